@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// AppLogStreamError is returned when streaming the logs of one of several apps
+// fails. The streams of the other apps carry on.
+type AppLogStreamError struct {
+	AppName string
+	Err     error
+}
+
+func (e AppLogStreamError) Error() string {
+	return fmt.Sprintf("Failed to stream logs for app %s: %s", e.AppName, e.Err)
+}
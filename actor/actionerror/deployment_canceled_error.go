@@ -0,0 +1,9 @@
+package actionerror
+
+// DeploymentCanceledError is returned when a deployment is canceled before
+// all of the application's instances have been replaced.
+type DeploymentCanceledError struct{}
+
+func (DeploymentCanceledError) Error() string {
+	return "Deployment was canceled"
+}
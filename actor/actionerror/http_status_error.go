@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// HTTPStatusError is returned when a request that should fail on HTTP errors
+// receives a 4xx or 5xx response.
+type HTTPStatusError struct {
+	StatusCode int
+}
+
+func (e HTTPStatusError) Error() string {
+	return fmt.Sprintf("The requested URL returned error: %d", e.StatusCode)
+}
@@ -0,0 +1,8 @@
+package actionerror
+
+// LoginDeniedError is returned when the user denies the login request.
+type LoginDeniedError struct{}
+
+func (LoginDeniedError) Error() string {
+	return "Login was denied"
+}
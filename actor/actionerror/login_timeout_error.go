@@ -0,0 +1,9 @@
+package actionerror
+
+// LoginTimeoutError is returned when the login is not completed before the
+// authorization expires.
+type LoginTimeoutError struct{}
+
+func (LoginTimeoutError) Error() string {
+	return "Timed out waiting for the login to complete"
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginChecksumMismatchError is returned when a downloaded plugin binary
+// does not match the checksum published by the plugin repository.
+type PluginChecksumMismatchError struct {
+	Algorithm string
+}
+
+func (e PluginChecksumMismatchError) Error() string {
+	return fmt.Sprintf("plugin binary does not match %s checksum", e.Algorithm)
+}
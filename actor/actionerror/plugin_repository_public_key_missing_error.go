@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginRepositoryPublicKeyMissingError is returned when a signature is
+// required but no public key was registered for the plugin repository.
+type PluginRepositoryPublicKeyMissingError struct {
+	RepositoryName string
+}
+
+func (e PluginRepositoryPublicKeyMissingError) Error() string {
+	return fmt.Sprintf("repository %s has no public key", e.RepositoryName)
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// PluginSignatureInvalidError is returned when a downloaded plugin binary's
+// signature cannot be verified with the plugin repository's public key.
+type PluginSignatureInvalidError struct {
+	PluginName     string
+	RepositoryName string
+	Reason         string
+}
+
+func (e PluginSignatureInvalidError) Error() string {
+	return fmt.Sprintf("signature of plugin %s from repository %s is invalid: %s", e.PluginName, e.RepositoryName, e.Reason)
+}
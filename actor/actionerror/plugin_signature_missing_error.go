@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// PluginSignatureMissingError is returned when a plugin repository with a
+// public key does not publish a signature for the plugin binary.
+type PluginSignatureMissingError struct {
+	PluginName     string
+	RepositoryName string
+}
+
+func (e PluginSignatureMissingError) Error() string {
+	return fmt.Sprintf("plugin %s from repository %s is not signed", e.PluginName, e.RepositoryName)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ProcessInstancesNotRunningError is returned when trying to perform an action
+// on every running instance of a process that has none.
+type ProcessInstancesNotRunningError struct {
+	ProcessType string
+}
+
+func (e ProcessInstancesNotRunningError) Error() string {
+	return fmt.Sprintf("No instances of process %s running", e.ProcessType)
+}
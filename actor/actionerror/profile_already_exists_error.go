@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ProfileAlreadyExistsError is returned when a profile is renamed to the name
+// of another profile.
+type ProfileAlreadyExistsError struct {
+	Name string
+}
+
+func (e ProfileAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Profile '%s' already exists.", e.Name)
+}
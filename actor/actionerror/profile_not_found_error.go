@@ -0,0 +1,12 @@
+package actionerror
+
+import "fmt"
+
+// ProfileNotFoundError is returned when a profile does not exist.
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	return fmt.Sprintf("Profile '%s' not found.", e.Name)
+}
@@ -0,0 +1,12 @@
+package actionerror
+
+import "fmt"
+
+// ProfileTargetedError is returned when deleting the targeted profile.
+type ProfileTargetedError struct {
+	Name string
+}
+
+func (e ProfileTargetedError) Error() string {
+	return fmt.Sprintf("Profile '%s' is targeted.", e.Name)
+}
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
 type FakeV3Actor struct {
@@ -25,10 +26,11 @@ type FakeV3Actor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
-	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	GetApplicationsBySpaceStub        func(spaceGUID string, queries ...ccv3.Query) ([]v3action.Application, v3action.Warnings, error)
 	getApplicationsBySpaceMutex       sync.RWMutex
 	getApplicationsBySpaceArgsForCall []struct {
 		spaceGUID string
+		queries   []ccv3.Query
 	}
 	getApplicationsBySpaceReturns struct {
 		result1 []v3action.Application
@@ -99,16 +101,17 @@ func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV3Actor) GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error) {
+func (fake *FakeV3Actor) GetApplicationsBySpace(spaceGUID string, queries ...ccv3.Query) ([]v3action.Application, v3action.Warnings, error) {
 	fake.getApplicationsBySpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
 	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
 		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+		queries   []ccv3.Query
+	}{spaceGUID, queries})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID, queries})
 	fake.getApplicationsBySpaceMutex.Unlock()
 	if fake.GetApplicationsBySpaceStub != nil {
-		return fake.GetApplicationsBySpaceStub(spaceGUID)
+		return fake.GetApplicationsBySpaceStub(spaceGUID, queries...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -122,10 +125,10 @@ func (fake *FakeV3Actor) GetApplicationsBySpaceCallCount() int {
 	return len(fake.getApplicationsBySpaceArgsForCall)
 }
 
-func (fake *FakeV3Actor) GetApplicationsBySpaceArgsForCall(i int) string {
+func (fake *FakeV3Actor) GetApplicationsBySpaceArgsForCall(i int) (string, []ccv3.Query) {
 	fake.getApplicationsBySpaceMutex.RLock()
 	defer fake.getApplicationsBySpaceMutex.RUnlock()
-	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID, fake.getApplicationsBySpaceArgsForCall[i].queries
 }
 
 func (fake *FakeV3Actor) GetApplicationsBySpaceReturns(result1 []v3action.Application, result2 v3action.Warnings, result3 error) {
@@ -8,6 +8,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -160,7 +161,7 @@ var _ = Describe("Policy", func() {
 				},
 			}}, nil)
 
-			fakeV3Actor.GetApplicationsBySpaceStub = func(_ string) ([]v3action.Application, v3action.Warnings, error) {
+			fakeV3Actor.GetApplicationsBySpaceStub = func(_ string, _ ...ccv3.Query) ([]v3action.Application, v3action.Warnings, error) {
 				return []v3action.Application{
 					{
 						Name: "appA",
@@ -296,7 +297,7 @@ var _ = Describe("Policy", func() {
 				},
 			}}, nil)
 
-			fakeV3Actor.GetApplicationsBySpaceStub = func(_ string) ([]v3action.Application, v3action.Warnings, error) {
+			fakeV3Actor.GetApplicationsBySpaceStub = func(_ string, _ ...ccv3.Query) ([]v3action.Application, v3action.Warnings, error) {
 				return []v3action.Application{
 					{
 						Name: "appA",
@@ -1,9 +1,12 @@
 package cfnetworkingaction
 
-import "code.cloudfoundry.org/cli/actor/v3action"
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
 
 //go:generate counterfeiter . V3Actor
 type V3Actor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
-	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string, queries ...ccv3.Query) ([]v3action.Application, v3action.Warnings, error)
 }
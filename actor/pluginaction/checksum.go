@@ -1,8 +1,95 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/minisign"
+)
 
 func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
 	plugin := configv3.Plugin{Location: path}
 	return plugin.CalculateSHA1() == checksum
 }
+
+// VerifyPluginBinary checks the downloaded plugin binary against the
+// checksums and the signature published by the plugin repository. Every
+// published checksum must match, and at least one must be published. The
+// signature is required and verified whenever the repository has a public
+// key. When requireSignature is set, a repository without a public key is an
+// error as well.
+func (actor Actor) VerifyPluginBinary(path string, pluginInfo PluginInfo, requireSignature bool) error {
+	binary, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	checksums := []struct {
+		algorithm string
+		expected  string
+		hash      hash.Hash
+	}{
+		{"sha512", pluginInfo.SHA512, sha512.New()},
+		{"sha256", pluginInfo.SHA256, sha256.New()},
+		{"sha1", pluginInfo.Checksum, sha1.New()},
+	}
+
+	var verified bool
+	for _, checksum := range checksums {
+		if checksum.expected == "" {
+			continue
+		}
+		checksum.hash.Write(binary)
+		if !strings.EqualFold(hex.EncodeToString(checksum.hash.Sum(nil)), checksum.expected) {
+			return actionerror.PluginChecksumMismatchError{Algorithm: checksum.algorithm}
+		}
+		verified = true
+	}
+	if !verified {
+		return actionerror.PluginChecksumMismatchError{Algorithm: "sha1"}
+	}
+
+	return verifyPluginSignature(binary, pluginInfo, requireSignature)
+}
+
+func verifyPluginSignature(binary []byte, pluginInfo PluginInfo, requireSignature bool) error {
+	if pluginInfo.PublicKey == "" {
+		if requireSignature {
+			return actionerror.PluginRepositoryPublicKeyMissingError{RepositoryName: pluginInfo.RepositoryName}
+		}
+		return nil
+	}
+
+	if pluginInfo.Signature == "" {
+		return actionerror.PluginSignatureMissingError{
+			PluginName:     pluginInfo.Name,
+			RepositoryName: pluginInfo.RepositoryName,
+		}
+	}
+
+	invalidSignature := func(err error) error {
+		return actionerror.PluginSignatureInvalidError{
+			PluginName:     pluginInfo.Name,
+			RepositoryName: pluginInfo.RepositoryName,
+			Reason:         err.Error(),
+		}
+	}
+
+	publicKey, err := minisign.ParsePublicKey(pluginInfo.PublicKey)
+	if err != nil {
+		return invalidSignature(err)
+	}
+
+	err = publicKey.Verify(binary, pluginInfo.Signature)
+	if err != nil {
+		return invalidSignature(err)
+	}
+	return nil
+}
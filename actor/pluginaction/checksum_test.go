@@ -1,13 +1,16 @@
 package pluginaction_test
 
 import (
+	"encoding/base64"
 	"io/ioutil"
 	"os"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
 )
 
 var _ = Describe("Checksums", func() {
@@ -50,4 +53,133 @@ var _ = Describe("Checksums", func() {
 			})
 		})
 	})
+
+	Describe("VerifyPluginBinary", func() {
+		const (
+			fooSHA1   = "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"
+			fooSHA256 = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+			fooSHA512 = "f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7"
+		)
+
+		var (
+			file             *os.File
+			pluginInfo       PluginInfo
+			requireSignature bool
+			privateKey       ed25519.PrivateKey
+			err              error
+		)
+
+		BeforeEach(func() {
+			file, err = ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			Expect(ioutil.WriteFile(file.Name(), []byte("foo"), 0600)).To(Succeed())
+
+			var publicKey ed25519.PublicKey
+			publicKey, privateKey, err = ed25519.GenerateKey(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			pluginInfo = PluginInfo{
+				Name:           "some-plugin",
+				RepositoryName: "some-repo",
+				SHA256:         fooSHA256,
+				SHA512:         fooSHA512,
+				PublicKey:      base64.StdEncoding.EncodeToString(publicKey),
+				Signature:      base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("foo"))),
+			}
+			requireSignature = false
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(file.Name())).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			err = actor.VerifyPluginBinary(file.Name(), pluginInfo, requireSignature)
+		})
+
+		Context("when the checksums and the signature match", func() {
+			It("returns no error", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when only the legacy SHA1 checksum is published", func() {
+			BeforeEach(func() {
+				pluginInfo = PluginInfo{Checksum: fooSHA1}
+			})
+
+			It("returns no error", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when a checksum does not match", func() {
+			BeforeEach(func() {
+				pluginInfo.SHA256 = "some-other-checksum"
+			})
+
+			It("returns a PluginChecksumMismatchError", func() {
+				Expect(err).To(MatchError(actionerror.PluginChecksumMismatchError{Algorithm: "sha256"}))
+			})
+		})
+
+		Context("when no checksum is published", func() {
+			BeforeEach(func() {
+				pluginInfo.SHA256 = ""
+				pluginInfo.SHA512 = ""
+			})
+
+			It("returns a PluginChecksumMismatchError", func() {
+				Expect(err).To(MatchError(actionerror.PluginChecksumMismatchError{Algorithm: "sha1"}))
+			})
+		})
+
+		Context("when the signature does not match", func() {
+			BeforeEach(func() {
+				pluginInfo.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("bar")))
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureInvalidError{
+					PluginName:     "some-plugin",
+					RepositoryName: "some-repo",
+					Reason:         "invalid signature",
+				}))
+			})
+		})
+
+		Context("when the plugin is not signed", func() {
+			BeforeEach(func() {
+				pluginInfo.Signature = ""
+			})
+
+			It("returns a PluginSignatureMissingError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureMissingError{
+					PluginName:     "some-plugin",
+					RepositoryName: "some-repo",
+				}))
+			})
+		})
+
+		Context("when the repository has no public key", func() {
+			BeforeEach(func() {
+				pluginInfo.PublicKey = ""
+			})
+
+			It("does not verify the signature", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when a signature is required", func() {
+				BeforeEach(func() {
+					requireSignature = true
+				})
+
+				It("returns a PluginRepositoryPublicKeyMissingError", func() {
+					Expect(err).To(MatchError(actionerror.PluginRepositoryPublicKeyMissingError{RepositoryName: "some-repo"}))
+				})
+			})
+		})
+	})
 })
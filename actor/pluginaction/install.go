@@ -141,15 +141,27 @@ func (actor Actor) GetAndValidatePlugin(pluginMetadata PluginMetadata, commandLi
 	return plugin, nil
 }
 
+// InstallPluginFromPath installs the plugin binary at path into the plugin
+// home and adds the plugin to the plugin config. The binary is copied next to
+// the installed binary and renamed over it, so a binary that is already
+// installed is replaced atomically.
 func (actor Actor) InstallPluginFromPath(path string, plugin configv3.Plugin) error {
 	installPath := generic.ExecutableFilename(filepath.Join(actor.config.PluginHome(), plugin.Name))
-	err := fileutils.CopyPathToPath(path, installPath)
+	newPath := installPath + ".new"
+	err := fileutils.CopyPathToPath(path, newPath)
 	if err != nil {
 		return err
 	}
 	// rwxr-xr-x so that multiple users can share the same $CF_PLUGIN_HOME
-	err = os.Chmod(installPath, 0755)
+	err = os.Chmod(newPath, 0755)
 	if err != nil {
+		_ = os.Remove(newPath)
+		return err
+	}
+
+	err = os.Rename(newPath, installPath)
+	if err != nil {
+		_ = os.Remove(newPath)
 		return err
 	}
 
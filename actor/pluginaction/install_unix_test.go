@@ -123,6 +123,26 @@ var _ = Describe("install actions", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(stat.Mode()).To(Equal(os.FileMode(0755)))
 			})
+
+			Context("when the plugin is already installed", func() {
+				BeforeEach(func() {
+					Expect(os.MkdirAll(pluginHomeDir, 0700)).To(Succeed())
+					Expect(ioutil.WriteFile(filepath.Join(pluginHomeDir, "some-plugin"), []byte("old-binary"), 0755)).To(Succeed())
+					Expect(ioutil.WriteFile(pluginPath, []byte("new-binary"), 0600)).To(Succeed())
+				})
+
+				It("replaces the installed binary without leaving temporary files", func() {
+					Expect(installErr).ToNot(HaveOccurred())
+
+					contents, err := ioutil.ReadFile(filepath.Join(pluginHomeDir, "some-plugin"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("new-binary"))
+
+					files, err := ioutil.ReadDir(pluginHomeDir)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(files).To(HaveLen(1))
+				})
+			})
 		})
 	})
 })
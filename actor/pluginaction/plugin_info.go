@@ -13,6 +13,16 @@ type PluginInfo struct {
 	Version  string
 	URL      string
 	Checksum string
+	SHA256   string
+	SHA512   string
+
+	// Signature is the detached minisign signature of the binary.
+	Signature string
+
+	// RepositoryName and PublicKey identify the repository the plugin was
+	// found in and the key its signatures are verified with.
+	RepositoryName string
+	PublicKey      string
 }
 
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
@@ -74,10 +84,15 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
 					return PluginInfo{
-						Name:     plugin.Name,
-						Version:  plugin.Version,
-						URL:      pluginBinary.URL,
-						Checksum: pluginBinary.Checksum,
+						Name:           plugin.Name,
+						Version:        plugin.Version,
+						URL:            pluginBinary.URL,
+						Checksum:       pluginBinary.Checksum,
+						SHA256:         pluginBinary.SHA256,
+						SHA512:         pluginBinary.SHA512,
+						Signature:      pluginBinary.Signature,
+						RepositoryName: pluginRepo.Name,
+						PublicKey:      pluginRepo.PublicKey,
 					}, nil
 				}
 			}
@@ -188,10 +188,11 @@ var _ = Describe("plugin info actions", func() {
 
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pluginInfo).To(Equal(PluginInfo{
-						Name:     "some-plugin",
-						Version:  "1.2.3",
-						URL:      "some-url",
-						Checksum: "some-checksum",
+						Name:           "some-plugin",
+						Version:        "1.2.3",
+						URL:            "some-url",
+						Checksum:       "some-checksum",
+						RepositoryName: "repo2",
 					}))
 					Expect(repos).To(ConsistOf("repo2", "repo3"))
 				})
@@ -217,15 +218,48 @@ var _ = Describe("plugin info actions", func() {
 
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pluginInfo).To(Equal(PluginInfo{
-						Name:     "some-plugin",
-						Version:  "1.2.3",
-						URL:      "some-url",
-						Checksum: "some-checksum",
+						Name:           "some-plugin",
+						Version:        "1.2.3",
+						URL:            "some-url",
+						Checksum:       "some-checksum",
+						RepositoryName: "repo1",
 					}))
 					Expect(repos).To(ConsistOf("repo1"))
 				})
 			})
 
+			Context("when the repository publishes checksums and a signature", func() {
+				BeforeEach(func() {
+					pluginRepositories[0].PublicKey = "some-public-key"
+					fakeClient.GetPluginRepositoryStub = func(repoURL string) (plugin.PluginRepository, error) {
+						if repoURL == "url1" {
+							return plugin.PluginRepository{Plugins: []plugin.Plugin{
+								{Name: "some-plugin", Version: "1.2.3", Binaries: []plugin.PluginBinary{
+									{Platform: "some-platform", URL: "some-url", SHA256: "some-sha256", SHA512: "some-sha512", Signature: "some-signature"},
+								}},
+							}}, nil
+						}
+						return plugin.PluginRepository{}, nil
+					}
+				})
+
+				It("returns them with the repository's public key", func() {
+					pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", pluginRepositories, "some-platform")
+
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pluginInfo).To(Equal(PluginInfo{
+						Name:           "some-plugin",
+						Version:        "1.2.3",
+						URL:            "some-url",
+						SHA256:         "some-sha256",
+						SHA512:         "some-sha512",
+						Signature:      "some-signature",
+						RepositoryName: "repo1",
+						PublicKey:      "some-public-key",
+					}))
+				})
+			})
+
 			Context("when the plugin is found in many repositories", func() {
 				BeforeEach(func() {
 					fakeClient.GetPluginRepositoryStub = func(repoURL string) (plugin.PluginRepository, error) {
@@ -242,10 +276,11 @@ var _ = Describe("plugin info actions", func() {
 
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pluginInfo).To(Equal(PluginInfo{
-						Name:     "some-plugin",
-						Version:  "1.2.3",
-						URL:      "some-url",
-						Checksum: "some-checksum",
+						Name:           "some-plugin",
+						Version:        "1.2.3",
+						URL:            "some-url",
+						Checksum:       "some-checksum",
+						RepositoryName: "repo1",
 					}))
 					Expect(repos).To(ConsistOf("repo1", "repo2", "repo3"))
 				})
@@ -282,10 +317,11 @@ var _ = Describe("plugin info actions", func() {
 
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pluginInfo).To(Equal(PluginInfo{
-						Name:     "some-plugin",
-						Version:  "2.2.3",
-						URL:      "some-url",
-						Checksum: "some-checksum",
+						Name:           "some-plugin",
+						Version:        "2.2.3",
+						URL:            "some-url",
+						Checksum:       "some-checksum",
+						RepositoryName: "repo2",
 					}))
 					Expect(repos).To(ConsistOf("repo2"))
 				})
@@ -316,10 +352,11 @@ var _ = Describe("plugin info actions", func() {
 
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pluginInfo).To(Equal(PluginInfo{
-						Name:     "some-plugin",
-						Version:  "1.2.3",
-						URL:      "some-url",
-						Checksum: "some-checksum",
+						Name:           "some-plugin",
+						Version:        "1.2.3",
+						URL:            "some-url",
+						Checksum:       "some-checksum",
+						RepositoryName: "repo1",
 					}))
 					Expect(repos).To(ConsistOf("repo1", "repo2"))
 				})
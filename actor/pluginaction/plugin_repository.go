@@ -6,9 +6,12 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/minisign"
 )
 
-func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
+// AddPluginRepository registers the repository. When publicKey is provided,
+// the signatures of the repository's plugin binaries are verified with it.
+func (actor Actor) AddPluginRepository(repoName string, repoURL string, publicKey string) error {
 	normalizedURL, err := normalizeURLPath(repoURL)
 	if err != nil {
 		return actionerror.AddPluginRepositoryError{
@@ -18,6 +21,16 @@ func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
 		}
 	}
 
+	if publicKey != "" {
+		if _, err = minisign.ParsePublicKey(publicKey); err != nil {
+			return actionerror.AddPluginRepositoryError{
+				Name:    repoName,
+				URL:     normalizedURL,
+				Message: err.Error(),
+			}
+		}
+	}
+
 	repoNameLowerCased := strings.ToLower(repoName)
 	for _, repository := range actor.config.PluginRepositories() {
 		existingRepoNameLowerCased := strings.ToLower(repository.Name)
@@ -40,7 +53,7 @@ func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
 		}
 	}
 
-	actor.config.AddPluginRepository(repoName, normalizedURL)
+	actor.config.AddPluginRepository(repoName, normalizedURL, publicKey)
 	return nil
 }
 
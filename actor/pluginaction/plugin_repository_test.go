@@ -27,15 +27,22 @@ var _ = Describe("Plugin Repository Actions", func() {
 	})
 
 	Describe("AddPluginRepository", func() {
-		var err error
+		var (
+			publicKey string
+			err       error
+		)
+
+		BeforeEach(func() {
+			publicKey = ""
+		})
 
 		JustBeforeEach(func() {
-			err = actor.AddPluginRepository("some-repo", "some-URL")
+			err = actor.AddPluginRepository("some-repo", "some-URL", publicKey)
 		})
 
 		Context("when passed a url without a scheme", func() {
 			It("prepends https://", func() {
-				_ = actor.AddPluginRepository("some-repo2", "some-URL")
+				_ = actor.AddPluginRepository("some-repo2", "some-URL", "")
 				url := fakePluginClient.GetPluginRepositoryArgsForCall(1)
 				Expect(strings.HasPrefix(url, "https://")).To(BeTrue())
 			})
@@ -43,7 +50,7 @@ var _ = Describe("Plugin Repository Actions", func() {
 
 		Context("when passed a schemeless IP address with a port", func() {
 			It("prepends https://", func() {
-				_ = actor.AddPluginRepository("some-repo2", "127.0.0.1:5000")
+				_ = actor.AddPluginRepository("some-repo2", "127.0.0.1:5000", "")
 				url := fakePluginClient.GetPluginRepositoryArgsForCall(1)
 				Expect(strings.HasPrefix(url, "https://")).To(BeTrue())
 			})
@@ -115,7 +122,7 @@ var _ = Describe("Plugin Repository Actions", func() {
 			})
 
 			It("returns a RepositoryAlreadyExistsError", func() {
-				err = actor.AddPluginRepository("some-repo", "some-URL/")
+				err = actor.AddPluginRepository("some-repo", "some-URL/", "")
 				Expect(err).To(MatchError(actionerror.RepositoryAlreadyExistsError{Name: "some-repo", URL: "https://some-URL"}))
 
 				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
@@ -144,9 +151,43 @@ var _ = Describe("Plugin Repository Actions", func() {
 				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://some-URL"))
 
 				Expect(fakeConfig.AddPluginRepositoryCallCount()).To(Equal(1))
-				repoName, repoURL := fakeConfig.AddPluginRepositoryArgsForCall(0)
+				repoName, repoURL, repoPublicKey := fakeConfig.AddPluginRepositoryArgsForCall(0)
 				Expect(repoName).To(Equal("some-repo"))
 				Expect(repoURL).To(Equal("https://some-URL"))
+				Expect(repoPublicKey).To(BeEmpty())
+			})
+		})
+
+		Context("when a public key is provided", func() {
+			Context("when the public key is valid", func() {
+				BeforeEach(func() {
+					publicKey = "RWQBAgMEBQYHCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+				})
+
+				It("adds the repo to the config with the public key", func() {
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeConfig.AddPluginRepositoryCallCount()).To(Equal(1))
+					_, _, repoPublicKey := fakeConfig.AddPluginRepositoryArgsForCall(0)
+					Expect(repoPublicKey).To(Equal(publicKey))
+				})
+			})
+
+			Context("when the public key is invalid", func() {
+				BeforeEach(func() {
+					publicKey = "some-invalid-key"
+				})
+
+				It("returns an AddPluginRepositoryError", func() {
+					Expect(err).To(MatchError(actionerror.AddPluginRepositoryError{
+						Name:    "some-repo",
+						URL:     "https://some-URL",
+						Message: "invalid public key",
+					}))
+
+					Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
+					Expect(fakeConfig.AddPluginRepositoryCallCount()).To(Equal(0))
+				})
 			})
 		})
 
@@ -176,9 +217,10 @@ var _ = Describe("Plugin Repository Actions", func() {
 				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://some-URL"))
 
 				Expect(fakeConfig.AddPluginRepositoryCallCount()).To(Equal(1))
-				repoName, repoURL := fakeConfig.AddPluginRepositoryArgsForCall(0)
+				repoName, repoURL, repoPublicKey := fakeConfig.AddPluginRepositoryArgsForCall(0)
 				Expect(repoName).To(Equal("some-repo"))
 				Expect(repoURL).To(Equal("https://some-URL"))
+				Expect(repoPublicKey).To(BeEmpty())
 			})
 		})
 	})
@@ -14,11 +14,12 @@ type FakeConfig struct {
 	addPluginArgsForCall []struct {
 		arg1 configv3.Plugin
 	}
-	AddPluginRepositoryStub        func(repoName string, repoURL string)
+	AddPluginRepositoryStub        func(repoName string, repoURL string, publicKey string)
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
-		repoName string
-		repoURL  string
+		repoName  string
+		repoURL   string
+		publicKey string
 	}
 	GetPluginStub        func(pluginName string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
@@ -102,16 +103,17 @@ func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
 	return fake.addPluginArgsForCall[i].arg1
 }
 
-func (fake *FakeConfig) AddPluginRepository(repoName string, repoURL string) {
+func (fake *FakeConfig) AddPluginRepository(repoName string, repoURL string, publicKey string) {
 	fake.addPluginRepositoryMutex.Lock()
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
-		repoName string
-		repoURL  string
-	}{repoName, repoURL})
-	fake.recordInvocation("AddPluginRepository", []interface{}{repoName, repoURL})
+		repoName  string
+		repoURL   string
+		publicKey string
+	}{repoName, repoURL, publicKey})
+	fake.recordInvocation("AddPluginRepository", []interface{}{repoName, repoURL, publicKey})
 	fake.addPluginRepositoryMutex.Unlock()
 	if fake.AddPluginRepositoryStub != nil {
-		fake.AddPluginRepositoryStub(repoName, repoURL)
+		fake.AddPluginRepositoryStub(repoName, repoURL, publicKey)
 	}
 }
 
@@ -121,10 +123,10 @@ func (fake *FakeConfig) AddPluginRepositoryCallCount() int {
 	return len(fake.addPluginRepositoryArgsForCall)
 }
 
-func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
+func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string, string) {
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
-	return fake.addPluginRepositoryArgsForCall[i].repoName, fake.addPluginRepositoryArgsForCall[i].repoURL
+	return fake.addPluginRepositoryArgsForCall[i].repoName, fake.addPluginRepositoryArgsForCall[i].repoURL, fake.addPluginRepositoryArgsForCall[i].publicKey
 }
 
 func (fake *FakeConfig) GetPlugin(pluginName string) (configv3.Plugin, bool) {
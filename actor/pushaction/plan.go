@@ -0,0 +1,124 @@
+package pushaction
+
+import (
+	"os"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	log "github.com/sirupsen/logrus"
+)
+
+// ApplicationPlan is the set of changes that Apply would make to the routes,
+// service bindings and bits of an application.
+type ApplicationPlan struct {
+	RoutesToCreate []v2action.Route
+	RoutesToMap    []v2action.Route
+	RoutesToUnmap  []v2action.Route
+	ServicesToBind []string
+
+	// FilesToUpload and BytesToUpload describe the application files, or the
+	// droplet, that the Cloud Controller does not already have.
+	FilesToUpload int
+	BytesToUpload int64
+}
+
+// HasChanges returns true if applying the plan would change the routes,
+// service bindings or bits of the application.
+func (plan ApplicationPlan) HasChanges() bool {
+	return len(plan.RoutesToCreate) > 0 ||
+		len(plan.RoutesToMap) > 0 ||
+		len(plan.RoutesToUnmap) > 0 ||
+		len(plan.ServicesToBind) > 0 ||
+		plan.FilesToUpload > 0
+}
+
+// PlanApplication determines the changes that Apply would make for the
+// provided config without making them. Resource matching is used to
+// determine which files would be uploaded; it does not modify anything on
+// the Cloud Controller.
+func (actor Actor) PlanApplication(config ApplicationConfig) (ApplicationPlan, Warnings, error) {
+	var plan ApplicationPlan
+
+	if config.NoRoute {
+		plan.RoutesToUnmap = config.CurrentRoutes
+	} else {
+		for _, route := range config.DesiredRoutes {
+			if route.GUID == "" {
+				plan.RoutesToCreate = append(plan.RoutesToCreate, route)
+			}
+			if !actor.routeInListByGUID(route, config.CurrentRoutes) {
+				plan.RoutesToMap = append(plan.RoutesToMap, route)
+			}
+		}
+	}
+
+	for serviceInstanceName := range config.DesiredServices {
+		if _, ok := config.CurrentServices[serviceInstanceName]; !ok {
+			plan.ServicesToBind = append(plan.ServicesToBind, serviceInstanceName)
+		}
+	}
+	sort.Strings(plan.ServicesToBind)
+
+	switch {
+	case config.DropletPath != "":
+		info, err := os.Stat(config.DropletPath)
+		if err != nil {
+			return ApplicationPlan{}, nil, err
+		}
+		plan.FilesToUpload = 1
+		plan.BytesToUpload = info.Size()
+	case config.DesiredApplication.DockerImage == "":
+		log.Info("matching resources for plan")
+		var warnings Warnings
+		config, warnings = actor.SetMatchedResources(config)
+		for _, resource := range config.UnmatchedResources {
+			if resource.Mode.IsDir() {
+				continue
+			}
+			plan.FilesToUpload++
+			plan.BytesToUpload += resource.Size
+		}
+		return plan, warnings, nil
+	}
+
+	return plan, nil, nil
+}
+
+// PlanDefaultApplicationRoute determines whether
+// CreateAndMapDefaultApplicationRoute would create and/or map the default
+// route of the provided application without making any changes. An
+// application without a GUID is treated as an application that has not been
+// created yet.
+func (actor Actor) PlanDefaultApplicationRoute(orgGUID string, spaceGUID string, app v2action.Application) (ApplicationPlan, Warnings, error) {
+	var plan ApplicationPlan
+
+	defaultRoute, warnings, err := actor.getDefaultRoute(orgGUID, spaceGUID, app.Name)
+	if err != nil {
+		return ApplicationPlan{}, warnings, err
+	}
+
+	if app.GUID != "" {
+		boundRoutes, appRouteWarnings, err := actor.V2Actor.GetApplicationRoutes(app.GUID)
+		warnings = append(warnings, appRouteWarnings...)
+		if err != nil {
+			return ApplicationPlan{}, warnings, err
+		}
+
+		if _, routeAlreadyBound := actor.routeInListBySettings(defaultRoute, boundRoutes); routeAlreadyBound {
+			return plan, warnings, nil
+		}
+	}
+
+	spaceRoute, spaceRouteWarnings, err := actor.V2Actor.FindRouteBoundToSpaceWithSettings(defaultRoute)
+	warnings = append(warnings, spaceRouteWarnings...)
+	if _, ok := err.(actionerror.RouteNotFoundError); ok {
+		plan.RoutesToCreate = []v2action.Route{defaultRoute}
+		spaceRoute = defaultRoute
+	} else if err != nil {
+		return ApplicationPlan{}, warnings, err
+	}
+
+	plan.RoutesToMap = []v2action.Route{spaceRoute}
+	return plan, warnings, nil
+}
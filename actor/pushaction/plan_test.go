@@ -0,0 +1,297 @@
+package pushaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil, nil)
+	})
+
+	Describe("PlanApplication", func() {
+		var (
+			config ApplicationConfig
+
+			plan       ApplicationPlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{
+					Application: v2action.Application{DockerImage: "some-image"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.PlanApplication(config)
+		})
+
+		Context("when there is nothing to change", func() {
+			BeforeEach(func() {
+				route := v2action.Route{GUID: "some-route-guid", Host: "some-app"}
+				config.CurrentRoutes = []v2action.Route{route}
+				config.DesiredRoutes = []v2action.Route{route}
+				config.CurrentServices = map[string]v2action.ServiceInstance{"service-1": {}}
+				config.DesiredServices = map[string]v2action.ServiceInstance{"service-1": {}}
+			})
+
+			It("returns an empty plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.HasChanges()).To(BeFalse())
+				Expect(warnings).To(BeEmpty())
+			})
+		})
+
+		Context("when there are routes to create and map", func() {
+			BeforeEach(func() {
+				config.CurrentRoutes = []v2action.Route{
+					{GUID: "bound-route-guid", Host: "bound"},
+				}
+				config.DesiredRoutes = []v2action.Route{
+					{GUID: "bound-route-guid", Host: "bound"},
+					{GUID: "existing-route-guid", Host: "existing"},
+					{Host: "new"},
+				}
+			})
+
+			It("plans to create the new routes and map the unbound routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.RoutesToCreate).To(ConsistOf(v2action.Route{Host: "new"}))
+				Expect(plan.RoutesToMap).To(ConsistOf(
+					v2action.Route{GUID: "existing-route-guid", Host: "existing"},
+					v2action.Route{Host: "new"},
+				))
+				Expect(plan.RoutesToUnmap).To(BeEmpty())
+				Expect(plan.HasChanges()).To(BeTrue())
+			})
+		})
+
+		Context("when no route is requested", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+				config.CurrentRoutes = []v2action.Route{{GUID: "bound-route-guid", Host: "bound"}}
+				config.DesiredRoutes = []v2action.Route{{Host: "new"}}
+			})
+
+			It("plans to unmap the current routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.RoutesToCreate).To(BeEmpty())
+				Expect(plan.RoutesToMap).To(BeEmpty())
+				Expect(plan.RoutesToUnmap).To(ConsistOf(v2action.Route{GUID: "bound-route-guid", Host: "bound"}))
+			})
+		})
+
+		Context("when there are services to bind", func() {
+			BeforeEach(func() {
+				config.CurrentServices = map[string]v2action.ServiceInstance{"service-1": {}}
+				config.DesiredServices = map[string]v2action.ServiceInstance{
+					"service-3": {},
+					"service-1": {},
+					"service-2": {},
+				}
+			})
+
+			It("plans to bind the unbound services in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.ServicesToBind).To(Equal([]string{"service-2", "service-3"}))
+			})
+		})
+
+		Context("when the app has bits", func() {
+			BeforeEach(func() {
+				config.DesiredApplication.DockerImage = ""
+				config.AllResources = []v2action.Resource{
+					{Filename: "some-dir", Mode: os.ModeDir | 0755},
+					{Filename: "matched", Mode: 0644, Size: 100},
+					{Filename: "unmatched-1", Mode: 0644, Size: 10},
+					{Filename: "unmatched-2", Mode: 0644, Size: 5},
+				}
+			})
+
+			Context("when resource matching succeeds", func() {
+				BeforeEach(func() {
+					fakeV2Actor.ResourceMatchReturns(
+						[]v2action.Resource{config.AllResources[1]},
+						[]v2action.Resource{config.AllResources[0], config.AllResources[2], config.AllResources[3]},
+						v2action.Warnings{"resource-match-warning"},
+						nil,
+					)
+				})
+
+				It("plans to upload the unmatched files", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("resource-match-warning"))
+					Expect(plan.FilesToUpload).To(Equal(2))
+					Expect(plan.BytesToUpload).To(BeNumerically("==", 15))
+
+					Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.ResourceMatchArgsForCall(0)).To(Equal(config.AllResources))
+				})
+			})
+
+			Context("when resource matching errors", func() {
+				BeforeEach(func() {
+					fakeV2Actor.ResourceMatchReturns(nil, nil, v2action.Warnings{"resource-match-warning"}, errors.New("some-error"))
+				})
+
+				It("plans to upload all the files", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("resource-match-warning"))
+					Expect(plan.FilesToUpload).To(Equal(3))
+					Expect(plan.BytesToUpload).To(BeNumerically("==", 115))
+				})
+			})
+		})
+
+		Context("when the app is pushed with a droplet", func() {
+			BeforeEach(func() {
+				droplet, err := ioutil.TempFile("", "some-droplet-")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = droplet.Write([]byte("some-droplet-contents"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet.Close()).To(Succeed())
+
+				config.DesiredApplication.DockerImage = ""
+				config.DropletPath = droplet.Name()
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(config.DropletPath)).To(Succeed())
+			})
+
+			It("plans to upload the droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.FilesToUpload).To(Equal(1))
+				Expect(plan.BytesToUpload).To(BeNumerically("==", len("some-droplet-contents")))
+				Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("PlanDefaultApplicationRoute", func() {
+		var (
+			app v2action.Application
+
+			plan       ApplicationPlan
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			app = v2action.Application{Name: "some-app", GUID: "some-app-guid"}
+
+			fakeV2Actor.GetOrganizationDomainsReturns(
+				[]v2action.Domain{{GUID: "some-domain-guid", Name: "some-domain"}},
+				v2action.Warnings{"domain-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, executeErr = actor.PlanDefaultApplicationRoute("some-org-guid", "some-space-guid", app)
+		})
+
+		Context("when the default route is already bound to the app", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationRoutesReturns(
+					[]v2action.Route{{
+						GUID:      "some-route-guid",
+						Host:      "some-app",
+						Domain:    v2action.Domain{GUID: "some-domain-guid", Name: "some-domain"},
+						SpaceGUID: "some-space-guid",
+					}},
+					v2action.Warnings{"route-warning"},
+					nil,
+				)
+			})
+
+			It("returns an empty plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("domain-warning", "route-warning"))
+				Expect(plan.HasChanges()).To(BeFalse())
+				Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the default route exists in the space", func() {
+			var existingRoute v2action.Route
+
+			BeforeEach(func() {
+				existingRoute = v2action.Route{
+					GUID:      "some-route-guid",
+					Host:      "some-app",
+					Domain:    v2action.Domain{GUID: "some-domain-guid", Name: "some-domain"},
+					SpaceGUID: "some-space-guid",
+				}
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(existingRoute, v2action.Warnings{"find-route-warning"}, nil)
+			})
+
+			It("plans to map the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("domain-warning", "find-route-warning"))
+				Expect(plan.RoutesToCreate).To(BeEmpty())
+				Expect(plan.RoutesToMap).To(ConsistOf(existingRoute))
+			})
+		})
+
+		Context("when the default route does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"find-route-warning"}, actionerror.RouteNotFoundError{})
+			})
+
+			It("plans to create and map the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				defaultRoute := v2action.Route{
+					Host:      "some-app",
+					Domain:    v2action.Domain{GUID: "some-domain-guid", Name: "some-domain"},
+					SpaceGUID: "some-space-guid",
+				}
+				Expect(plan.RoutesToCreate).To(ConsistOf(defaultRoute))
+				Expect(plan.RoutesToMap).To(ConsistOf(defaultRoute))
+			})
+
+			Context("when the app does not exist yet", func() {
+				BeforeEach(func() {
+					app.GUID = ""
+				})
+
+				It("does not look up the routes of the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(0))
+					Expect(plan.RoutesToCreate).To(HaveLen(1))
+				})
+			})
+		})
+
+		Context("when finding the route errors", func() {
+			BeforeEach(func() {
+				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"find-route-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("domain-warning", "find-route-warning"))
+			})
+		})
+	})
+})
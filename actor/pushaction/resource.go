@@ -61,6 +61,26 @@ func (actor Actor) SetMatchedResources(config ApplicationConfig) (ApplicationCon
 	return config, Warnings(warnings)
 }
 
+// GetUnmatchedResources returns the files that the Cloud Controller does not
+// already have in its resource cache and so would need to be uploaded. Empty
+// files are never uploaded and are not returned.
+func (actor Actor) GetUnmatchedResources(resources []sharedaction.Resource) ([]sharedaction.Resource, Warnings, error) {
+	_, unmatched, warnings, err := actor.V2Actor.ResourceMatch(actor.ConvertSharedResourcesToV2Resources(resources))
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var unmatchedFiles []sharedaction.Resource
+	for _, resource := range unmatched {
+		if resource.Mode.IsDir() || resource.Size == 0 {
+			continue
+		}
+		unmatchedFiles = append(unmatchedFiles, sharedaction.Resource(resource))
+	}
+
+	return unmatchedFiles, Warnings(warnings), nil
+}
+
 func (actor Actor) UploadPackage(config ApplicationConfig) (Warnings, error) {
 	job, warnings, err := actor.V2Actor.UploadApplicationPackage(config.DesiredApplication.GUID, config.MatchedResources, nil, 0)
 	if err != nil {
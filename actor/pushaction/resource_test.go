@@ -9,6 +9,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 
 	. "github.com/onsi/ginkgo"
@@ -178,6 +179,59 @@ var _ = Describe("Resources", func() {
 		})
 	})
 
+	Describe("GetUnmatchedResources", func() {
+		var (
+			resources []sharedaction.Resource
+
+			unmatched  []sharedaction.Resource
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			resources = []sharedaction.Resource{
+				{Filename: "file-1", SHA1: "sha-1", Size: 10},
+				{Filename: "file-2", SHA1: "sha-2", Size: 20},
+				{Filename: "empty-file", Size: 0},
+			}
+		})
+
+		JustBeforeEach(func() {
+			unmatched, warnings, executeErr = actor.GetUnmatchedResources(resources)
+		})
+
+		Context("when resource matching succeeds", func() {
+			BeforeEach(func() {
+				fakeV2Actor.ResourceMatchReturns(
+					[]v2action.Resource{{Filename: "file-1", SHA1: "sha-1", Size: 10}},
+					[]v2action.Resource{{Filename: "file-2", SHA1: "sha-2", Size: 20}, {Filename: "empty-file", Size: 0}},
+					v2action.Warnings{"resource-match-warning"},
+					nil,
+				)
+			})
+
+			It("returns the unmatched non-empty files and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(unmatched).To(Equal([]sharedaction.Resource{{Filename: "file-2", SHA1: "sha-2", Size: 20}}))
+				Expect(warnings).To(ConsistOf("resource-match-warning"))
+
+				Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.ResourceMatchArgsForCall(0)).To(Equal(actor.ConvertSharedResourcesToV2Resources(resources)))
+			})
+		})
+
+		Context("when resource matching fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.ResourceMatchReturns(nil, nil, v2action.Warnings{"resource-match-warning"}, errors.New("some-match-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-match-error"))
+				Expect(warnings).To(ConsistOf("resource-match-warning"))
+			})
+		})
+	})
+
 	Describe("UploadPackage", func() {
 		var (
 			config ApplicationConfig
@@ -1,13 +1,19 @@
 package sharedaction
 
+import "code.cloudfoundry.org/cli/util/configv3"
+
 //go:generate counterfeiter . Config
 
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
 	BinaryName() string
+	CurrentProfile() string
+	DeleteProfile(name string)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	Profiles() []configv3.Profile
 	RefreshToken() string
+	RenameProfile(oldName string, newName string)
 	Verbose() (bool, []string)
 }
@@ -0,0 +1,71 @@
+package sharedaction
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogMessage is a log line from either the v2 or v3 actor.
+type LogMessage interface {
+	Message() string
+	Type() string
+	Timestamp() time.Time
+	SourceType() string
+	SourceInstance() string
+}
+
+// LogFilter selects which log messages are displayed. A log message must
+// satisfy every set field to match; a zero LogFilter matches everything.
+type LogFilter struct {
+	// SourceTypes match the first segment of the source type, so "APP"
+	// matches "APP/PROC/WEB".
+	SourceTypes []string
+	// SourceInstances match the source instance exactly.
+	SourceInstances []string
+	// Type is "OUT" or "ERR".
+	Type string
+	// Pattern matches against the message.
+	Pattern *regexp.Regexp
+	// Since drops messages older than it.
+	Since time.Time
+}
+
+// Matches returns true when the log message satisfies the filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if len(filter.SourceTypes) > 0 && !matchesSourceType(filter.SourceTypes, message.SourceType()) {
+		return false
+	}
+
+	if len(filter.SourceInstances) > 0 && !contains(filter.SourceInstances, message.SourceInstance()) {
+		return false
+	}
+
+	if filter.Type != "" && filter.Type != message.Type() {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	if !filter.Since.IsZero() && message.Timestamp().Before(filter.Since) {
+		return false
+	}
+
+	return true
+}
+
+func matchesSourceType(sourceTypes []string, sourceType string) bool {
+	prefix := strings.ToUpper(strings.SplitN(sourceType, "/", 2)[0])
+	return contains(sourceTypes, prefix)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package sharedaction_test
+
+import (
+	"regexp"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFilter", func() {
+	var message *uifakes.FakeLogMessage
+
+	BeforeEach(func() {
+		message = new(uifakes.FakeLogMessage)
+		message.MessageReturns("some-error happened")
+		message.TypeReturns("ERR")
+		message.TimestampReturns(time.Unix(100, 0))
+		message.SourceTypeReturns("APP/PROC/WEB")
+		message.SourceInstanceReturns("1")
+	})
+
+	DescribeTable("Matches",
+		func(filter LogFilter, expected bool) {
+			Expect(filter.Matches(message)).To(Equal(expected))
+		},
+
+		Entry("matches everything when empty", LogFilter{}, true),
+		Entry("matches the first segment of the source type", LogFilter{SourceTypes: []string{"RTR", "APP"}}, true),
+		Entry("does not match other source types", LogFilter{SourceTypes: []string{"RTR"}}, false),
+		Entry("matches the source instance", LogFilter{SourceInstances: []string{"0", "1"}}, true),
+		Entry("does not match other source instances", LogFilter{SourceInstances: []string{"0"}}, false),
+		Entry("matches the type", LogFilter{Type: "ERR"}, true),
+		Entry("does not match the other type", LogFilter{Type: "OUT"}, false),
+		Entry("matches the pattern", LogFilter{Pattern: regexp.MustCompile("error")}, true),
+		Entry("does not match other patterns", LogFilter{Pattern: regexp.MustCompile("^warning")}, false),
+		Entry("matches messages at or after since", LogFilter{Since: time.Unix(100, 0)}, true),
+		Entry("does not match messages before since", LogFilter{Since: time.Unix(101, 0)}, false),
+		Entry("requires every field to match", LogFilter{SourceTypes: []string{"APP"}, Type: "OUT"}, false),
+	)
+})
@@ -0,0 +1,41 @@
+package sharedaction
+
+import "code.cloudfoundry.org/cli/actor/actionerror"
+
+// DeleteProfile deletes the profile. The targeted profile cannot be deleted.
+func (actor Actor) DeleteProfile(name string) error {
+	if !actor.profileExists(name) {
+		return actionerror.ProfileNotFoundError{Name: name}
+	}
+
+	if actor.Config.CurrentProfile() == name {
+		return actionerror.ProfileTargetedError{Name: name}
+	}
+
+	actor.Config.DeleteProfile(name)
+	return nil
+}
+
+// RenameProfile renames the profile. The new name cannot be used by another
+// profile.
+func (actor Actor) RenameProfile(oldName string, newName string) error {
+	if !actor.profileExists(oldName) {
+		return actionerror.ProfileNotFoundError{Name: oldName}
+	}
+
+	if actor.profileExists(newName) {
+		return actionerror.ProfileAlreadyExistsError{Name: newName}
+	}
+
+	actor.Config.RenameProfile(oldName, newName)
+	return nil
+}
+
+func (actor Actor) profileExists(name string) bool {
+	for _, profile := range actor.Config.Profiles() {
+		if profile.Name == name {
+			return true
+		}
+	}
+	return false
+}
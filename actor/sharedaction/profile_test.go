@@ -0,0 +1,82 @@
+package sharedaction_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profile Actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *sharedactionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeConfig.CurrentProfileReturns("prod")
+		fakeConfig.ProfilesReturns([]configv3.Profile{
+			{Name: "prod"},
+			{Name: "staging"},
+		})
+		actor = NewActor(fakeConfig)
+	})
+
+	Describe("DeleteProfile", func() {
+		Context("when the profile exists and is not targeted", func() {
+			It("deletes the profile", func() {
+				Expect(actor.DeleteProfile("staging")).To(Succeed())
+				Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(1))
+				Expect(fakeConfig.DeleteProfileArgsForCall(0)).To(Equal("staging"))
+			})
+		})
+
+		Context("when the profile is targeted", func() {
+			It("returns a ProfileTargetedError", func() {
+				err := actor.DeleteProfile("prod")
+				Expect(err).To(MatchError(actionerror.ProfileTargetedError{Name: "prod"}))
+				Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the profile does not exist", func() {
+			It("returns a ProfileNotFoundError", func() {
+				err := actor.DeleteProfile("sandbox")
+				Expect(err).To(MatchError(actionerror.ProfileNotFoundError{Name: "sandbox"}))
+				Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RenameProfile", func() {
+		Context("when the profile exists and the new name is free", func() {
+			It("renames the profile", func() {
+				Expect(actor.RenameProfile("staging", "stage")).To(Succeed())
+				Expect(fakeConfig.RenameProfileCallCount()).To(Equal(1))
+				oldName, newName := fakeConfig.RenameProfileArgsForCall(0)
+				Expect(oldName).To(Equal("staging"))
+				Expect(newName).To(Equal("stage"))
+			})
+		})
+
+		Context("when the profile does not exist", func() {
+			It("returns a ProfileNotFoundError", func() {
+				err := actor.RenameProfile("sandbox", "stage")
+				Expect(err).To(MatchError(actionerror.ProfileNotFoundError{Name: "sandbox"}))
+				Expect(fakeConfig.RenameProfileCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the new name is taken", func() {
+			It("returns a ProfileAlreadyExistsError", func() {
+				err := actor.RenameProfile("staging", "prod")
+				Expect(err).To(MatchError(actionerror.ProfileAlreadyExistsError{Name: "prod"}))
+				Expect(fakeConfig.RenameProfileCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,13 +1,22 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 //go:generate counterfeiter . SecureShellClient
 
 type SecureShellClient interface {
 	Connect(username string, passcode string, sshEndpoint string, sshHostKeyFingerprint string, skipHostValidation bool) error
 	Close() error
+	CopyFromRemote(remotePath string, localPath string, recursive bool, progressBar clissh.ProgressBar) error
+	CopyToRemote(localPath string, remotePath string, recursive bool, progressBar clissh.ProgressBar) error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
+	DynamicPortForward(localAddresses []string) error
+	RunCommand(commands []string, stdout io.Writer, stderr io.Writer) (int, error)
 	Wait() error
 }
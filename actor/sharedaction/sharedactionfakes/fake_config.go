@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type FakeConfig struct {
@@ -26,6 +27,20 @@ type FakeConfig struct {
 	binaryNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CurrentProfileStub        func() string
+	currentProfileMutex       sync.RWMutex
+	currentProfileArgsForCall []struct{}
+	currentProfileReturns     struct {
+		result1 string
+	}
+	currentProfileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	DeleteProfileStub        func(name string)
+	deleteProfileMutex       sync.RWMutex
+	deleteProfileArgsForCall []struct {
+		name string
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct{}
@@ -44,6 +59,15 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ProfilesStub        func() []configv3.Profile
+	profilesMutex       sync.RWMutex
+	profilesArgsForCall []struct{}
+	profilesReturns     struct {
+		result1 []configv3.Profile
+	}
+	profilesReturnsOnCall map[int]struct {
+		result1 []configv3.Profile
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -53,6 +77,12 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RenameProfileStub        func(oldName string, newName string)
+	renameProfileMutex       sync.RWMutex
+	renameProfileArgsForCall []struct {
+		oldName string
+		newName string
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct{}
@@ -148,6 +178,70 @@ func (fake *FakeConfig) BinaryNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CurrentProfile() string {
+	fake.currentProfileMutex.Lock()
+	ret, specificReturn := fake.currentProfileReturnsOnCall[len(fake.currentProfileArgsForCall)]
+	fake.currentProfileArgsForCall = append(fake.currentProfileArgsForCall, struct{}{})
+	fake.recordInvocation("CurrentProfile", []interface{}{})
+	fake.currentProfileMutex.Unlock()
+	if fake.CurrentProfileStub != nil {
+		return fake.CurrentProfileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.currentProfileReturns.result1
+}
+
+func (fake *FakeConfig) CurrentProfileCallCount() int {
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
+	return len(fake.currentProfileArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentProfileReturns(result1 string) {
+	fake.CurrentProfileStub = nil
+	fake.currentProfileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentProfileReturnsOnCall(i int, result1 string) {
+	fake.CurrentProfileStub = nil
+	if fake.currentProfileReturnsOnCall == nil {
+		fake.currentProfileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentProfileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) DeleteProfile(name string) {
+	fake.deleteProfileMutex.Lock()
+	fake.deleteProfileArgsForCall = append(fake.deleteProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("DeleteProfile", []interface{}{name})
+	fake.deleteProfileMutex.Unlock()
+	if fake.DeleteProfileStub != nil {
+		fake.DeleteProfileStub(name)
+	}
+}
+
+func (fake *FakeConfig) DeleteProfileCallCount() int {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return len(fake.deleteProfileArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteProfileArgsForCall(i int) string {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return fake.deleteProfileArgsForCall[i].name
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -228,6 +322,46 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) Profiles() []configv3.Profile {
+	fake.profilesMutex.Lock()
+	ret, specificReturn := fake.profilesReturnsOnCall[len(fake.profilesArgsForCall)]
+	fake.profilesArgsForCall = append(fake.profilesArgsForCall, struct{}{})
+	fake.recordInvocation("Profiles", []interface{}{})
+	fake.profilesMutex.Unlock()
+	if fake.ProfilesStub != nil {
+		return fake.ProfilesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.profilesReturns.result1
+}
+
+func (fake *FakeConfig) ProfilesCallCount() int {
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
+	return len(fake.profilesArgsForCall)
+}
+
+func (fake *FakeConfig) ProfilesReturns(result1 []configv3.Profile) {
+	fake.ProfilesStub = nil
+	fake.profilesReturns = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfilesReturnsOnCall(i int, result1 []configv3.Profile) {
+	fake.ProfilesStub = nil
+	if fake.profilesReturnsOnCall == nil {
+		fake.profilesReturnsOnCall = make(map[int]struct {
+			result1 []configv3.Profile
+		})
+	}
+	fake.profilesReturnsOnCall[i] = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -268,6 +402,31 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RenameProfile(oldName string, newName string) {
+	fake.renameProfileMutex.Lock()
+	fake.renameProfileArgsForCall = append(fake.renameProfileArgsForCall, struct {
+		oldName string
+		newName string
+	}{oldName, newName})
+	fake.recordInvocation("RenameProfile", []interface{}{oldName, newName})
+	fake.renameProfileMutex.Unlock()
+	if fake.RenameProfileStub != nil {
+		fake.RenameProfileStub(oldName, newName)
+	}
+}
+
+func (fake *FakeConfig) RenameProfileCallCount() int {
+	fake.renameProfileMutex.RLock()
+	defer fake.renameProfileMutex.RUnlock()
+	return len(fake.renameProfileArgsForCall)
+}
+
+func (fake *FakeConfig) RenameProfileArgsForCall(i int) (string, string) {
+	fake.renameProfileMutex.RLock()
+	defer fake.renameProfileMutex.RUnlock()
+	return fake.renameProfileArgsForCall[i].oldName, fake.renameProfileArgsForCall[i].newName
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -318,12 +477,20 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.accessTokenMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.renameProfileMutex.RLock()
+	defer fake.renameProfileMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -2,6 +2,7 @@
 package sharedactionfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -33,6 +34,34 @@ type FakeSecureShellClient struct {
 	closeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CopyFromRemoteStub        func(remotePath string, localPath string, recursive bool, progressBar clissh.ProgressBar) error
+	copyFromRemoteMutex       sync.RWMutex
+	copyFromRemoteArgsForCall []struct {
+		remotePath  string
+		localPath   string
+		recursive   bool
+		progressBar clissh.ProgressBar
+	}
+	copyFromRemoteReturns struct {
+		result1 error
+	}
+	copyFromRemoteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CopyToRemoteStub        func(localPath string, remotePath string, recursive bool, progressBar clissh.ProgressBar) error
+	copyToRemoteMutex       sync.RWMutex
+	copyToRemoteArgsForCall []struct {
+		localPath   string
+		remotePath  string
+		recursive   bool
+		progressBar clissh.ProgressBar
+	}
+	copyToRemoteReturns struct {
+		result1 error
+	}
+	copyToRemoteReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func(commands []string, terminalRequest clissh.TTYRequest) error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct {
@@ -56,6 +85,43 @@ type FakeSecureShellClient struct {
 	localPortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemotePortForwardStub        func(remotePortForwardSpecs []clissh.RemotePortForward) error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct {
+		remotePortForwardSpecs []clissh.RemotePortForward
+	}
+	remotePortForwardReturns struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func(localAddresses []string) error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct {
+		localAddresses []string
+	}
+	dynamicPortForwardReturns struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCommandStub        func(commands []string, stdout io.Writer, stderr io.Writer) (int, error)
+	runCommandMutex       sync.RWMutex
+	runCommandArgsForCall []struct {
+		commands []string
+		stdout   io.Writer
+		stderr   io.Writer
+	}
+	runCommandReturns struct {
+		result1 int
+		result2 error
+	}
+	runCommandReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
@@ -161,6 +227,108 @@ func (fake *FakeSecureShellClient) CloseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) CopyFromRemote(remotePath string, localPath string, recursive bool, progressBar clissh.ProgressBar) error {
+	fake.copyFromRemoteMutex.Lock()
+	ret, specificReturn := fake.copyFromRemoteReturnsOnCall[len(fake.copyFromRemoteArgsForCall)]
+	fake.copyFromRemoteArgsForCall = append(fake.copyFromRemoteArgsForCall, struct {
+		remotePath  string
+		localPath   string
+		recursive   bool
+		progressBar clissh.ProgressBar
+	}{remotePath, localPath, recursive, progressBar})
+	fake.recordInvocation("CopyFromRemote", []interface{}{remotePath, localPath, recursive, progressBar})
+	fake.copyFromRemoteMutex.Unlock()
+	if fake.CopyFromRemoteStub != nil {
+		return fake.CopyFromRemoteStub(remotePath, localPath, recursive, progressBar)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.copyFromRemoteReturns.result1
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteCallCount() int {
+	fake.copyFromRemoteMutex.RLock()
+	defer fake.copyFromRemoteMutex.RUnlock()
+	return len(fake.copyFromRemoteArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteArgsForCall(i int) (string, string, bool, clissh.ProgressBar) {
+	fake.copyFromRemoteMutex.RLock()
+	defer fake.copyFromRemoteMutex.RUnlock()
+	return fake.copyFromRemoteArgsForCall[i].remotePath, fake.copyFromRemoteArgsForCall[i].localPath, fake.copyFromRemoteArgsForCall[i].recursive, fake.copyFromRemoteArgsForCall[i].progressBar
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteReturns(result1 error) {
+	fake.CopyFromRemoteStub = nil
+	fake.copyFromRemoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteReturnsOnCall(i int, result1 error) {
+	fake.CopyFromRemoteStub = nil
+	if fake.copyFromRemoteReturnsOnCall == nil {
+		fake.copyFromRemoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyFromRemoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) CopyToRemote(localPath string, remotePath string, recursive bool, progressBar clissh.ProgressBar) error {
+	fake.copyToRemoteMutex.Lock()
+	ret, specificReturn := fake.copyToRemoteReturnsOnCall[len(fake.copyToRemoteArgsForCall)]
+	fake.copyToRemoteArgsForCall = append(fake.copyToRemoteArgsForCall, struct {
+		localPath   string
+		remotePath  string
+		recursive   bool
+		progressBar clissh.ProgressBar
+	}{localPath, remotePath, recursive, progressBar})
+	fake.recordInvocation("CopyToRemote", []interface{}{localPath, remotePath, recursive, progressBar})
+	fake.copyToRemoteMutex.Unlock()
+	if fake.CopyToRemoteStub != nil {
+		return fake.CopyToRemoteStub(localPath, remotePath, recursive, progressBar)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.copyToRemoteReturns.result1
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteCallCount() int {
+	fake.copyToRemoteMutex.RLock()
+	defer fake.copyToRemoteMutex.RUnlock()
+	return len(fake.copyToRemoteArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteArgsForCall(i int) (string, string, bool, clissh.ProgressBar) {
+	fake.copyToRemoteMutex.RLock()
+	defer fake.copyToRemoteMutex.RUnlock()
+	return fake.copyToRemoteArgsForCall[i].localPath, fake.copyToRemoteArgsForCall[i].remotePath, fake.copyToRemoteArgsForCall[i].recursive, fake.copyToRemoteArgsForCall[i].progressBar
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteReturns(result1 error) {
+	fake.CopyToRemoteStub = nil
+	fake.copyToRemoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteReturnsOnCall(i int, result1 error) {
+	fake.CopyToRemoteStub = nil
+	if fake.copyToRemoteReturnsOnCall == nil {
+		fake.copyToRemoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyToRemoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error {
 	var commandsCopy []string
 	if commands != nil {
@@ -268,6 +436,170 @@ func (fake *FakeSecureShellClient) LocalPortForwardReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error {
+	var remotePortForwardSpecsCopy []clissh.RemotePortForward
+	if remotePortForwardSpecs != nil {
+		remotePortForwardSpecsCopy = make([]clissh.RemotePortForward, len(remotePortForwardSpecs))
+		copy(remotePortForwardSpecsCopy, remotePortForwardSpecs)
+	}
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct {
+		remotePortForwardSpecs []clissh.RemotePortForward
+	}{remotePortForwardSpecsCopy})
+	fake.recordInvocation("RemotePortForward", []interface{}{remotePortForwardSpecsCopy})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub(remotePortForwardSpecs)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.remotePortForwardReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardArgsForCall(i int) []clissh.RemotePortForward {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return fake.remotePortForwardArgsForCall[i].remotePortForwardSpecs
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForward(localAddresses []string) error {
+	var localAddressesCopy []string
+	if localAddresses != nil {
+		localAddressesCopy = make([]string, len(localAddresses))
+		copy(localAddressesCopy, localAddresses)
+	}
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct {
+		localAddresses []string
+	}{localAddressesCopy})
+	fake.recordInvocation("DynamicPortForward", []interface{}{localAddressesCopy})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub(localAddresses)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dynamicPortForwardReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardArgsForCall(i int) []string {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return fake.dynamicPortForwardArgsForCall[i].localAddresses
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturns(result1 error) {
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RunCommand(commands []string, stdout io.Writer, stderr io.Writer) (int, error) {
+	var commandsCopy []string
+	if commands != nil {
+		commandsCopy = make([]string, len(commands))
+		copy(commandsCopy, commands)
+	}
+	fake.runCommandMutex.Lock()
+	ret, specificReturn := fake.runCommandReturnsOnCall[len(fake.runCommandArgsForCall)]
+	fake.runCommandArgsForCall = append(fake.runCommandArgsForCall, struct {
+		commands []string
+		stdout   io.Writer
+		stderr   io.Writer
+	}{commandsCopy, stdout, stderr})
+	fake.recordInvocation("RunCommand", []interface{}{commandsCopy, stdout, stderr})
+	fake.runCommandMutex.Unlock()
+	if fake.RunCommandStub != nil {
+		return fake.RunCommandStub(commands, stdout, stderr)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.runCommandReturns.result1, fake.runCommandReturns.result2
+}
+
+func (fake *FakeSecureShellClient) RunCommandCallCount() int {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return len(fake.runCommandArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RunCommandArgsForCall(i int) ([]string, io.Writer, io.Writer) {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return fake.runCommandArgsForCall[i].commands, fake.runCommandArgsForCall[i].stdout, fake.runCommandArgsForCall[i].stderr
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturns(result1 int, result2 error) {
+	fake.RunCommandStub = nil
+	fake.runCommandReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturnsOnCall(i int, result1 int, result2 error) {
+	fake.RunCommandStub = nil
+	if fake.runCommandReturnsOnCall == nil {
+		fake.runCommandReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.runCommandReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureShellClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
@@ -315,10 +647,20 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.connectMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.copyFromRemoteMutex.RLock()
+	defer fake.copyFromRemoteMutex.RUnlock()
+	fake.copyToRemoteMutex.RLock()
+	defer fake.copyToRemoteMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
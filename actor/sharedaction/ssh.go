@@ -1,6 +1,11 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -13,6 +18,8 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
 type SSHOptions struct {
 	Commands              []string
 	Username              string
@@ -23,6 +30,11 @@ type SSHOptions struct {
 	SkipRemoteExecution   bool
 	TTYOption             TTYOption
 	LocalPortForwardSpecs []LocalPortForward
+	// RemotePortForwardSpecs listen in the app instance and connect from the
+	// local machine.
+	RemotePortForwardSpecs []RemotePortForward
+	// DynamicPortForwardAddresses are local addresses to run SOCKS proxies on.
+	DynamicPortForwardAddresses []string
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +49,16 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(sshOptions.DynamicPortForwardAddresses)
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -45,6 +67,101 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// SecureCopyOptions describe a copy between the local machine and an app
+// instance. When ToRemote is true Source is a local path and Destination is a
+// path in the app instance, otherwise the reverse.
+type SecureCopyOptions struct {
+	Source      string
+	Destination string
+	ToRemote    bool
+	Recursive   bool
+}
+
+func (actor Actor) ExecuteSecureCopy(sshClient SecureShellClient, sshOptions SSHOptions, copyOptions SecureCopyOptions, progressBar clissh.ProgressBar) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if copyOptions.ToRemote {
+		return sshClient.CopyToRemote(copyOptions.Source, copyOptions.Destination, copyOptions.Recursive, progressBar)
+	}
+	return sshClient.CopyFromRemote(copyOptions.Source, copyOptions.Destination, copyOptions.Recursive, progressBar)
+}
+
+// InstanceCommandOptions describe running a command on one app instance,
+// with its output copied to Stdout and Stderr. When GetPasscode is set, it is
+// called just before connecting and its passcode replaces
+// SSHOptions.Passcode, so one time passcodes are not spent on instances that
+// are still waiting for a worker.
+type InstanceCommandOptions struct {
+	InstanceIndex uint
+	SSHOptions    SSHOptions
+	GetPasscode   func() (string, error)
+	Stdout        io.Writer
+	Stderr        io.Writer
+}
+
+// InstanceCommandResult is the outcome of running a command on one app
+// instance. Err is set when the command could not be run to completion.
+type InstanceCommandResult struct {
+	InstanceIndex uint
+	ExitStatus    int
+	Err           error
+}
+
+// ExecuteSecureShellCommandOnInstances runs each instance's commands over its
+// own SSH client, with at most maxInFlight sessions open at once. The results
+// are returned in the same order as instances.
+func (actor Actor) ExecuteSecureShellCommandOnInstances(newSSHClient func() SecureShellClient, instances []InstanceCommandOptions, maxInFlight int) []InstanceCommandResult {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	results := make([]InstanceCommandResult, len(instances))
+	semaphore := make(chan struct{}, maxInFlight)
+	wg := &sync.WaitGroup{}
+
+	for i, instance := range instances {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, instance InstanceCommandOptions) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			exitStatus, err := actor.executeSecureShellCommand(newSSHClient(), instance)
+			results[i] = InstanceCommandResult{
+				InstanceIndex: instance.InstanceIndex,
+				ExitStatus:    exitStatus,
+				Err:           err,
+			}
+		}(i, instance)
+	}
+
+	wg.Wait()
+	return results
+}
+
+func (Actor) executeSecureShellCommand(sshClient SecureShellClient, instance InstanceCommandOptions) (int, error) {
+	sshOptions := instance.SSHOptions
+	if instance.GetPasscode != nil {
+		passcode, err := instance.GetPasscode()
+		if err != nil {
+			return 0, err
+		}
+		sshOptions.Passcode = passcode
+	}
+
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return 0, err
+	}
+	defer sshClient.Close()
+
+	return sshClient.RunCommand(sshOptions.Commands, instance.Stdout, instance.Stderr)
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -54,3 +171,13 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
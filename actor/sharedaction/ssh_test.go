@@ -2,10 +2,15 @@ package sharedaction_test
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -96,6 +101,50 @@ var _ = Describe("SSH Actions", func() {
 
 				It("returns the error", func() {
 					Expect(executeErr).To(MatchError("some-forwarding-error"))
+					Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when remote and dynamic port forwarding are requested", func() {
+				BeforeEach(func() {
+					sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+						{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"},
+					}
+					sshOptions.DynamicPortForwardAddresses = []string{"local-address-2"}
+				})
+
+				It("forwards the remote ports and starts the SOCKS proxies", func() {
+					Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+						[]clissh.RemotePortForward{
+							{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"},
+						},
+					))
+
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal([]string{"local-address-2"}))
+				})
+
+				Context("when remote port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+						Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when dynamic port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+						Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
+					})
 				})
 			})
 
@@ -163,4 +212,220 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureShellCommandOnInstances", func() {
+		var (
+			instances   []InstanceCommandOptions
+			maxInFlight int
+			clients     []*sharedactionfakes.FakeSecureShellClient
+			clientsLock sync.Mutex
+			results     []InstanceCommandResult
+		)
+
+		BeforeEach(func() {
+			maxInFlight = 2
+			clients = nil
+			instances = nil
+			for i := uint(0); i < 3; i++ {
+				instances = append(instances, InstanceCommandOptions{
+					InstanceIndex: i,
+					SSHOptions: SSHOptions{
+						Commands:           []string{"some-command"},
+						Username:           fmt.Sprintf("some-user/%d", i),
+						Passcode:           "some-passcode",
+						Endpoint:           "some-endpoint",
+						HostKeyFingerprint: "some-fingerprint",
+					},
+				})
+			}
+		})
+
+		JustBeforeEach(func() {
+			results = actor.ExecuteSecureShellCommandOnInstances(func() SecureShellClient {
+				clientsLock.Lock()
+				defer clientsLock.Unlock()
+
+				client := new(sharedactionfakes.FakeSecureShellClient)
+				client.ConnectStub = func(username string, _ string, _ string, _ string, _ bool) error {
+					if username == "some-user/1" {
+						return errors.New("some-connect-error")
+					}
+					return nil
+				}
+				client.RunCommandStub = func(_ []string, _ io.Writer, _ io.Writer) (int, error) {
+					username, _, _, _, _ := client.ConnectArgsForCall(0)
+					if username == "some-user/2" {
+						return 3, nil
+					}
+					return 0, nil
+				}
+				clients = append(clients, client)
+				return client
+			}, instances, maxInFlight)
+		})
+
+		It("runs the commands on every instance with its own client", func() {
+			Expect(clients).To(HaveLen(3))
+			for _, client := range clients {
+				Expect(client.ConnectCallCount()).To(Equal(1))
+			}
+		})
+
+		It("returns each instance's exit status or error in the order given", func() {
+			Expect(results).To(Equal([]InstanceCommandResult{
+				{InstanceIndex: 0, ExitStatus: 0},
+				{InstanceIndex: 1, Err: errors.New("some-connect-error")},
+				{InstanceIndex: 2, ExitStatus: 3},
+			}))
+		})
+
+		It("only closes the clients that connected", func() {
+			var closed int
+			for _, client := range clients {
+				closed += client.CloseCallCount()
+				if client.CloseCallCount() == 1 {
+					commands, _, _ := client.RunCommandArgsForCall(0)
+					Expect(commands).To(Equal([]string{"some-command"}))
+				}
+			}
+			Expect(closed).To(Equal(2))
+		})
+
+		Context("when the instances fetch their own passcodes", func() {
+			var passcodesFetched int32
+
+			BeforeEach(func() {
+				passcodesFetched = 0
+				for i := range instances {
+					index := i
+					instances[i].SSHOptions.Passcode = ""
+					instances[i].GetPasscode = func() (string, error) {
+						atomic.AddInt32(&passcodesFetched, 1)
+						if index == 2 {
+							return "", errors.New("some-passcode-error")
+						}
+						return fmt.Sprintf("some-passcode-%d", index), nil
+					}
+				}
+			})
+
+			It("fetches a passcode for each instance right before connecting to it", func() {
+				Expect(atomic.LoadInt32(&passcodesFetched)).To(BeEquivalentTo(3))
+
+				var passcodes []string
+				for _, client := range clients {
+					for i := 0; i < client.ConnectCallCount(); i++ {
+						_, passcode, _, _, _ := client.ConnectArgsForCall(i)
+						passcodes = append(passcodes, passcode)
+					}
+				}
+				Expect(passcodes).To(ConsistOf("some-passcode-0", "some-passcode-1"))
+			})
+
+			It("returns the passcode error for that instance without connecting", func() {
+				Expect(results[2]).To(Equal(InstanceCommandResult{InstanceIndex: 2, Err: errors.New("some-passcode-error")}))
+
+				var connects int
+				for _, client := range clients {
+					connects += client.ConnectCallCount()
+				}
+				Expect(connects).To(Equal(2))
+			})
+		})
+	})
+
+	Describe("ExecuteSecureCopy", func() {
+		var (
+			sshOptions      SSHOptions
+			copyOptions     SecureCopyOptions
+			fakeProgressBar *clisshfakes.FakeProgressBar
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+			}
+			copyOptions = SecureCopyOptions{
+				Source:      "some-source",
+				Destination: "some-destination",
+				Recursive:   true,
+			}
+			fakeProgressBar = new(clisshfakes.FakeProgressBar)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureCopy(fakeSecureShellClient, sshOptions, copyOptions, fakeProgressBar)
+		})
+
+		It("calls connect with the provided authorization info", func() {
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeFalse())
+		})
+
+		Context("when connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without copying", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when copying from the app instance", func() {
+			It("copies the remote path to the local path and closes the connection", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(1))
+				remotePath, localPath, recursive, progressBar := fakeSecureShellClient.CopyFromRemoteArgsForCall(0)
+				Expect(remotePath).To(Equal("some-source"))
+				Expect(localPath).To(Equal("some-destination"))
+				Expect(recursive).To(BeTrue())
+				Expect(progressBar).To(Equal(fakeProgressBar))
+
+				Expect(fakeSecureShellClient.CopyToRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+
+			Context("when copying fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.CopyFromRemoteReturns(errors.New("some-copy-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-copy-error"))
+					Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when copying to the app instance", func() {
+			BeforeEach(func() {
+				copyOptions.ToRemote = true
+			})
+
+			It("copies the local path to the remote path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSecureShellClient.CopyToRemoteCallCount()).To(Equal(1))
+				localPath, remotePath, recursive, _ := fakeSecureShellClient.CopyToRemoteArgsForCall(0)
+				Expect(localPath).To(Equal("some-source"))
+				Expect(remotePath).To(Equal("some-destination"))
+				Expect(recursive).To(BeTrue())
+
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
@@ -72,3 +73,72 @@ func (actor Actor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID st
 
 	return applicationSummary, allWarnings, nil
 }
+
+// routeGUIDsPerRouteMappingsRequest is the maximum number of route GUIDs
+// filtered on in a single route mappings request, keeping the request URL
+// well below the length Cloud Controller and intermediate proxies accept.
+const routeGUIDsPerRouteMappingsRequest = 50
+
+// GetApplicationSummariesBySpace returns back a summary of every application
+// in the space with its routes. The space's routes and their mappings are
+// requested once for all the applications; instances and stacks are not
+// looked up.
+func (actor Actor) GetApplicationSummariesBySpace(spaceGUID string) ([]ApplicationSummary, Warnings, error) {
+	apps, allWarnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	routes, warnings, err := actor.GetSpaceRoutes(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	routesByGUID := map[string]Route{}
+	var routeGUIDs []string
+	for _, route := range routes {
+		routesByGUID[route.GUID] = route
+		routeGUIDs = append(routeGUIDs, route.GUID)
+	}
+
+	routesByApp := map[string][]Route{}
+	mapped := map[ccv2.RouteMapping]bool{}
+	for start := 0; start < len(routeGUIDs); start += routeGUIDsPerRouteMappingsRequest {
+		end := start + routeGUIDsPerRouteMappingsRequest
+		if end > len(routeGUIDs) {
+			end = len(routeGUIDs)
+		}
+
+		routeMappings, warnings, err := actor.CloudControllerClient.GetRouteMappings(ccv2.Filter{
+			Type:     constant.RouteGUIDFilter,
+			Operator: constant.InOperator,
+			Values:   routeGUIDs[start:end],
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, routeMapping := range routeMappings {
+			// An app can be mapped to the same route on several ports; list the
+			// route once.
+			key := ccv2.RouteMapping{AppGUID: routeMapping.AppGUID, RouteGUID: routeMapping.RouteGUID}
+			if mapped[key] {
+				continue
+			}
+			mapped[key] = true
+			routesByApp[routeMapping.AppGUID] = append(routesByApp[routeMapping.AppGUID], routesByGUID[routeMapping.RouteGUID])
+		}
+	}
+
+	var summaries []ApplicationSummary
+	for _, app := range apps {
+		summaries = append(summaries, ApplicationSummary{
+			Application: app,
+			Routes:      routesByApp[app.GUID],
+		})
+	}
+
+	return summaries, allWarnings, nil
+}
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
@@ -240,4 +241,132 @@ var _ = Describe("Application Summary Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationSummariesBySpace", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+			summaries                 []ApplicationSummary
+			warnings                  Warnings
+			executeErr                error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "app-guid-1", Name: "app-1"}, {GUID: "app-guid-2", Name: "app-2"}},
+				ccv2.Warnings{"app-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceRoutesReturns(
+				[]ccv2.Route{
+					{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-guid"},
+					{GUID: "route-guid-2", Host: "host-2", DomainGUID: "domain-guid"},
+				},
+				ccv2.Warnings{"route-warning"},
+				nil)
+			fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "example.com"}, nil, nil)
+			fakeCloudControllerClient.GetRouteMappingsReturns(
+				[]ccv2.RouteMapping{
+					{AppGUID: "app-guid-1", RouteGUID: "route-guid-1"},
+					{AppGUID: "app-guid-1", RouteGUID: "route-guid-2"},
+					{AppGUID: "app-guid-1", RouteGUID: "route-guid-2"},
+				},
+				ccv2.Warnings{"route-mapping-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetApplicationSummariesBySpace("some-space-guid")
+		})
+
+		It("returns each app with its routes from one request per resource", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("app-warning", "route-warning", "route-mapping-warning"))
+
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[0].Name).To(Equal("app-1"))
+			Expect(summaries[0].Routes).To(HaveLen(2))
+			Expect(summaries[0].Routes[0].String()).To(Equal("host-1.example.com"))
+			Expect(summaries[0].Routes[1].String()).To(Equal("host-2.example.com"))
+			Expect(summaries[1].Name).To(Equal("app-2"))
+			Expect(summaries[1].Routes).To(BeEmpty())
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpaceRoutesCallCount()).To(Equal(1))
+			spaceGUID, _ := fakeCloudControllerClient.GetSpaceRoutesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.RouteGUIDFilter,
+				Operator: constant.InOperator,
+				Values:   []string{"route-guid-1", "route-guid-2"},
+			}))
+			Expect(fakeCloudControllerClient.GetApplicationRoutesCallCount()).To(Equal(0))
+		})
+
+		Context("when the space has more routes than fit in one request", func() {
+			BeforeEach(func() {
+				var routes []ccv2.Route
+				for i := 0; i < 51; i++ {
+					routes = append(routes, ccv2.Route{GUID: fmt.Sprintf("route-guid-%d", i), DomainGUID: "domain-guid"})
+				}
+				fakeCloudControllerClient.GetSpaceRoutesReturns(routes, nil, nil)
+			})
+
+			It("requests the route mappings in batches", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)[0].Values).To(HaveLen(50))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(1)[0].Values).To(Equal([]string{"route-guid-50"}))
+			})
+		})
+
+		Context("when the space has no routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, nil, nil)
+			})
+
+			It("does not request route mappings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(2))
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"app-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("app-warning"))
+			})
+		})
+
+		Context("when getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"route-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("app-warning", "route-warning"))
+			})
+		})
+
+		Context("when getting the route mappings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteMappingsReturns(nil, ccv2.Warnings{"route-mapping-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("app-warning", "route-warning", "route-mapping-warning"))
+			})
+		})
+	})
 })
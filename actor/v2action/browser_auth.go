@@ -0,0 +1,136 @@
+package v2action
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// BrowserAuthentication is an authorization code login that is waiting for
+// the user to log in with a browser.
+type BrowserAuthentication struct {
+	// URL is the UAA page the user logs in on.
+	URL string
+
+	listener     net.Listener
+	redirectURI  string
+	codeVerifier string
+	state        string
+}
+
+// StartBrowserAuthentication listens for the authorization code redirect on a
+// loopback address and returns the URL the user needs to open to log in. The
+// authorization code is protected with PKCE, so it is useless to anyone who
+// intercepts the redirect.
+func (actor Actor) StartBrowserAuthentication() (BrowserAuthentication, error) {
+	if actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return BrowserAuthentication{}, actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	codeVerifier, err := generateAuthenticationSecret()
+	if err != nil {
+		return BrowserAuthentication{}, err
+	}
+
+	state, err := generateAuthenticationSecret()
+	if err != nil {
+		return BrowserAuthentication{}, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return BrowserAuthentication{}, err
+	}
+
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr())
+	challenge := sha256.Sum256([]byte(codeVerifier))
+
+	authorizeURL, err := actor.UAAClient.AuthorizationCodeURL(redirectURI, base64.RawURLEncoding.EncodeToString(challenge[:]), state)
+	if err != nil {
+		_ = listener.Close()
+		return BrowserAuthentication{}, err
+	}
+
+	return BrowserAuthentication{
+		URL:          authorizeURL,
+		listener:     listener,
+		redirectURI:  redirectURI,
+		codeVerifier: codeVerifier,
+		state:        state,
+	}, nil
+}
+
+// CompleteBrowserAuthentication waits for UAA to redirect the browser back to
+// the CLI, exchanges the authorization code for tokens and sets them in the
+// config. Redirects that do not carry the state sent to UAA are ignored.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) CompleteBrowserAuthentication(auth BrowserAuthentication) error {
+	type callbackResult struct {
+		code string
+		err  error
+	}
+	results := make(chan callbackResult, 1)
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if r.URL.Path != "/callback" || query.Get("state") != auth.state {
+				http.NotFound(w, r)
+				return
+			}
+
+			if query.Get("error") != "" {
+				fmt.Fprintln(w, "Login failed. You can close this window.")
+				results <- callbackResult{err: actionerror.LoginDeniedError{}}
+				return
+			}
+
+			fmt.Fprintln(w, "Login complete. You can close this window and return to the CLI.")
+			results <- callbackResult{code: query.Get("code")}
+		}),
+	}
+	go server.Serve(auth.listener)
+	defer server.Close()
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	var result callbackResult
+	select {
+	case result = <-results:
+	case <-time.After(actor.Config.OverallPollingTimeout()):
+		result.err = actionerror.LoginTimeoutError{}
+	}
+	if result.err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return result.err
+	}
+
+	accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithAuthorizationCode(result.code, auth.codeVerifier, auth.redirectURI)
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	actor.Config.SetTokenInformation(fmt.Sprintf("bearer %s", accessToken), refreshToken, "")
+	return nil
+}
+
+// generateAuthenticationSecret returns a random, URL safe string that is
+// suitable as a PKCE code verifier or an OAuth state.
+func generateAuthenticationSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
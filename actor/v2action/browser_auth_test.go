@@ -0,0 +1,129 @@
+package v2action_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Browser Auth Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v2actionfakes.FakeUAAClient
+		fakeConfig    *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeUAAClient, fakeConfig)
+
+		fakeUAAClient.AuthorizationCodeURLReturns("https://uaa.example.com/oauth/authorize?some=params", nil)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+	})
+
+	Describe("StartBrowserAuthentication", func() {
+		It("returns the UAA authorize URL for a loopback redirect", func() {
+			auth, err := actor.StartBrowserAuthentication()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth.URL).To(Equal("https://uaa.example.com/oauth/authorize?some=params"))
+
+			Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(1))
+			redirectURI, codeChallenge, state := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+			Expect(codeChallenge).To(MatchRegexp(`^[A-Za-z0-9_-]{43}$`))
+			Expect(state).To(MatchRegexp(`^[A-Za-z0-9_-]{43}$`))
+		})
+
+		Context("when a client credentials user is logged in", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				_, err := actor.StartBrowserAuthentication()
+				Expect(err).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+			})
+		})
+	})
+
+	Describe("CompleteBrowserAuthentication", func() {
+		var (
+			auth        BrowserAuthentication
+			redirectURI string
+			state       string
+			completed   chan error
+		)
+
+		BeforeEach(func() {
+			var err error
+			auth, err = actor.StartBrowserAuthentication()
+			Expect(err).ToNot(HaveOccurred())
+			redirectURI, _, state = fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+
+			completed = make(chan error, 1)
+			go func() {
+				completed <- actor.CompleteBrowserAuthentication(auth)
+			}()
+		})
+
+		redirect := func(query url.Values) string {
+			response, err := http.Get(redirectURI + "?" + query.Encode())
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return string(body)
+		}
+
+		Context("when UAA redirects with an authorization code", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithAuthorizationCodeReturns("some-access-token", "some-refresh-token", nil)
+			})
+
+			It("exchanges the code and sets the tokens", func() {
+				Expect(redirect(url.Values{"code": {"some-code"}, "state": {state}})).To(ContainSubstring("Login complete"))
+				Eventually(completed).Should(Receive(BeNil()))
+
+				Expect(fakeUAAClient.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(1))
+				code, codeVerifier, passedRedirectURI := fakeUAAClient.AuthenticateWithAuthorizationCodeArgsForCall(0)
+				Expect(code).To(Equal("some-code"))
+				Expect(codeVerifier).To(MatchRegexp(`^[A-Za-z0-9_-]{43}$`))
+				Expect(passedRedirectURI).To(Equal(redirectURI))
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+			})
+		})
+
+		Context("when the redirect does not carry the state", func() {
+			It("ignores the redirect", func() {
+				redirect(url.Values{"code": {"some-code"}, "state": {"some-other-state"}})
+				Consistently(completed).ShouldNot(Receive())
+
+				redirect(url.Values{"code": {"some-code"}, "state": {state}})
+				Eventually(completed).Should(Receive(BeNil()))
+				Expect(fakeUAAClient.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user denies the login", func() {
+			It("returns a LoginDeniedError", func() {
+				redirect(url.Values{"error": {"access_denied"}, "state": {state}})
+				Eventually(completed).Should(Receive(MatchError(actionerror.LoginDeniedError{})))
+				Expect(fakeUAAClient.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"io"
+	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
@@ -35,6 +36,7 @@ type CloudControllerClient interface {
 	GetOrganizations(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetRouteApplications(routeGUID string, filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error)
+	GetRouteMappings(filters ...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error)
 	GetRoutes(filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
 	GetSecurityGroupSpaces(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSecurityGroupStagingSpaces(securityGroupGUID string) ([]ccv2.Space, ccv2.Warnings, error)
@@ -58,6 +60,7 @@ type CloudControllerClient interface {
 	GetStack(guid string) (ccv2.Stack, ccv2.Warnings, error)
 	GetStacks(filters ...ccv2.Filter) ([]ccv2.Stack, ccv2.Warnings, error)
 	GetUserProvidedServiceInstanceServiceBindings(userProvidedServiceInstanceGUID string) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
+	MakeRawRequest(method string, path string, headers http.Header, body io.ReadSeeker) ([]byte, *http.Response, ccv2.Warnings, error)
 	PollJob(job ccv2.Job) (ccv2.Warnings, error)
 	RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
@@ -0,0 +1,126 @@
+package v2action
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// curlPage represents the pagination information and resources of a V2 or V3
+// paginated response.
+type curlPage struct {
+	NextURL    string `json:"next_url"`
+	Pagination struct {
+		Next struct {
+			Href string `json:"href"`
+		} `json:"next"`
+	} `json:"pagination"`
+	Resources []json.RawMessage `json:"resources"`
+}
+
+func (page curlPage) nextPath() string {
+	if page.NextURL != "" {
+		return page.NextURL
+	}
+	return page.Pagination.Next.Href
+}
+
+// MakeCurlRequest sends a request to the provided Cloud Controller path and
+// returns the unprocessed response body and HTTP response. When httpMethod is
+// empty, a POST is made if httpData is provided and a GET otherwise. Responses
+// with 4xx and 5xx status codes are only treated as an error if
+// failOnHTTPError is set.
+func (actor Actor) MakeCurlRequest(httpMethod string, path string, customHeaders []string, httpData string, failOnHTTPError bool) ([]byte, *http.Response, Warnings, error) {
+	if httpMethod == "" {
+		if httpData != "" {
+			httpMethod = http.MethodPost
+		} else {
+			httpMethod = http.MethodGet
+		}
+	}
+
+	if parsedURL, err := url.Parse(path); err != nil || !parsedURL.IsAbs() {
+		path = "/" + strings.TrimLeft(path, "/")
+	}
+
+	headers, err := parseCurlHeaders(customHeaders)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var body io.ReadSeeker
+	if httpData != "" {
+		body = bytes.NewReader([]byte(httpData))
+	}
+
+	responseBody, response, warnings, err := actor.CloudControllerClient.MakeRawRequest(httpMethod, path, headers, body)
+	if response != nil && response.StatusCode >= http.StatusBadRequest {
+		if failOnHTTPError {
+			return responseBody, response, Warnings(warnings), actionerror.HTTPStatusError{StatusCode: response.StatusCode}
+		}
+		return responseBody, response, Warnings(warnings), nil
+	}
+
+	return responseBody, response, Warnings(warnings), err
+}
+
+// MakePaginatedCurlRequest sends a GET request to the provided Cloud
+// Controller path and follows the V2 'next_url' and V3 'pagination.next.href'
+// links, merging the resources of every page into a single JSON array. The
+// HTTP response of the last request made is returned. Responses that are not
+// paginated are returned unmodified. Like the first request, next links that
+// are not on the targeted API are rejected by the Cloud Controller client.
+func (actor Actor) MakePaginatedCurlRequest(path string, customHeaders []string, failOnHTTPError bool) ([]byte, *http.Response, Warnings, error) {
+	var (
+		allWarnings  Warnings
+		lastResponse *http.Response
+	)
+	resources := []json.RawMessage{}
+
+	for firstPage := true; path != ""; firstPage = false {
+		responseBody, response, warnings, err := actor.MakeCurlRequest(http.MethodGet, path, customHeaders, "", failOnHTTPError)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil || response.StatusCode >= http.StatusBadRequest {
+			return responseBody, response, allWarnings, err
+		}
+		lastResponse = response
+
+		var page curlPage
+		err = json.Unmarshal(responseBody, &page)
+		if firstPage && (err != nil || page.Resources == nil) {
+			return responseBody, response, allWarnings, nil
+		}
+		if err != nil {
+			return nil, response, allWarnings, err
+		}
+
+		resources = append(resources, page.Resources...)
+		path = page.nextPath()
+	}
+
+	mergedResources, err := json.Marshal(resources)
+	return mergedResources, lastResponse, allWarnings, err
+}
+
+func parseCurlHeaders(customHeaders []string) (http.Header, error) {
+	headers := http.Header{}
+	for _, customHeader := range customHeaders {
+		reader := textproto.NewReader(bufio.NewReader(strings.NewReader(customHeader + "\n\n")))
+		parsedHeader, err := reader.ReadMIMEHeader()
+		if err != nil {
+			return nil, err
+		}
+
+		for key, values := range parsedHeader {
+			headers[key] = append(headers[key], values...)
+		}
+	}
+	return headers, nil
+}
@@ -0,0 +1,286 @@
+package v2action_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Curl Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("MakeCurlRequest", func() {
+		var (
+			httpMethod      string
+			path            string
+			customHeaders   []string
+			httpData        string
+			failOnHTTPError bool
+
+			body       []byte
+			response   *http.Response
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			httpMethod = ""
+			path = "/v2/some-path"
+			customHeaders = nil
+			httpData = ""
+			failOnHTTPError = false
+		})
+
+		JustBeforeEach(func() {
+			body, response, warnings, executeErr = actor.MakeCurlRequest(httpMethod, path, customHeaders, httpData, failOnHTTPError)
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturns(
+					[]byte(`{"some":"response"}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"curl-warning"},
+					nil)
+			})
+
+			It("returns the response body, HTTP response and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(`{"some":"response"}`))
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(warnings).To(ConsistOf("curl-warning"))
+			})
+
+			It("defaults to a GET request without a body", func() {
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(1))
+				method, passedPath, headers, passedBody := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+				Expect(method).To(Equal(http.MethodGet))
+				Expect(passedPath).To(Equal("/v2/some-path"))
+				Expect(headers).To(BeEmpty())
+				Expect(passedBody).To(BeNil())
+			})
+
+			Context("when the path does not start with a slash", func() {
+				BeforeEach(func() {
+					path = "v2/some-path"
+				})
+
+				It("prefixes the path with a slash", func() {
+					_, passedPath, _, _ := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+					Expect(passedPath).To(Equal("/v2/some-path"))
+				})
+			})
+
+			Context("when data is provided", func() {
+				BeforeEach(func() {
+					httpData = `{"some":"data"}`
+				})
+
+				It("makes a POST request with the data as the body", func() {
+					method, _, _, passedBody := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+					Expect(method).To(Equal(http.MethodPost))
+					Expect(ioutil.ReadAll(passedBody.(io.Reader))).To(Equal([]byte(`{"some":"data"}`)))
+				})
+
+				Context("when the method is provided", func() {
+					BeforeEach(func() {
+						httpMethod = http.MethodPut
+					})
+
+					It("uses the provided method", func() {
+						method, _, _, _ := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+						Expect(method).To(Equal(http.MethodPut))
+					})
+				})
+			})
+
+			Context("when custom headers are provided", func() {
+				BeforeEach(func() {
+					customHeaders = []string{"Content-Type: application/x-www-form-urlencoded", "x-custom: value-1", "X-Custom: value-2"}
+				})
+
+				It("passes the parsed headers", func() {
+					_, _, headers, _ := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+					Expect(headers).To(Equal(http.Header{
+						"Content-Type": {"application/x-www-form-urlencoded"},
+						"X-Custom":     {"value-1", "value-2"},
+					}))
+				})
+			})
+		})
+
+		Context("when a custom header is malformed", func() {
+			BeforeEach(func() {
+				customHeaders = []string{"not-a-header"}
+			})
+
+			It("returns an error without making a request", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the Cloud Controller responds with an HTTP error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturns(
+					[]byte(`{"error_code":"CF-NotFound"}`),
+					&http.Response{StatusCode: http.StatusNotFound},
+					ccv2.Warnings{"curl-warning"},
+					ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns the response body and warnings without an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(`{"error_code":"CF-NotFound"}`))
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(warnings).To(ConsistOf("curl-warning"))
+			})
+
+			Context("when failing on HTTP errors", func() {
+				BeforeEach(func() {
+					failOnHTTPError = true
+				})
+
+				It("returns an HTTPStatusError and warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.HTTPStatusError{StatusCode: http.StatusNotFound}))
+					Expect(warnings).To(ConsistOf("curl-warning"))
+				})
+			})
+		})
+
+		Context("when the request cannot be made", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.MakeRawRequestReturns(nil, nil, ccv2.Warnings{"curl-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("curl-warning"))
+			})
+		})
+	})
+
+	Describe("MakePaginatedCurlRequest", func() {
+		var (
+			body       []byte
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			body, _, warnings, executeErr = actor.MakePaginatedCurlRequest("/v2/apps", nil, true)
+		})
+
+		Context("when the response is paginated", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturnsOnCall(0,
+					[]byte(`{"next_url": "/v2/apps?page=2", "resources": [{"name": "app-1"}]}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"warning-1"},
+					nil)
+				fakeCloudControllerClient.MakeRawRequestReturnsOnCall(1,
+					[]byte(`{"pagination": {"next": {"href": "https://api.example.com/v3/apps?page=3"}}, "resources": [{"name": "app-2"}]}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"warning-2"},
+					nil)
+				fakeCloudControllerClient.MakeRawRequestReturnsOnCall(2,
+					[]byte(`{"next_url": null, "pagination": {"next": null}, "resources": [{"name": "app-3"}]}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"warning-3"},
+					nil)
+			})
+
+			It("follows the next links and merges all resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(body).To(MatchJSON(`[{"name": "app-1"}, {"name": "app-2"}, {"name": "app-3"}]`))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(3))
+				method, path, _, _ := fakeCloudControllerClient.MakeRawRequestArgsForCall(0)
+				Expect(method).To(Equal(http.MethodGet))
+				Expect(path).To(Equal("/v2/apps"))
+				_, path, _, _ = fakeCloudControllerClient.MakeRawRequestArgsForCall(1)
+				Expect(path).To(Equal("/v2/apps?page=2"))
+				_, path, _, _ = fakeCloudControllerClient.MakeRawRequestArgsForCall(2)
+				Expect(path).To(Equal("https://api.example.com/v3/apps?page=3"))
+			})
+		})
+
+		Context("when the response is not paginated", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturns(
+					[]byte(`{"name": "some-app"}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the response unmodified", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(`{"name": "some-app"}`))
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a next link points at a host other than the targeted API", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturnsOnCall(0,
+					[]byte(`{"next_url": "https://evil.example.com/v2/apps?page=2", "resources": [{"name": "app-1"}]}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"warning-1"},
+					nil)
+				fakeCloudControllerClient.MakeRawRequestReturnsOnCall(1,
+					nil,
+					nil,
+					nil,
+					ccerror.UntargetedURLError{URL: "https://evil.example.com/v2/apps?page=2", API: "https://api.example.com"})
+			})
+
+			It("stops following the links and returns the error", func() {
+				Expect(executeErr).To(MatchError(ccerror.UntargetedURLError{URL: "https://evil.example.com/v2/apps?page=2", API: "https://api.example.com"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when a page returns an HTTP error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturnsOnCall(0,
+					[]byte(`{"next_url": "/v2/apps?page=2", "resources": [{"name": "app-1"}]}`),
+					&http.Response{StatusCode: http.StatusOK},
+					ccv2.Warnings{"warning-1"},
+					nil)
+				fakeCloudControllerClient.MakeRawRequestReturnsOnCall(1,
+					[]byte(`{"error_code": "CF-Error"}`),
+					&http.Response{StatusCode: http.StatusInternalServerError},
+					ccv2.Warnings{"warning-2"},
+					ccerror.V2UnexpectedResponseError{})
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.HTTPStatusError{StatusCode: http.StatusInternalServerError}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+})
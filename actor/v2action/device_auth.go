@@ -0,0 +1,74 @@
+package v2action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// DeviceAuthorization is a device login that is waiting for the user to
+// enter the user code on another device.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+// StartDeviceAuthentication requests a device code and the user code that the
+// user needs to enter at the verification URI.
+func (actor Actor) StartDeviceAuthentication() (DeviceAuthorization, error) {
+	if actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return DeviceAuthorization{}, actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	authorization, err := actor.UAAClient.AuthorizeDevice()
+	return DeviceAuthorization(authorization), err
+}
+
+// CompleteDeviceAuthentication polls UAA until the user approves or denies
+// the device authorization, or until it expires, and sets the returned tokens
+// in the config.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) CompleteDeviceAuthentication(authorization DeviceAuthorization) error {
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval == 0 {
+		interval = actor.Config.PollingInterval()
+	}
+
+	timeout := actor.Config.OverallPollingTimeout()
+	if authorization.ExpiresIn > 0 {
+		timeout = time.Duration(authorization.ExpiresIn) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	for time.Now().Before(deadline) {
+		time.Sleep(interval)
+
+		accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithDeviceCode(authorization.DeviceCode)
+		switch err.(type) {
+		case nil:
+			actor.Config.SetTokenInformation(fmt.Sprintf("bearer %s", accessToken), refreshToken, "")
+			return nil
+		case uaa.AuthorizationPendingError:
+		case uaa.SlowDownError:
+			// RFC 8628 requires the polling interval to be increased by 5
+			// seconds every time UAA asks for it.
+			interval += 5 * time.Second
+		case uaa.ExpiredTokenError:
+			actor.Config.SetTokenInformation("", "", "")
+			return actionerror.LoginTimeoutError{}
+		case uaa.AccessDeniedError:
+			actor.Config.SetTokenInformation("", "", "")
+			return actionerror.LoginDeniedError{}
+		default:
+			actor.Config.SetTokenInformation("", "", "")
+			return err
+		}
+	}
+
+	actor.Config.SetTokenInformation("", "", "")
+	return actionerror.LoginTimeoutError{}
+}
@@ -0,0 +1,133 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device Auth Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v2actionfakes.FakeUAAClient
+		fakeConfig    *v2actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeUAAClient, fakeConfig)
+	})
+
+	Describe("StartDeviceAuthentication", func() {
+		BeforeEach(func() {
+			fakeUAAClient.AuthorizeDeviceReturns(uaa.DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				UserCode:   "ABCD-EFGH",
+			}, nil)
+		})
+
+		It("returns the device authorization", func() {
+			authorization, err := actor.StartDeviceAuthentication()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorization).To(Equal(DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				UserCode:   "ABCD-EFGH",
+			}))
+		})
+
+		Context("when a client credentials user is logged in", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				_, err := actor.StartDeviceAuthentication()
+				Expect(err).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+				Expect(fakeUAAClient.AuthorizeDeviceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CompleteDeviceAuthentication", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			fakeConfig.PollingIntervalReturns(time.Millisecond)
+			fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.CompleteDeviceAuthentication(DeviceAuthorization{DeviceCode: "some-device-code"})
+		})
+
+		Context("when the user approves the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateWithDeviceCodeReturnsOnCall(1, "some-access-token", "some-refresh-token", nil)
+			})
+
+			It("polls until the tokens are returned and sets them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateWithDeviceCodeCallCount()).To(Equal(2))
+				Expect(fakeUAAClient.AuthenticateWithDeviceCodeArgsForCall(0)).To(Equal("some-device-code"))
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+			})
+		})
+
+		Context("when the user denies the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", uaa.AccessDeniedError{})
+			})
+
+			It("returns a LoginDeniedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginDeniedError{}))
+			})
+		})
+
+		Context("when the device code expires", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", uaa.ExpiredTokenError{})
+			})
+
+			It("returns a LoginTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginTimeoutError{}))
+			})
+		})
+
+		Context("when the polling timeout is reached", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(10 * time.Millisecond)
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", uaa.AuthorizationPendingError{})
+			})
+
+			It("returns a LoginTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginTimeoutError{}))
+				accessToken, _, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+			})
+		})
+
+		Context("when UAA returns another error", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+})
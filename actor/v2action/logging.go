@@ -2,8 +2,10 @@ package v2action
 
 import (
 	"sort"
+	"sync"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
@@ -19,6 +21,7 @@ type LogMessage struct {
 	timestamp      time.Time
 	sourceType     string
 	sourceInstance string
+	appName        string
 }
 
 func (log LogMessage) Message() string {
@@ -48,6 +51,12 @@ func (log LogMessage) SourceInstance() string {
 	return log.sourceInstance
 }
 
+// AppName is the name of the app the message came from. It is only set on
+// messages streamed from several apps at once.
+func (log LogMessage) AppName() string {
+	return log.appName
+}
+
 func NewLogMessage(message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
 	return &LogMessage{
 		message:        message,
@@ -165,3 +174,129 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 
 	return messages, logErrs, allWarnings, err
 }
+
+// GetStreamingLogsForApplicationsByNameAndSpace tails the logs of several apps
+// at once through the same client. Messages from all apps are merged in
+// timestamp order and carry their app name. A failing stream sends an
+// AppLogStreamError and the other streams carry on.
+func (actor Actor) GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	var (
+		apps        []Application
+		allWarnings Warnings
+	)
+
+	for _, appName := range appNames {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, nil, allWarnings, err
+		}
+		apps = append(apps, app)
+	}
+
+	messages, logErrs := actor.getStreamingLogsForApplications(apps, client)
+
+	return messages, logErrs, allWarnings, nil
+}
+
+// GetStreamingLogsForSpace tails the logs of every app in the space, as
+// GetStreamingLogsForApplicationsByNameAndSpace does.
+func (actor Actor) GetStreamingLogsForSpace(spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	messages, logErrs := actor.getStreamingLogsForApplications(apps, client)
+
+	return messages, logErrs, warnings, nil
+}
+
+func (Actor) getStreamingLogsForApplications(apps []Application, client NOAAClient) (<-chan *LogMessage, <-chan error) {
+	messages := make(chan *LogMessage)
+	errs := make(chan error)
+	incoming := make(chan *LogMessage)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(len(apps))
+
+	for _, app := range apps {
+		// Do not pass in token because client should have a TokenRefresher set
+		eventStream, errStream := client.TailingLogs(app.GUID, "")
+
+		go func(appName string, eventStream <-chan *events.LogMessage, errStream <-chan error) {
+			defer wg.Done()
+
+			for eventStream != nil || errStream != nil {
+				select {
+				case event, ok := <-eventStream:
+					if !ok {
+						eventStream = nil
+						break
+					}
+
+					incoming <- &LogMessage{
+						message:        string(event.GetMessage()),
+						messageType:    event.GetMessageType(),
+						timestamp:      time.Unix(0, event.GetTimestamp()),
+						sourceInstance: event.GetSourceInstance(),
+						sourceType:     event.GetSourceType(),
+						appName:        appName,
+					}
+				case err, ok := <-errStream:
+					if !ok {
+						errStream = nil
+						break
+					}
+
+					if _, ok := err.(noaaErrors.RetryError); ok {
+						break
+					}
+
+					if err != nil {
+						errs <- actionerror.AppLogStreamError{AppName: appName, Err: err}
+					}
+				}
+			}
+		}(app.Name, eventStream, errStream)
+	}
+
+	go func() {
+		wg.Wait()
+		close(incoming)
+	}()
+
+	go func() {
+		defer close(messages)
+		defer close(errs)
+
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+
+		var logs LogMessages
+		flush := func() {
+			sort.Stable(logs)
+			for _, l := range logs {
+				messages <- l
+			}
+
+			logs = logs[0:0]
+		}
+
+		for {
+			select {
+			case log, ok := <-incoming:
+				if !ok {
+					flush()
+					return
+				}
+
+				logs = append(logs, log)
+			case <-ticker.C:
+				flush()
+			}
+		}
+	}()
+
+	return messages, errs
+}
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
@@ -462,4 +464,187 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStreamingLogsForApplicationsByNameAndSpace", func() {
+		var (
+			eventStreams map[string]chan *events.LogMessage
+			errStreams   map[string]chan error
+
+			messages <-chan *LogMessage
+			logErrs  <-chan error
+			warnings Warnings
+			err      error
+		)
+
+		newLogMessage := func(message string, timestamp int64) *events.LogMessage {
+			outMessage := events.LogMessage_OUT
+			sourceType := "APP/PROC/WEB"
+			sourceInstance := "0"
+			return &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &outMessage,
+				Timestamp:      &timestamp,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			}
+		}
+
+		BeforeEach(func() {
+			eventStreams = map[string]chan *events.LogMessage{
+				"some-app-guid-1": make(chan *events.LogMessage, 10),
+				"some-app-guid-2": make(chan *events.LogMessage, 10),
+			}
+			errStreams = map[string]chan error{
+				"some-app-guid-1": make(chan error, 10),
+				"some-app-guid-2": make(chan error, 10),
+			}
+
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0,
+				[]ccv2.Application{{Name: "some-app-1", GUID: "some-app-guid-1"}},
+				ccv2.Warnings{"some-app-warnings-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+				[]ccv2.Application{{Name: "some-app-2", GUID: "some-app-guid-2"}},
+				ccv2.Warnings{"some-app-warnings-2"},
+				nil,
+			)
+
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+				Expect(authToken).To(BeEmpty())
+				return eventStreams[appGUID], errStreams[appGUID]
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, logErrs, warnings, err = actor.GetStreamingLogsForApplicationsByNameAndSpace([]string{"some-app-1", "some-app-2"}, "some-space-guid", fakeNOAAClient)
+		})
+
+		It("merges the messages of every app in timestamp order with their app names", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings-1", "some-app-warnings-2"))
+			Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+
+			eventStreams["some-app-guid-1"] <- newLogMessage("message-2", 20)
+			eventStreams["some-app-guid-2"] <- newLogMessage("message-1", 10)
+			for guid := range eventStreams {
+				close(eventStreams[guid])
+				close(errStreams[guid])
+			}
+
+			var message *LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("message-1"))
+			Expect(message.AppName()).To(Equal("some-app-2"))
+
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("message-2"))
+			Expect(message.AppName()).To(Equal("some-app-1"))
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		Context("when one of the streams fails", func() {
+			It("passes the error along with the app name and keeps streaming the others", func() {
+				errStreams["some-app-guid-1"] <- errors.New("some-stream-error")
+				close(eventStreams["some-app-guid-1"])
+				close(errStreams["some-app-guid-1"])
+
+				Eventually(logErrs).Should(Receive(Equal(actionerror.AppLogStreamError{
+					AppName: "some-app-1",
+					Err:     errors.New("some-stream-error"),
+				})))
+
+				eventStreams["some-app-guid-2"] <- newLogMessage("message-1", 10)
+
+				var message *LogMessage
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.AppName()).To(Equal("some-app-2"))
+
+				close(eventStreams["some-app-guid-2"])
+				close(errStreams["some-app-guid-2"])
+				Eventually(messages).Should(BeClosed())
+			})
+		})
+
+		Context("when finding an application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, nil, ccv2.Warnings{"some-app-warnings-2"}, errors.New("some-app-error"))
+			})
+
+			It("returns the error and all warnings without streaming", func() {
+				Expect(err).To(MatchError("some-app-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings-1", "some-app-warnings-2"))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForSpace", func() {
+		var (
+			messages <-chan *LogMessage
+			logErrs  <-chan error
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			messages, logErrs, warnings, err = actor.GetStreamingLogsForSpace("some-space-guid", fakeNOAAClient)
+		})
+
+		Context("when the space has apps", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{Name: "some-app-1", GUID: "some-app-guid-1"},
+						{Name: "some-app-2", GUID: "some-app-guid-2"},
+					},
+					ccv2.Warnings{"some-app-warnings"},
+					nil,
+				)
+
+				fakeNOAAClient.TailingLogsStub = func(_ string, _ string) (<-chan *events.LogMessage, <-chan error) {
+					eventStream := make(chan *events.LogMessage)
+					errStream := make(chan error)
+					close(eventStream)
+					close(errStream)
+					return eventStream, errStream
+				}
+			})
+
+			It("tails the logs of every app in the space", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.SpaceGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-space-guid"},
+				}))
+
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+				appGUID, _ := fakeNOAAClient.TailingLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid-1"))
+				appGUID, _ = fakeNOAAClient.TailingLogsArgsForCall(1)
+				Expect(appGUID).To(Equal("some-app-guid-2"))
+
+				Eventually(messages).Should(BeClosed())
+				Eventually(logErrs).Should(BeClosed())
+			})
+		})
+
+		Context("when getting the apps errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"some-app-warnings"}, errors.New("some-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("some-app-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -68,6 +68,26 @@ func (actor Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID stri
 	return ServiceBinding(serviceBindings[0]), Warnings(warnings), err
 }
 
+// GetServiceBindingsByApplication returns the service bindings of an
+// application.
+func (actor Actor) GetServiceBindingsByApplication(appGUID string) ([]ServiceBinding, Warnings, error) {
+	serviceBindings, warnings, err := actor.CloudControllerClient.GetServiceBindings(ccv2.Filter{
+		Type:     constant.AppGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{appGUID},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var allServiceBindings []ServiceBinding
+	for _, serviceBinding := range serviceBindings {
+		allServiceBindings = append(allServiceBindings, ServiceBinding(serviceBinding))
+	}
+
+	return allServiceBindings, Warnings(warnings), nil
+}
+
 // UnbindServiceBySpace deletes the service binding between an application and
 // service instance for a given space.
 func (actor Actor) UnbindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string) (Warnings, error) {
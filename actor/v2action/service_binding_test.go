@@ -174,6 +174,53 @@ var _ = Describe("Service Binding Actions", func() {
 		})
 	})
 
+	Describe("GetServiceBindingsByApplication", func() {
+		Context("when the app has service bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{GUID: "some-service-binding-guid-1", ServiceInstanceGUID: "some-service-instance-guid-1"},
+						{GUID: "some-service-binding-guid-2", ServiceInstanceGUID: "some-service-instance-guid-2"},
+					},
+					ccv2.Warnings{"foo"},
+					nil,
+				)
+			})
+
+			It("returns the service bindings and warnings", func() {
+				serviceBindings, warnings, err := actor.GetServiceBindingsByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceBindings).To(Equal([]ServiceBinding{
+					{GUID: "some-service-binding-guid-1", ServiceInstanceGUID: "some-service-instance-guid-1"},
+					{GUID: "some-service-binding-guid-2", ServiceInstanceGUID: "some-service-instance-guid-2"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"foo"}))
+
+				Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.AppGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-app-guid"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"foo"}, expectedError)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetServiceBindingsByApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(Equal(Warnings{"foo"}))
+			})
+		})
+	})
+
 	Describe("GetServiceBindingByApplicationAndServiceInstance", func() {
 		Context("when the service binding exists", func() {
 			BeforeEach(func() {
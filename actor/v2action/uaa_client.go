@@ -9,6 +9,10 @@ import (
 
 type UAAClient interface {
 	Authenticate(ID string, secret string, grantType constant.GrantType) (string, string, error)
+	AuthenticateWithAuthorizationCode(code string, codeVerifier string, redirectURI string) (string, string, error)
+	AuthenticateWithDeviceCode(deviceCode string) (string, string, error)
+	AuthorizationCodeURL(redirectURI string, codeChallenge string, state string) (string, error)
+	AuthorizeDevice() (uaa.DeviceAuthorization, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
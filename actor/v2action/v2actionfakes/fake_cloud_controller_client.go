@@ -3,6 +3,7 @@ package v2actionfakes
 
 import (
 	"io"
+	"net/http"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -383,6 +384,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRouteMappingsStub        func(filters ...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error)
+	getRouteMappingsMutex       sync.RWMutex
+	getRouteMappingsArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getRouteMappingsReturns struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouteMappingsReturnsOnCall map[int]struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetRoutesStub        func(filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
 	getRoutesMutex       sync.RWMutex
 	getRoutesArgsForCall []struct {
@@ -733,6 +749,26 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	MakeRawRequestStub        func(method string, path string, headers http.Header, body io.ReadSeeker) ([]byte, *http.Response, ccv2.Warnings, error)
+	makeRawRequestMutex       sync.RWMutex
+	makeRawRequestArgsForCall []struct {
+		method  string
+		path    string
+		headers http.Header
+		body    io.ReadSeeker
+	}
+	makeRawRequestReturns struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}
+	makeRawRequestReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}
 	PollJobStub        func(job ccv2.Job) (ccv2.Warnings, error)
 	pollJobMutex       sync.RWMutex
 	pollJobArgsForCall []struct {
@@ -2287,6 +2323,60 @@ func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRouteMappings(filters ...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error) {
+	fake.getRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.getRouteMappingsReturnsOnCall[len(fake.getRouteMappingsArgsForCall)]
+	fake.getRouteMappingsArgsForCall = append(fake.getRouteMappingsArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetRouteMappings", []interface{}{filters})
+	fake.getRouteMappingsMutex.Unlock()
+	if fake.GetRouteMappingsStub != nil {
+		return fake.GetRouteMappingsStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteMappingsReturns.result1, fake.getRouteMappingsReturns.result2, fake.getRouteMappingsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsCallCount() int {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return len(fake.getRouteMappingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsArgsForCall(i int) []ccv2.Filter {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return fake.getRouteMappingsArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturns(result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	fake.getRouteMappingsReturns = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturnsOnCall(i int, result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.GetRouteMappingsStub = nil
+	if fake.getRouteMappingsReturnsOnCall == nil {
+		fake.getRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.RouteMapping
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteMappingsReturnsOnCall[i] = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRoutes(filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error) {
 	fake.getRoutesMutex.Lock()
 	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
@@ -3534,6 +3624,66 @@ func (fake *FakeCloudControllerClient) GetUserProvidedServiceInstanceServiceBind
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) MakeRawRequest(method string, path string, headers http.Header, body io.ReadSeeker) ([]byte, *http.Response, ccv2.Warnings, error) {
+	fake.makeRawRequestMutex.Lock()
+	ret, specificReturn := fake.makeRawRequestReturnsOnCall[len(fake.makeRawRequestArgsForCall)]
+	fake.makeRawRequestArgsForCall = append(fake.makeRawRequestArgsForCall, struct {
+		method  string
+		path    string
+		headers http.Header
+		body    io.ReadSeeker
+	}{method, path, headers, body})
+	fake.recordInvocation("MakeRawRequest", []interface{}{method, path, headers, body})
+	fake.makeRawRequestMutex.Unlock()
+	if fake.MakeRawRequestStub != nil {
+		return fake.MakeRawRequestStub(method, path, headers, body)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.makeRawRequestReturns.result1, fake.makeRawRequestReturns.result2, fake.makeRawRequestReturns.result3, fake.makeRawRequestReturns.result4
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestCallCount() int {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return len(fake.makeRawRequestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestArgsForCall(i int) (string, string, http.Header, io.ReadSeeker) {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return fake.makeRawRequestArgsForCall[i].method, fake.makeRawRequestArgsForCall[i].path, fake.makeRawRequestArgsForCall[i].headers, fake.makeRawRequestArgsForCall[i].body
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturns(result1 []byte, result2 *http.Response, result3 ccv2.Warnings, result4 error) {
+	fake.MakeRawRequestStub = nil
+	fake.makeRawRequestReturns = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturnsOnCall(i int, result1 []byte, result2 *http.Response, result3 ccv2.Warnings, result4 error) {
+	fake.MakeRawRequestStub = nil
+	if fake.makeRawRequestReturnsOnCall == nil {
+		fake.makeRawRequestReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 *http.Response
+			result3 ccv2.Warnings
+			result4 error
+		})
+	}
+	fake.makeRawRequestReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 *http.Response
+		result3 ccv2.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeCloudControllerClient) PollJob(job ccv2.Job) (ccv2.Warnings, error) {
 	fake.pollJobMutex.Lock()
 	ret, specificReturn := fake.pollJobReturnsOnCall[len(fake.pollJobArgsForCall)]
@@ -4413,6 +4563,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPrivateDomainMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
 	defer fake.getRouteApplicationsMutex.RUnlock()
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
 	fake.getSecurityGroupSpacesMutex.RLock()
@@ -4459,6 +4611,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getStacksMutex.RUnlock()
 	fake.getUserProvidedServiceInstanceServiceBindingsMutex.RLock()
 	defer fake.getUserProvidedServiceInstanceServiceBindingsMutex.RUnlock()
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
 	fake.pollJobMutex.RLock()
 	defer fake.pollJobMutex.RUnlock()
 	fake.restageApplicationMutex.RLock()
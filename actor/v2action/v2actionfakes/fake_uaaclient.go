@@ -27,6 +27,64 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthenticateWithAuthorizationCodeStub        func(code string, codeVerifier string, redirectURI string) (string, string, error)
+	authenticateWithAuthorizationCodeMutex       sync.RWMutex
+	authenticateWithAuthorizationCodeArgsForCall []struct {
+		code         string
+		codeVerifier string
+		redirectURI  string
+	}
+	authenticateWithAuthorizationCodeReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithAuthorizationCodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	AuthenticateWithDeviceCodeStub        func(deviceCode string) (string, string, error)
+	authenticateWithDeviceCodeMutex       sync.RWMutex
+	authenticateWithDeviceCodeArgsForCall []struct {
+		deviceCode string
+	}
+	authenticateWithDeviceCodeReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithDeviceCodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	AuthorizationCodeURLStub        func(redirectURI string, codeChallenge string, state string) (string, error)
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		redirectURI   string
+		codeChallenge string
+		state         string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	AuthorizeDeviceStub        func() (uaa.DeviceAuthorization, error)
+	authorizeDeviceMutex       sync.RWMutex
+	authorizeDeviceArgsForCall []struct{}
+	authorizeDeviceReturns     struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	authorizeDeviceReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	CreateUserStub        func(username string, password string, origin string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -129,6 +187,212 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCode(code string, codeVerifier string, redirectURI string) (string, string, error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithAuthorizationCodeReturnsOnCall[len(fake.authenticateWithAuthorizationCodeArgsForCall)]
+	fake.authenticateWithAuthorizationCodeArgsForCall = append(fake.authenticateWithAuthorizationCodeArgsForCall, struct {
+		code         string
+		codeVerifier string
+		redirectURI  string
+	}{code, codeVerifier, redirectURI})
+	fake.recordInvocation("AuthenticateWithAuthorizationCode", []interface{}{code, codeVerifier, redirectURI})
+	fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	if fake.AuthenticateWithAuthorizationCodeStub != nil {
+		return fake.AuthenticateWithAuthorizationCodeStub(code, codeVerifier, redirectURI)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.authenticateWithAuthorizationCodeReturns.result1, fake.authenticateWithAuthorizationCodeReturns.result2, fake.authenticateWithAuthorizationCodeReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeCallCount() int {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	return len(fake.authenticateWithAuthorizationCodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeArgsForCall(i int) (string, string, string) {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	return fake.authenticateWithAuthorizationCodeArgsForCall[i].code, fake.authenticateWithAuthorizationCodeArgsForCall[i].codeVerifier, fake.authenticateWithAuthorizationCodeArgsForCall[i].redirectURI
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeReturns(result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	fake.authenticateWithAuthorizationCodeReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	if fake.authenticateWithAuthorizationCodeReturnsOnCall == nil {
+		fake.authenticateWithAuthorizationCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithAuthorizationCodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCode(deviceCode string) (string, string, error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceCodeReturnsOnCall[len(fake.authenticateWithDeviceCodeArgsForCall)]
+	fake.authenticateWithDeviceCodeArgsForCall = append(fake.authenticateWithDeviceCodeArgsForCall, struct {
+		deviceCode string
+	}{deviceCode})
+	fake.recordInvocation("AuthenticateWithDeviceCode", []interface{}{deviceCode})
+	fake.authenticateWithDeviceCodeMutex.Unlock()
+	if fake.AuthenticateWithDeviceCodeStub != nil {
+		return fake.AuthenticateWithDeviceCodeStub(deviceCode)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.authenticateWithDeviceCodeReturns.result1, fake.authenticateWithDeviceCodeReturns.result2, fake.authenticateWithDeviceCodeReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeCallCount() int {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return len(fake.authenticateWithDeviceCodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeArgsForCall(i int) string {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return fake.authenticateWithDeviceCodeArgsForCall[i].deviceCode
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeReturns(result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithDeviceCodeStub = nil
+	fake.authenticateWithDeviceCodeReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.AuthenticateWithDeviceCodeStub = nil
+	if fake.authenticateWithDeviceCodeReturnsOnCall == nil {
+		fake.authenticateWithDeviceCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithDeviceCodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURL(redirectURI string, codeChallenge string, state string) (string, error) {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		redirectURI   string
+		codeChallenge string
+		state         string
+	}{redirectURI, codeChallenge, state})
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{redirectURI, codeChallenge, state})
+	fake.authorizationCodeURLMutex.Unlock()
+	if fake.AuthorizationCodeURLStub != nil {
+		return fake.AuthorizationCodeURLStub(redirectURI, codeChallenge, state)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.authorizationCodeURLReturns.result1, fake.authorizationCodeURLReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLArgsForCall(i int) (string, string, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return fake.authorizationCodeURLArgsForCall[i].redirectURI, fake.authorizationCodeURLArgsForCall[i].codeChallenge, fake.authorizationCodeURLArgsForCall[i].state
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizeDevice() (uaa.DeviceAuthorization, error) {
+	fake.authorizeDeviceMutex.Lock()
+	ret, specificReturn := fake.authorizeDeviceReturnsOnCall[len(fake.authorizeDeviceArgsForCall)]
+	fake.authorizeDeviceArgsForCall = append(fake.authorizeDeviceArgsForCall, struct{}{})
+	fake.recordInvocation("AuthorizeDevice", []interface{}{})
+	fake.authorizeDeviceMutex.Unlock()
+	if fake.AuthorizeDeviceStub != nil {
+		return fake.AuthorizeDeviceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.authorizeDeviceReturns.result1, fake.authorizeDeviceReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthorizeDeviceCallCount() int {
+	fake.authorizeDeviceMutex.RLock()
+	defer fake.authorizeDeviceMutex.RUnlock()
+	return len(fake.authorizeDeviceArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizeDeviceReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.AuthorizeDeviceStub = nil
+	fake.authorizeDeviceReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizeDeviceReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.AuthorizeDeviceStub = nil
+	if fake.authorizeDeviceReturnsOnCall == nil {
+		fake.authorizeDeviceReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.authorizeDeviceReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) CreateUser(username string, password string, origin string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -290,6 +554,14 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	fake.authorizeDeviceMutex.RLock()
+	defer fake.authorizeDeviceMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
@@ -0,0 +1,82 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// GetApplicationAnnotations returns the annotations of the application with
+// the given name in the given space.
+func (actor Actor) GetApplicationAnnotations(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return metadataAnnotations((*ccv3.Metadata)(app.Metadata)), warnings, nil
+}
+
+// GetOrganizationAnnotations returns the annotations of the organization with
+// the given name.
+func (actor Actor) GetOrganizationAnnotations(orgName string) (map[string]types.NullString, Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return metadataAnnotations(org.Metadata), warnings, nil
+}
+
+// GetSpaceAnnotations returns the annotations of the space with the given
+// name in the given organization.
+func (actor Actor) GetSpaceAnnotations(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return metadataAnnotations(space.Metadata), warnings, nil
+}
+
+// UpdateApplicationAnnotationsByApplicationName adds, updates and removes the
+// provided annotations on the application with the given name in the given
+// space. Annotations with an unset value are removed.
+func (actor Actor) UpdateApplicationAnnotationsByApplicationName(appName string, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceMetadata("app", app.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+// UpdateOrganizationAnnotationsByOrganizationName adds, updates and removes
+// the provided annotations on the organization with the given name.
+// Annotations with an unset value are removed.
+func (actor Actor) UpdateOrganizationAnnotationsByOrganizationName(orgName string, annotations map[string]types.NullString) (Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceMetadata("org", org.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+// UpdateSpaceAnnotationsBySpaceName adds, updates and removes the provided
+// annotations on the space with the given name in the given organization.
+// Annotations with an unset value are removed.
+func (actor Actor) UpdateSpaceAnnotationsBySpaceName(spaceName string, orgGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceMetadata("space", space.GUID, ccv3.Metadata{Annotations: annotations}, warnings)
+}
+
+func metadataAnnotations(metadata *ccv3.Metadata) map[string]types.NullString {
+	if metadata == nil {
+		return nil
+	}
+	return metadata.Annotations
+}
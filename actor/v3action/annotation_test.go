@@ -0,0 +1,228 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Annotation Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		annotations               map[string]types.NullString
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationAnnotations", func() {
+		JustBeforeEach(func() {
+			annotations, warnings, executeErr = actor.GetApplicationAnnotations("some-app-name", "some-space-guid")
+		})
+
+		Context("when the app has annotations", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{
+						GUID: "some-app-guid",
+						Metadata: &ccv3.Metadata{
+							Labels:      map[string]types.NullString{"env": types.NewNullString("prod")},
+							Annotations: map[string]types.NullString{"contact": types.NewNullString("team@example.com")},
+						},
+					}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns the annotations and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(annotations).To(Equal(map[string]types.NullString{"contact": types.NewNullString("team@example.com")}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		Context("when the app has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, nil, nil)
+			})
+
+			It("returns no annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(annotations).To(BeEmpty())
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationAnnotations", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{
+					GUID: "some-org-guid",
+					Metadata: &ccv3.Metadata{
+						Annotations: map[string]types.NullString{"contact": types.NewNullString("team@example.com")},
+					},
+				}},
+				ccv3.Warnings{"get-org-warning"},
+				nil,
+			)
+		})
+
+		It("returns the annotations and warnings", func() {
+			annotations, warnings, executeErr = actor.GetOrganizationAnnotations("some-org-name")
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(annotations).To(Equal(map[string]types.NullString{"contact": types.NewNullString("team@example.com")}))
+			Expect(warnings).To(ConsistOf("get-org-warning"))
+		})
+	})
+
+	Describe("GetSpaceAnnotations", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{
+					GUID: "some-space-guid",
+					Metadata: &ccv3.Metadata{
+						Annotations: map[string]types.NullString{"contact": types.NewNullString("team@example.com")},
+					},
+				}},
+				ccv3.Warnings{"get-space-warning"},
+				nil,
+			)
+		})
+
+		It("returns the annotations and warnings", func() {
+			annotations, warnings, executeErr = actor.GetSpaceAnnotations("some-space-name", "some-org-guid")
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(annotations).To(Equal(map[string]types.NullString{"contact": types.NewNullString("team@example.com")}))
+			Expect(warnings).To(ConsistOf("get-space-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-space-name"}},
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+			))
+		})
+	})
+
+	Describe("UpdateApplicationAnnotationsByApplicationName", func() {
+		BeforeEach(func() {
+			annotations = map[string]types.NullString{
+				"contact": types.NewNullString("team@example.com"),
+				"owner":   types.NewNullString(),
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationAnnotationsByApplicationName("some-app-name", "some-space-guid", annotations)
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates only the annotations of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+				resource, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resource).To(Equal("app"))
+				Expect(guid).To(Equal("some-app-guid"))
+				Expect(metadata).To(Equal(ccv3.Metadata{Annotations: annotations}))
+			})
+
+			Context("when updating the metadata fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-app-warning", "update-warning"))
+				})
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError without updating", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationAnnotationsByOrganizationName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid"}}, ccv3.Warnings{"get-org-warning"}, nil)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the annotations of the org", func() {
+			annotations = map[string]types.NullString{"contact": types.NewNullString("team@example.com")}
+			warnings, executeErr = actor.UpdateOrganizationAnnotationsByOrganizationName("some-org-name", annotations)
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-org-warning", "update-warning"))
+
+			resource, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resource).To(Equal("org"))
+			Expect(guid).To(Equal("some-org-guid"))
+			Expect(metadata).To(Equal(ccv3.Metadata{Annotations: annotations}))
+		})
+	})
+
+	Describe("UpdateSpaceAnnotationsBySpaceName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{{GUID: "some-space-guid"}}, ccv3.Warnings{"get-space-warning"}, nil)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the annotations of the space", func() {
+			annotations = map[string]types.NullString{"contact": types.NewNullString("team@example.com")}
+			warnings, executeErr = actor.UpdateSpaceAnnotationsBySpaceName("some-space-name", "some-org-guid", annotations)
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-warning", "update-warning"))
+
+			resource, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resource).To(Equal("space"))
+			Expect(guid).To(Equal("some-space-guid"))
+			Expect(metadata).To(Equal(ccv3.Metadata{Annotations: annotations}))
+		})
+	})
+})
@@ -16,6 +16,7 @@ type Application struct {
 	State               constant.ApplicationState
 	LifecycleType       constant.AppLifecycleType
 	LifecycleBuildpacks []string
+	Metadata            *Metadata
 }
 
 func (app Application) Started() bool {
@@ -60,10 +61,11 @@ func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string
 	return actor.convertCCToActorApplication(apps[0]), Warnings(warnings), nil
 }
 
-// GetApplicationsBySpace returns all applications in a space.
-func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warnings, error) {
+// GetApplicationsBySpace returns all applications in a space. Additional
+// queries can be provided to further filter the applications.
+func (actor Actor) GetApplicationsBySpace(spaceGUID string, queries ...ccv3.Query) ([]Application, Warnings, error) {
 	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(
-		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		append([]ccv3.Query{{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}}}, queries...)...,
 	)
 
 	if err != nil {
@@ -147,12 +149,14 @@ func (actor Actor) PollStart(appGUID string, warningsChannel chan<- Warnings) er
 	return actionerror.StartupTimeoutError{}
 }
 
-// UpdateApplication updates the buildpacks on an application
+// UpdateApplication updates the name and buildpacks on an application. An
+// empty name leaves the name of the application unchanged.
 func (actor Actor) UpdateApplication(app Application) (Application, Warnings, error) {
 	ccApp := ccv3.Application{
 		GUID:                app.GUID,
 		LifecycleType:       app.LifecycleType,
 		LifecycleBuildpacks: app.LifecycleBuildpacks,
+		Name:                app.Name,
 	}
 
 	updatedApp, warnings, err := actor.CloudControllerClient.UpdateApplication(ccApp)
@@ -168,6 +172,7 @@ func (Actor) convertCCToActorApplication(app ccv3.Application) Application {
 		GUID:                app.GUID,
 		LifecycleType:       app.LifecycleType,
 		LifecycleBuildpacks: app.LifecycleBuildpacks,
+		Metadata:            (*Metadata)(app.Metadata),
 		Name:                app.Name,
 		State:               app.State,
 	}
@@ -203,6 +203,18 @@ var _ = Describe("Application Actions", func() {
 					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
 				))
 			})
+
+			It("passes additional queries to the cloud controller", func() {
+				_, _, err := actor.GetApplicationsBySpace("some-space-guid",
+					ccv3.Query{Key: ccv3.StatesFilter, Values: []string{"STARTED"}},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					ccv3.Query{Key: ccv3.StatesFilter, Values: []string{"STARTED"}},
+				))
+			})
 		})
 
 		Context("when the cloud controller client returns an error", func() {
@@ -321,6 +333,7 @@ var _ = Describe("Application Actions", func() {
 				GUID:                "some-app-guid",
 				LifecycleType:       constant.AppLifecycleTypeBuildpack,
 				LifecycleBuildpacks: []string{"buildpack-1", "buildpack-2"},
+				Name:                "some-app-name",
 			})
 		})
 
@@ -351,6 +364,7 @@ var _ = Describe("Application Actions", func() {
 					GUID:                "some-app-guid",
 					LifecycleType:       constant.AppLifecycleTypeBuildpack,
 					LifecycleBuildpacks: []string{"buildpack-1", "buildpack-2"},
+					Name:                "some-app-name",
 				}))
 			})
 		})
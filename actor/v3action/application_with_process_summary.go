@@ -9,27 +9,41 @@ type ApplicationWithProcessSummary struct {
 	ProcessSummaries ProcessSummaries
 }
 
-func (actor Actor) GetApplicationsWithProcessesBySpace(spaceGUID string) ([]ApplicationWithProcessSummary, Warnings, error) {
+// GetApplicationsWithProcessesBySpace returns all applications in a space
+// along with a summary of their processes. Additional queries can be
+// provided to further filter the applications.
+func (actor Actor) GetApplicationsWithProcessesBySpace(spaceGUID string, queries ...ccv3.Query) ([]ApplicationWithProcessSummary, Warnings, error) {
 	var allWarnings Warnings
 
 	apps, warnings, err := actor.CloudControllerClient.GetApplications(
-		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		append([]ccv3.Query{
+			{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+			{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		}, queries...)...,
 	)
 	allWarnings = Warnings(warnings)
 	if err != nil {
 		return nil, allWarnings, err
 	}
 
-	var appSummaries []ApplicationWithProcessSummary
+	if len(apps) == 0 {
+		return nil, allWarnings, nil
+	}
 
+	appGUIDs := make([]string, 0, len(apps))
 	for _, app := range apps {
-		processSummaries, processWarnings, err := actor.getProcessSummariesForApp(app.GUID)
-		allWarnings = append(allWarnings, processWarnings...)
-		if err != nil {
-			return nil, allWarnings, err
-		}
+		appGUIDs = append(appGUIDs, app.GUID)
+	}
+
+	processSummariesByApp, processWarnings, err := actor.getProcessSummariesForApps(appGUIDs)
+	allWarnings = append(allWarnings, processWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
 
+	var appSummaries []ApplicationWithProcessSummary
+
+	for _, app := range apps {
 		appSummaries = append(appSummaries, ApplicationWithProcessSummary{
 			Application: Application{
 				Name:                app.Name,
@@ -38,7 +52,7 @@ func (actor Actor) GetApplicationsWithProcessesBySpace(spaceGUID string) ([]Appl
 				LifecycleType:       app.LifecycleType,
 				LifecycleBuildpacks: app.LifecycleBuildpacks,
 			},
-			ProcessSummaries: processSummaries,
+			ProcessSummaries: processSummariesByApp[app.GUID],
 		})
 	}
 
@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"fmt"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -43,30 +44,25 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 					nil,
 				)
 
-				fakeCloudControllerClient.GetApplicationProcessesReturnsOnCall(
-					0,
+				fakeCloudControllerClient.GetProcessesReturns(
 					[]ccv3.Process{
 						{
-							GUID: "some-process-guid-1",
-							Type: "some-process-type-1",
+							AppGUID: "some-app-guid-1",
+							GUID:    "some-process-guid-1",
+							Type:    "some-process-type-1",
 						},
 						{
-							GUID: "some-process-guid-2",
-							Type: "some-process-type-2",
+							AppGUID: "some-app-guid-1",
+							GUID:    "some-process-guid-2",
+							Type:    "some-process-type-2",
 						},
-					},
-					ccv3.Warnings{"some-process-warning-1"},
-					nil,
-				)
-				fakeCloudControllerClient.GetApplicationProcessesReturnsOnCall(
-					1,
-					[]ccv3.Process{
 						{
-							GUID: "some-process-guid-3",
-							Type: "some-process-type-3",
+							AppGUID: "some-app-guid-2",
+							GUID:    "some-process-guid-3",
+							Type:    "some-process-type-3",
 						},
 					},
-					ccv3.Warnings{"some-process-warning-2"},
+					ccv3.Warnings{"some-process-warning"},
 					nil,
 				)
 
@@ -102,11 +98,11 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 						},
 						ProcessSummaries: []ProcessSummary{
 							{
-								Process:         Process{GUID: "some-process-guid-1", Type: "some-process-type-1"},
+								Process:         Process{AppGUID: "some-app-guid-1", GUID: "some-process-guid-1", Type: "some-process-type-1"},
 								InstanceDetails: []ProcessInstance{{State: constant.ProcessInstanceRunning}, {State: constant.ProcessInstanceDown}, {State: constant.ProcessInstanceRunning}},
 							},
 							{
-								Process:         Process{GUID: "some-process-guid-2", Type: "some-process-type-2"},
+								Process:         Process{AppGUID: "some-app-guid-1", GUID: "some-process-guid-2", Type: "some-process-type-2"},
 								InstanceDetails: []ProcessInstance{{State: constant.ProcessInstanceRunning}, {State: constant.ProcessInstanceRunning}},
 							},
 						},
@@ -119,12 +115,12 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 						},
 						ProcessSummaries: []ProcessSummary{
 							{
-								Process:         Process{GUID: "some-process-guid-3", Type: "some-process-type-3"},
+								Process:         Process{AppGUID: "some-app-guid-2", GUID: "some-process-guid-3", Type: "some-process-type-3"},
 								InstanceDetails: []ProcessInstance{{State: constant.ProcessInstanceDown}},
 							},
 						},
 					}))
-				Expect(warnings).To(ConsistOf("some-warning", "some-process-warning-1", "some-process-stats-warning-1", "some-process-stats-warning-2", "some-process-warning-2", "some-process-stats-warning-3"))
+				Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "some-process-stats-warning-1", "some-process-stats-warning-2", "some-process-stats-warning-3"))
 
 				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
 				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
@@ -132,15 +128,87 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
 				))
 
-				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(2))
-				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid-1"))
-				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(1)).To(Equal("some-app-guid-2"))
+				Expect(fakeCloudControllerClient.GetProcessesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetProcessesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid-1", "some-app-guid-2"}},
+				))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
 
 				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(3))
 				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid-1"))
 				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(1)).To(Equal("some-process-guid-2"))
 				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(2)).To(Equal("some-process-guid-3"))
 			})
+
+			Context("when additional queries are provided", func() {
+				It("passes them along with the space query", func() {
+					_, _, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid",
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+						ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					))
+				})
+			})
+		})
+
+		Context("when there are more apps than fit in a single processes request", func() {
+			var appGUIDs []string
+
+			BeforeEach(func() {
+				var apps []ccv3.Application
+				appGUIDs = nil
+				for i := 0; i < 120; i++ {
+					guid := fmt.Sprintf("some-app-guid-%d", i)
+					apps = append(apps, ccv3.Application{GUID: guid})
+					appGUIDs = append(appGUIDs, guid)
+				}
+				fakeCloudControllerClient.GetApplicationsReturns(apps, nil, nil)
+				fakeCloudControllerClient.GetProcessesReturnsOnCall(0, []ccv3.Process{{AppGUID: "some-app-guid-0", GUID: "process-guid-0"}}, ccv3.Warnings{"process-warning-1"}, nil)
+				fakeCloudControllerClient.GetProcessesReturnsOnCall(1, []ccv3.Process{{AppGUID: "some-app-guid-50", GUID: "process-guid-50"}}, ccv3.Warnings{"process-warning-2"}, nil)
+				fakeCloudControllerClient.GetProcessesReturnsOnCall(2, []ccv3.Process{{AppGUID: "some-app-guid-119", GUID: "process-guid-119"}}, ccv3.Warnings{"process-warning-3"}, nil)
+			})
+
+			It("requests the processes in batches of 50 app GUIDs", func() {
+				summaries, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("process-warning-1", "process-warning-2", "process-warning-3"))
+
+				Expect(fakeCloudControllerClient.GetProcessesCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetProcessesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: appGUIDs[0:50]},
+				))
+				Expect(fakeCloudControllerClient.GetProcessesArgsForCall(1)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: appGUIDs[50:100]},
+				))
+				Expect(fakeCloudControllerClient.GetProcessesArgsForCall(2)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: appGUIDs[100:120]},
+				))
+
+				Expect(summaries).To(HaveLen(120))
+				Expect(summaries[0].ProcessSummaries).To(HaveLen(1))
+				Expect(summaries[50].ProcessSummaries).To(HaveLen(1))
+				Expect(summaries[119].ProcessSummaries).To(HaveLen(1))
+				Expect(summaries[1].ProcessSummaries).To(BeEmpty())
+			})
+		})
+
+		Context("when there are no apps", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("does not request any processes", func() {
+				summaries, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(summaries).To(BeEmpty())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(fakeCloudControllerClient.GetProcessesCallCount()).To(Equal(0))
+			})
 		})
 
 		Context("when getting the app processes returns an error", func() {
@@ -160,7 +228,7 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 				)
 
 				expectedErr = errors.New("some error")
-				fakeCloudControllerClient.GetApplicationProcessesReturns(
+				fakeCloudControllerClient.GetProcessesReturns(
 					[]ccv3.Process{},
 					ccv3.Warnings{"some-process-warning"},
 					expectedErr,
@@ -190,11 +258,12 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 					nil,
 				)
 
-				fakeCloudControllerClient.GetApplicationProcessesReturns(
+				fakeCloudControllerClient.GetProcessesReturns(
 					[]ccv3.Process{
 						{
-							GUID: "some-process-guid",
-							Type: "some-type",
+							AppGUID: "some-app-guid",
+							GUID:    "some-process-guid",
+							Type:    "some-type",
 						},
 					},
 					ccv3.Warnings{"some-process-warning"},
@@ -0,0 +1,52 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// ApplicationWithSpaceAndOrganization represents an application along with
+// the names of the space and organization it belongs to.
+type ApplicationWithSpaceAndOrganization struct {
+	Application
+	SpaceName        string
+	OrganizationName string
+}
+
+// GetApplicationsWithSpaceAndOrganization returns the applications matching
+// the given queries along with the names of their spaces and organizations.
+// The spaces and organizations are side-loaded into the applications
+// response, so no request is made per application to look them up.
+func (actor Actor) GetApplicationsWithSpaceAndOrganization(queries ...ccv3.Query) ([]ApplicationWithSpaceAndOrganization, Warnings, error) {
+	apps, includedResources, warnings, err := actor.CloudControllerClient.GetApplicationsWithIncludedResources(
+		append([]ccv3.Query{
+			{Key: ccv3.Include, Values: []string{ccv3.SpaceOrganizationInclude}},
+			{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		}, queries...)...,
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgNamesByGUID := map[string]string{}
+	for _, org := range includedResources.Organizations {
+		orgNamesByGUID[org.GUID] = org.Name
+	}
+
+	spacesByGUID := map[string]ccv3.Space{}
+	for _, space := range includedResources.Spaces {
+		spacesByGUID[space.GUID] = space
+	}
+
+	var appsWithSpaceAndOrg []ApplicationWithSpaceAndOrganization
+	for _, app := range apps {
+		space := spacesByGUID[app.Relationships[constant.RelationshipTypeSpace].GUID]
+		appsWithSpaceAndOrg = append(appsWithSpaceAndOrg, ApplicationWithSpaceAndOrganization{
+			Application:      actor.convertCCToActorApplication(app),
+			SpaceName:        space.Name,
+			OrganizationName: orgNamesByGUID[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+		})
+	}
+
+	return appsWithSpaceAndOrg, Warnings(warnings), nil
+}
@@ -0,0 +1,144 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application with Space and Organization Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationsWithSpaceAndOrganization", func() {
+		var (
+			apps       []ApplicationWithSpaceAndOrganization
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = actor.GetApplicationsWithSpaceAndOrganization(
+				ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+			)
+		})
+
+		Context("when there are apps", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsWithIncludedResourcesReturns(
+					[]ccv3.Application{
+						{
+							Name:  "some-app-name-1",
+							GUID:  "some-app-guid-1",
+							State: constant.ApplicationStarted,
+							Relationships: ccv3.Relationships{
+								constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "some-space-guid-1"},
+							},
+						},
+						{
+							Name:  "some-app-name-2",
+							GUID:  "some-app-guid-2",
+							State: constant.ApplicationStopped,
+							Relationships: ccv3.Relationships{
+								constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "some-space-guid-2"},
+							},
+						},
+					},
+					ccv3.IncludedResources{
+						Spaces: []ccv3.Space{
+							{
+								Name: "some-space-name-1",
+								GUID: "some-space-guid-1",
+								Relationships: ccv3.Relationships{
+									constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "some-org-guid-1"},
+								},
+							},
+							{
+								Name: "some-space-name-2",
+								GUID: "some-space-guid-2",
+								Relationships: ccv3.Relationships{
+									constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "some-org-guid-2"},
+								},
+							},
+						},
+						Organizations: []ccv3.Organization{
+							{Name: "some-org-name-1", GUID: "some-org-guid-1"},
+							{Name: "some-org-name-2", GUID: "some-org-guid-2"},
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the apps with the names of their spaces and organizations and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(apps).To(Equal([]ApplicationWithSpaceAndOrganization{
+					{
+						Application: Application{
+							Name:  "some-app-name-1",
+							GUID:  "some-app-guid-1",
+							State: constant.ApplicationStarted,
+						},
+						SpaceName:        "some-space-name-1",
+						OrganizationName: "some-org-name-1",
+					},
+					{
+						Application: Application{
+							Name:  "some-app-name-2",
+							GUID:  "some-app-guid-2",
+							State: constant.ApplicationStopped,
+						},
+						SpaceName:        "some-space-name-2",
+						OrganizationName: "some-org-name-2",
+					},
+				}))
+			})
+
+			It("side-loads the spaces and organizations in a single request", func() {
+				Expect(fakeCloudControllerClient.GetApplicationsWithIncludedResourcesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsWithIncludedResourcesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.Include, Values: []string{ccv3.SpaceOrganizationInclude}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+				))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationsWithIncludedResourcesReturns(
+					nil,
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"some-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
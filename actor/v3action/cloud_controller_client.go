@@ -11,8 +11,10 @@ type CloudControllerClient interface {
 	AppSSHEndpoint() string
 	AppSSHHostKeyFingerprint() string
 	AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	CancelDeployment(deploymentGUID string) (ccv3.Warnings, error)
 	CloudControllerAPIVersion() string
 	CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	CreateApplicationDeployment(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
@@ -28,8 +30,10 @@ type CloudControllerClient interface {
 	GetApplicationProcessByType(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error)
 	GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
 	GetApplications(query ...ccv3.Query) ([]ccv3.Application, ccv3.Warnings, error)
+	GetApplicationsWithIncludedResources(query ...ccv3.Query) ([]ccv3.Application, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error)
 	GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error)
+	GetDeployment(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error)
 	GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	GetDroplets(query ...ccv3.Query) ([]ccv3.Droplet, ccv3.Warnings, error)
 	GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
@@ -39,6 +43,7 @@ type CloudControllerClient interface {
 	GetOrganizations(query ...ccv3.Query) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetPackage(guid string) (ccv3.Package, ccv3.Warnings, error)
 	GetPackages(query ...ccv3.Query) ([]ccv3.Package, ccv3.Warnings, error)
+	GetProcesses(query ...ccv3.Query) ([]ccv3.Process, ccv3.Warnings, error)
 	GetProcessInstances(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error)
 	GetServiceInstances(query ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
@@ -54,6 +59,8 @@ type CloudControllerClient interface {
 	UpdateApplicationEnvironmentVariables(appGUID string, envVars ccv3.EnvironmentVariables) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
 	UpdateApplicationStart(appGUID string) (ccv3.Application, ccv3.Warnings, error)
 	UpdateApplicationStop(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	UpdateProcess(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
+	UpdateResourceMetadata(resource string, guid string, metadata ccv3.Metadata) (ccv3.ResourceMetadata, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 }
@@ -0,0 +1,51 @@
+package v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// CreateDeployment starts a rolling deployment of the given droplet to the
+// application and returns the GUID of the deployment.
+func (actor Actor) CreateDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
+	deployment, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	return deployment.GUID, Warnings(warnings), nil
+}
+
+// CancelDeployment rolls the application back to the droplet it was running
+// before the deployment was created.
+func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
+
+// PollDeployment waits until the deployment has replaced all of the
+// application's instances, the deployment is canceled or the startup timeout
+// is reached.
+func (actor Actor) PollDeployment(deploymentGUID string, warningsChannel chan<- Warnings) error {
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		switch deployment.State {
+		case constant.DeploymentDeployed:
+			return nil
+		case constant.DeploymentCanceling, constant.DeploymentCanceled:
+			return actionerror.DeploymentCanceledError{}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return actionerror.StartupTimeoutError{}
+}
@@ -1,8 +1,11 @@
 package v3action
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // EnvironmentVariableGroups represents all environment variables for application
@@ -72,3 +75,31 @@ func (actor *Actor) UnsetEnvironmentVariableByApplicationNameAndSpace(appName st
 	warnings = append(warnings, patchWarnings...)
 	return warnings, patchErr
 }
+
+// CopyApplicationEnvironmentVariables sets the user provided environment
+// variables of the source application on the destination application. It
+// must be restarted for changes to take effect.
+func (actor *Actor) CopyApplicationEnvironmentVariables(sourceAppGUID string, destinationAppGUID string) (Warnings, error) {
+	envGroups, warnings, err := actor.CloudControllerClient.GetApplicationEnvironment(sourceAppGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if len(envGroups.EnvironmentVariables) == 0 {
+		return allWarnings, nil
+	}
+
+	envVars := ccv3.EnvironmentVariables{}
+	for name, value := range envGroups.EnvironmentVariables {
+		if stringValue, ok := value.(string); ok {
+			envVars[name] = types.FilteredString{Value: stringValue, IsSet: true}
+		} else {
+			envVars[name] = types.FilteredString{Value: fmt.Sprint(value), IsSet: true}
+		}
+	}
+
+	_, warnings, err = actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(destinationAppGUID, envVars)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
@@ -292,4 +292,89 @@ var _ = Describe("Environment Variable Actions", func() {
 			})
 		})
 	})
+
+	Describe("CopyApplicationEnvironmentVariables", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+			warnings                  Warnings
+			executeErr                error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CopyApplicationEnvironmentVariables("source-app-guid", "destination-app-guid")
+		})
+
+		Context("when the source app has environment variables", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+					ccv3.Environment{
+						EnvironmentVariables: map[string]interface{}{"SOME_VAR": "some-value", "SOME_NUMBER": float64(5)},
+					},
+					ccv3.Warnings{"get-env-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"update-env-warning"}, nil)
+			})
+
+			It("sets them on the destination app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-env-warning", "update-env-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("source-app-guid"))
+				appGUID, envVars := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+				Expect(appGUID).To(Equal("destination-app-guid"))
+				Expect(envVars).To(Equal(ccv3.EnvironmentVariables{
+					"SOME_VAR":    {Value: "some-value", IsSet: true},
+					"SOME_NUMBER": {Value: "5", IsSet: true},
+				}))
+			})
+
+			Context("when updating the destination app fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("update-error")
+					fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(nil, ccv3.Warnings{"update-env-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-env-warning", "update-env-warning"))
+				})
+			})
+		})
+
+		Context("when the source app has no environment variables", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{}, ccv3.Warnings{"get-env-warning"}, nil)
+			})
+
+			It("does not update the destination app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-env-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the source environment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-error")
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv3.Environment{}, ccv3.Warnings{"get-env-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-env-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
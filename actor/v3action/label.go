@@ -0,0 +1,93 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata represents the user defined labels and annotations of a resource.
+type Metadata ccv3.Metadata
+
+// GetApplicationLabels returns the labels of the application with the given
+// name in the given space.
+func (actor Actor) GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return metadataLabels((*ccv3.Metadata)(app.Metadata)), warnings, nil
+}
+
+// GetOrganizationLabels returns the labels of the organization with the given
+// name.
+func (actor Actor) GetOrganizationLabels(orgName string) (map[string]types.NullString, Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return metadataLabels(org.Metadata), warnings, nil
+}
+
+// GetSpaceLabels returns the labels of the space with the given name in the
+// given organization.
+func (actor Actor) GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return metadataLabels(space.Metadata), warnings, nil
+}
+
+// UpdateApplicationLabelsByApplicationName adds, updates and removes the
+// provided labels on the application with the given name in the given space.
+// Labels with an unset value are removed.
+func (actor Actor) UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceMetadata("app", app.GUID, ccv3.Metadata{Labels: labels}, warnings)
+}
+
+// UpdateOrganizationLabelsByOrganizationName adds, updates and removes the
+// provided labels on the organization with the given name. Labels with an
+// unset value are removed.
+func (actor Actor) UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceMetadata("org", org.GUID, ccv3.Metadata{Labels: labels}, warnings)
+}
+
+// UpdateSpaceLabelsBySpaceName adds, updates and removes the provided labels
+// on the space with the given name in the given organization. Labels with an
+// unset value are removed.
+func (actor Actor) UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceMetadata("space", space.GUID, ccv3.Metadata{Labels: labels}, warnings)
+}
+
+// updateResourceMetadata sends the provided labels and annotations of the
+// resource to the Cloud Controller, appending its warnings to allWarnings.
+func (actor Actor) updateResourceMetadata(resource string, guid string, metadata ccv3.Metadata, allWarnings Warnings) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateResourceMetadata(resource, guid, metadata)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func metadataLabels(metadata *ccv3.Metadata) map[string]types.NullString {
+	if metadata == nil {
+		return nil
+	}
+	return metadata.Labels
+}
@@ -0,0 +1,223 @@
+package v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Label Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		labels                    map[string]types.NullString
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationLabels", func() {
+		JustBeforeEach(func() {
+			labels, warnings, executeErr = actor.GetApplicationLabels("some-app-name", "some-space-guid")
+		})
+
+		Context("when the app has labels", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{
+						GUID: "some-app-guid",
+						Metadata: &ccv3.Metadata{
+							Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+						},
+					}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns the labels and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		Context("when the app has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, nil, nil)
+			})
+
+			It("returns no labels", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(labels).To(BeEmpty())
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{
+					GUID: "some-org-guid",
+					Metadata: &ccv3.Metadata{
+						Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+					},
+				}},
+				ccv3.Warnings{"get-org-warning"},
+				nil,
+			)
+		})
+
+		It("returns the labels and warnings", func() {
+			labels, warnings, executeErr = actor.GetOrganizationLabels("some-org-name")
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+			Expect(warnings).To(ConsistOf("get-org-warning"))
+		})
+	})
+
+	Describe("GetSpaceLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{
+					GUID: "some-space-guid",
+					Metadata: &ccv3.Metadata{
+						Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+					},
+				}},
+				ccv3.Warnings{"get-space-warning"},
+				nil,
+			)
+		})
+
+		It("returns the labels and warnings", func() {
+			labels, warnings, executeErr = actor.GetSpaceLabels("some-space-name", "some-org-guid")
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+			Expect(warnings).To(ConsistOf("get-space-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-space-name"}},
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+			))
+		})
+	})
+
+	Describe("UpdateApplicationLabelsByApplicationName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{
+				"env":  types.NewNullString("prod"),
+				"tier": types.NewNullString(),
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationLabelsByApplicationName("some-app-name", "some-space-guid", labels)
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates the labels of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+				resource, guid, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resource).To(Equal("app"))
+				Expect(guid).To(Equal("some-app-guid"))
+				Expect(metadata).To(Equal(ccv3.Metadata{Labels: labels}))
+			})
+
+			Context("when updating the metadata fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-app-warning", "update-warning"))
+				})
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError without updating", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationLabelsByOrganizationName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{GUID: "some-org-guid"}}, ccv3.Warnings{"get-org-warning"}, nil)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the org", func() {
+			warnings, executeErr = actor.UpdateOrganizationLabelsByOrganizationName("some-org-name", map[string]types.NullString{"env": types.NewNullString("prod")})
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-org-warning", "update-warning"))
+
+			resource, guid, _ := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resource).To(Equal("org"))
+			Expect(guid).To(Equal("some-org-guid"))
+		})
+	})
+
+	Describe("UpdateSpaceLabelsBySpaceName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{{GUID: "some-space-guid"}}, ccv3.Warnings{"get-space-warning"}, nil)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the space", func() {
+			warnings, executeErr = actor.UpdateSpaceLabelsBySpaceName("some-space-name", "some-org-guid", map[string]types.NullString{"env": types.NewNullString("prod")})
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-warning", "update-warning"))
+
+			resource, guid, _ := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resource).To(Equal("space"))
+			Expect(guid).To(Equal("some-space-guid"))
+		})
+	})
+})
@@ -45,30 +45,32 @@ func (actor Actor) CreateDockerPackageByApplicationNameAndSpace(appName string,
 	return Package(pkg), allWarnings, err
 }
 
-func (actor Actor) CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (Package, Warnings, error) {
-	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+// GetBitsPackageResources returns the files that
+// CreateAndUploadBitsPackageByApplicationNameAndSpace would upload for the
+// provided path.
+func (actor Actor) GetBitsPackageResources(bitsPath string) ([]sharedaction.Resource, error) {
+	_, _, resources, err := actor.gatherBitsResources(bitsPath)
 	if err != nil {
-		return Package{}, allWarnings, err
+		return nil, err
 	}
 
-	if bitsPath == "" {
-		bitsPath, err = os.Getwd()
-		if err != nil {
-			return Package{}, allWarnings, err
+	var files []sharedaction.Resource
+	for _, resource := range resources {
+		if resource.Mode.IsDir() {
+			continue
 		}
+		files = append(files, resource)
 	}
+	return files, nil
+}
 
-	info, err := os.Stat(bitsPath)
+func (actor Actor) CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (Package, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return Package{}, allWarnings, err
 	}
 
-	var resources []sharedaction.Resource
-	if info.IsDir() {
-		resources, err = actor.SharedActor.GatherDirectoryResources(bitsPath)
-	} else {
-		resources, err = actor.SharedActor.GatherArchiveResources(bitsPath)
-	}
+	bitsPath, info, resources, err := actor.gatherBitsResources(bitsPath)
 	if err != nil {
 		return Package{}, allWarnings, err
 	}
@@ -148,3 +150,32 @@ func (actor *Actor) GetApplicationPackages(appName string, spaceGUID string) ([]
 
 	return packages, allWarnings, nil
 }
+
+// gatherBitsResources gathers the resources of the provided directory or
+// archive, defaulting to the current directory when no path is provided.
+func (actor Actor) gatherBitsResources(bitsPath string) (string, os.FileInfo, []sharedaction.Resource, error) {
+	var err error
+	if bitsPath == "" {
+		bitsPath, err = os.Getwd()
+		if err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	info, err := os.Stat(bitsPath)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var resources []sharedaction.Resource
+	if info.IsDir() {
+		resources, err = actor.SharedActor.GatherDirectoryResources(bitsPath)
+	} else {
+		resources, err = actor.SharedActor.GatherArchiveResources(bitsPath)
+	}
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return bitsPath, info, resources, nil
+}
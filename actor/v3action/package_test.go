@@ -258,6 +258,92 @@ var _ = Describe("Package Actions", func() {
 		})
 	})
 
+	Describe("GetBitsPackageResources", func() {
+		var (
+			bitsPath   string
+			resources  []sharedaction.Resource
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			resources, executeErr = actor.GetBitsPackageResources(bitsPath)
+		})
+
+		Context("when bits path is a directory", func() {
+			BeforeEach(func() {
+				var err error
+				bitsPath, err = ioutil.TempDir("", "example")
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeSharedActor.GatherDirectoryResourcesReturns([]sharedaction.Resource{
+					{Filename: "some-dir", Mode: os.ModeDir | 0755},
+					{Filename: "some-dir/file-1", Mode: 0644, Size: 10},
+					{Filename: "file-2", Mode: 0644, Size: 32},
+				}, nil)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(bitsPath)).To(Succeed())
+			})
+
+			It("returns the files", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(Equal([]sharedaction.Resource{
+					{Filename: "some-dir/file-1", Mode: 0644, Size: 10},
+					{Filename: "file-2", Mode: 0644, Size: 32},
+				}))
+
+				Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(1))
+				Expect(fakeSharedActor.GatherDirectoryResourcesArgsForCall(0)).To(Equal(bitsPath))
+			})
+		})
+
+		Context("when bits path is an archive", func() {
+			BeforeEach(func() {
+				archive, err := ioutil.TempFile("", "example-archive-")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(archive.Close()).To(Succeed())
+				bitsPath = archive.Name()
+
+				fakeSharedActor.GatherArchiveResourcesReturns([]sharedaction.Resource{
+					{Filename: "file-1", Mode: 0644, Size: 7},
+				}, nil)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(bitsPath)).To(Succeed())
+			})
+
+			It("returns the files in the archive", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(Equal([]sharedaction.Resource{
+					{Filename: "file-1", Mode: 0644, Size: 7},
+				}))
+
+				Expect(fakeSharedActor.GatherArchiveResourcesCallCount()).To(Equal(1))
+				Expect(fakeSharedActor.GatherArchiveResourcesArgsForCall(0)).To(Equal(bitsPath))
+			})
+		})
+
+		Context("when gathering the resources fails", func() {
+			BeforeEach(func() {
+				var err error
+				bitsPath, err = ioutil.TempDir("", "example")
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeSharedActor.GatherDirectoryResourcesReturns(nil, errors.New("some-gather-error"))
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(bitsPath)).To(Succeed())
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-gather-error"))
+			})
+		})
+	})
+
 	Describe("CreateAndUploadBitsPackageByApplicationNameAndSpace", func() {
 		var (
 			bitsPath   string
@@ -21,3 +21,80 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// UpdateProcessByTypeAndApplication updates the command and health check of
+// the process with the given type. Only the fields that are set on the
+// provided process are changed.
+func (actor Actor) UpdateProcessByTypeAndApplication(processType string, appGUID string, updatedProcess Process) (Warnings, error) {
+	ccProcess, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, processType)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return allWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+		}
+		return allWarnings, err
+	}
+
+	updatedProcess.GUID = ccProcess.GUID
+	_, warnings, err = actor.CloudControllerClient.UpdateProcess(ccv3.Process(updatedProcess))
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// CopyApplicationProcesses sets the scale and health check of every process
+// of the source application on the process of the same type of the
+// destination application. Process types the destination application does
+// not have are skipped.
+func (actor Actor) CopyApplicationProcesses(sourceAppGUID string, destinationAppGUID string) (Warnings, error) {
+	sourceProcesses, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(sourceAppGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	destinationProcesses, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(destinationAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	destinationGUIDs := map[string]string{}
+	for _, process := range destinationProcesses {
+		destinationGUIDs[process.Type] = process.GUID
+	}
+
+	for _, process := range sourceProcesses {
+		destinationGUID, ok := destinationGUIDs[process.Type]
+		if !ok {
+			continue
+		}
+
+		_, warnings, err = actor.CloudControllerClient.CreateApplicationProcessScale(destinationAppGUID, ccv3.Process{
+			Type:       process.Type,
+			Instances:  process.Instances,
+			MemoryInMB: process.MemoryInMB,
+			DiskInMB:   process.DiskInMB,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		if process.HealthCheckType == "" {
+			continue
+		}
+
+		_, warnings, err = actor.CloudControllerClient.UpdateProcess(ccv3.Process{
+			GUID:                destinationGUID,
+			HealthCheckType:     process.HealthCheckType,
+			HealthCheckEndpoint: process.HealthCheckEndpoint,
+			HealthCheckTimeout:  process.HealthCheckTimeout,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
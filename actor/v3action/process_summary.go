@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
@@ -77,6 +78,56 @@ func (actor Actor) getProcessSummariesForApp(appGUID string) (ProcessSummaries,
 		return nil, allWarnings, err
 	}
 
+	processSummaries, instanceWarnings, err := actor.getProcessSummaries(ccv3Processes)
+	allWarnings = append(allWarnings, instanceWarnings...)
+	return processSummaries, allWarnings, err
+}
+
+// appGUIDsPerProcessesRequest is the maximum number of application GUIDs
+// filtered on in a single processes request, keeping the request URL well
+// below the length Cloud Controller and intermediate proxies accept.
+const appGUIDsPerProcessesRequest = 50
+
+// getProcessSummariesForApps retrieves the processes of all the given
+// applications, requesting them in batches of appGUIDsPerProcessesRequest,
+// and groups their summaries by application GUID.
+func (actor Actor) getProcessSummariesForApps(appGUIDs []string) (map[string]ProcessSummaries, Warnings, error) {
+	var allWarnings Warnings
+
+	var ccv3Processes []ccv3.Process
+	for start := 0; start < len(appGUIDs); start += appGUIDsPerProcessesRequest {
+		end := start + appGUIDsPerProcessesRequest
+		if end > len(appGUIDs) {
+			end = len(appGUIDs)
+		}
+
+		processes, warnings, err := actor.CloudControllerClient.GetProcesses(
+			ccv3.Query{Key: ccv3.AppGUIDFilter, Values: appGUIDs[start:end]},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		ccv3Processes = append(ccv3Processes, processes...)
+	}
+
+	processSummaries, instanceWarnings, err := actor.getProcessSummaries(ccv3Processes)
+	allWarnings = append(allWarnings, instanceWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processSummariesByApp := map[string]ProcessSummaries{}
+	for _, processSummary := range processSummaries {
+		processSummariesByApp[processSummary.AppGUID] = append(processSummariesByApp[processSummary.AppGUID], processSummary)
+	}
+
+	return processSummariesByApp, allWarnings, nil
+}
+
+func (actor Actor) getProcessSummaries(ccv3Processes []ccv3.Process) (ProcessSummaries, Warnings, error) {
+	var allWarnings Warnings
+
 	var processSummaries ProcessSummaries
 	for _, ccv3Process := range ccv3Processes {
 		processGUID := ccv3Process.GUID
@@ -98,4 +98,193 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("UpdateProcessByTypeAndApplication", func() {
+		var (
+			updatedProcess Process
+
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			updatedProcess = Process{
+				Command:            types.FilteredString{IsSet: true, Value: "some-command"},
+				HealthCheckType:    "http",
+				HealthCheckTimeout: 60,
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.UpdateProcessByTypeAndApplication("worker", "some-app-guid", updatedProcess)
+		})
+
+		Context("when the process exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{GUID: "some-process-guid"},
+					ccv3.Warnings{"get-process-warning"},
+					nil)
+			})
+
+			Context("when updating the process succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateProcessReturns(
+						ccv3.Process{GUID: "some-process-guid"},
+						ccv3.Warnings{"update-process-warning"},
+						nil)
+				})
+
+				It("updates the process and returns all warnings", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-process-warning", "update-process-warning"))
+
+					Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+					appGUIDArg, processTypeArg := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+					Expect(appGUIDArg).To(Equal("some-app-guid"))
+					Expect(processTypeArg).To(Equal("worker"))
+
+					Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(ccv3.Process{
+						GUID:               "some-process-guid",
+						Command:            types.FilteredString{IsSet: true, Value: "some-command"},
+						HealthCheckType:    "http",
+						HealthCheckTimeout: 60,
+					}))
+				})
+			})
+
+			Context("when updating the process errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("update process error")
+					fakeCloudControllerClient.UpdateProcessReturns(
+						ccv3.Process{},
+						ccv3.Warnings{"update-process-warning"},
+						expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-process-warning", "update-process-warning"))
+				})
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{},
+					ccv3.Warnings{"get-process-warning"},
+					ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(err).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "worker"}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CopyApplicationProcesses", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CopyApplicationProcesses("source-app-guid", "destination-app-guid")
+		})
+
+		Context("when getting the processes succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturnsOnCall(0,
+					[]ccv3.Process{
+						{
+							GUID:                "source-web-guid",
+							Type:                constant.ProcessTypeWeb,
+							Instances:           types.NullInt{Value: 3, IsSet: true},
+							MemoryInMB:          types.NullUint64{Value: 256, IsSet: true},
+							DiskInMB:            types.NullUint64{Value: 512, IsSet: true},
+							HealthCheckType:     "http",
+							HealthCheckEndpoint: "/health",
+							HealthCheckTimeout:  90,
+						},
+						{
+							GUID:      "source-worker-guid",
+							Type:      "worker",
+							Instances: types.NullInt{Value: 2, IsSet: true},
+						},
+					},
+					ccv3.Warnings{"get-source-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationProcessesReturnsOnCall(1,
+					[]ccv3.Process{{GUID: "destination-web-guid", Type: constant.ProcessTypeWeb}},
+					ccv3.Warnings{"get-destination-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, nil)
+				fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("copies the scale and health check of the matching process types", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-source-warning", "get-destination-warning", "scale-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("source-app-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(1)).To(Equal("destination-app-guid"))
+
+				Expect(fakeCloudControllerClient.CreateApplicationProcessScaleCallCount()).To(Equal(1))
+				appGUID, scale := fakeCloudControllerClient.CreateApplicationProcessScaleArgsForCall(0)
+				Expect(appGUID).To(Equal("destination-app-guid"))
+				Expect(scale).To(Equal(ccv3.Process{
+					Type:       constant.ProcessTypeWeb,
+					Instances:  types.NullInt{Value: 3, IsSet: true},
+					MemoryInMB: types.NullUint64{Value: 256, IsSet: true},
+					DiskInMB:   types.NullUint64{Value: 512, IsSet: true},
+				}))
+
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(ccv3.Process{
+					GUID:                "destination-web-guid",
+					HealthCheckType:     "http",
+					HealthCheckEndpoint: "/health",
+					HealthCheckTimeout:  90,
+				}))
+			})
+
+			Context("when scaling a process fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("scale-error")
+					fakeCloudControllerClient.CreateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-source-warning", "get-destination-warning", "scale-warning"))
+					Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when getting the source processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-source-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-source-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@ package v3action
 
 import (
 	"fmt"
+	"sort"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 )
@@ -11,6 +12,7 @@ type SSHAuthentication struct {
 	HostKeyFingerprint string
 	Passcode           string
 	Username           string
+	InstanceIndex      uint
 }
 
 // GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex returns
@@ -75,5 +77,74 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 		HostKeyFingerprint: fingerprint,
 		Passcode:           passcode,
 		Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex),
+		InstanceIndex:      processIndex,
 	}, warnings, err
 }
+
+// GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType returns
+// back the SSH authentication information for a session to every running
+// instance of the process, ordered by instance index. The passcodes are left
+// empty; fetch one per session with GetSSHPasscode right before connecting.
+func (actor Actor) GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType(
+	appName string, spaceGUID string, processType string,
+) ([]SSHAuthentication, Warnings, error) {
+	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
+	if endpoint == "" {
+		return nil, nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := actor.CloudControllerClient.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return nil, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	appSummary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var processSummary ProcessSummary
+	for _, appProcessSummary := range appSummary.ProcessSummaries {
+		if appProcessSummary.Type == processType {
+			processSummary = appProcessSummary
+			break
+		}
+	}
+	if processSummary.GUID == "" {
+		return nil, warnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+	}
+
+	if !appSummary.Application.Started() {
+		return nil, warnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	var runningInstances []ProcessInstance
+	for _, instance := range processSummary.InstanceDetails {
+		if instance.Running() {
+			runningInstances = append(runningInstances, instance)
+		}
+	}
+	if len(runningInstances) == 0 {
+		return nil, warnings, actionerror.ProcessInstancesNotRunningError{ProcessType: processType}
+	}
+	sort.Slice(runningInstances, func(i int, j int) bool {
+		return runningInstances[i].Index < runningInstances[j].Index
+	})
+
+	var sshAuths []SSHAuthentication
+	for _, instance := range runningInstances {
+		sshAuths = append(sshAuths, SSHAuthentication{
+			Endpoint:           endpoint,
+			HostKeyFingerprint: fingerprint,
+			Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, instance.Index),
+			InstanceIndex:      uint(instance.Index),
+		})
+	}
+
+	return sshAuths, warnings, nil
+}
+
+// GetSSHPasscode returns back a new one time passcode for an SSH session.
+func (actor Actor) GetSSHPasscode() (string, error) {
+	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+}
@@ -177,4 +177,124 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType", func() {
+		var sshAuths []SSHAuthentication
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+				{State: constant.ProcessInstanceRunning, Index: 2},
+				{State: constant.ProcessInstanceDown, Index: 1},
+				{State: constant.ProcessInstanceRunning, Index: 0},
+			}, ccv3.Warnings{"some-instance-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sshAuths, warnings, executeErr = actor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType("some-app", "some-space-guid", "some-process-type")
+		})
+
+		It("returns a configuration without a passcode for each running instance, ordered by index", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+			Expect(sshAuths).To(Equal([]SSHAuthentication{
+				{
+					Endpoint:           "some-app-ssh-endpoint",
+					HostKeyFingerprint: "some-app-ssh-fingerprint",
+					Username:           "cf:some-process-guid/0",
+					InstanceIndex:      0,
+				},
+				{
+					Endpoint:           "some-app-ssh-endpoint",
+					HostKeyFingerprint: "some-app-ssh-fingerprint",
+					Username:           "cf:some-process-guid/2",
+					InstanceIndex:      2,
+				},
+			}))
+
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+		})
+
+		Context("when the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AppSSHEndpointReturns("")
+			})
+
+			It("returns an SSHEndpointNotSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+			})
+		})
+
+		Context("when the application is not in the STARTED state", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStopped}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns an ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+			})
+		})
+
+		Context("when no instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceDown, Index: 0},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("returns a ProcessInstancesNotRunningError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstancesNotRunningError{ProcessType: "some-process-type"}))
+			})
+		})
+	})
+
+	Describe("GetSSHPasscode", func() {
+		var passcode string
+
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+			fakeUAAClient.GetSSHPasscodeReturns("some-ssh-passcode", nil)
+		})
+
+		JustBeforeEach(func() {
+			passcode, executeErr = actor.GetSSHPasscode()
+		})
+
+		It("returns a passcode from the UAA", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(passcode).To(Equal("some-ssh-passcode"))
+
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(1))
+			accessTokenArg, oathClientArg := fakeUAAClient.GetSSHPasscodeArgsForCall(0)
+			Expect(accessTokenArg).To(Equal("some-access-token"))
+			Expect(oathClientArg).To(Equal("some-access-oauth-client"))
+		})
+
+		Context("when getting the passcode errors", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetSSHPasscodeReturns("", errors.New("some-ssh-passcode-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-ssh-passcode-error"))
+			})
+		})
+	})
 })
@@ -43,6 +43,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CancelDeploymentStub        func(deploymentGUID string) (ccv3.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	cancelDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -67,6 +80,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentStub        func(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createApplicationDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -293,6 +322,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationsWithIncludedResourcesStub        func(query ...ccv3.Query) ([]ccv3.Application, ccv3.IncludedResources, ccv3.Warnings, error)
+	getApplicationsWithIncludedResourcesMutex       sync.RWMutex
+	getApplicationsWithIncludedResourcesArgsForCall []struct {
+		query []ccv3.Query
+	}
+	getApplicationsWithIncludedResourcesReturns struct {
+		result1 []ccv3.Application
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}
+	getApplicationsWithIncludedResourcesReturnsOnCall map[int]struct {
+		result1 []ccv3.Application
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}
 	GetApplicationTasksStub        func(appGUID string, query ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -324,6 +370,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -459,6 +520,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetProcessesStub        func(query ...ccv3.Query) ([]ccv3.Process, ccv3.Warnings, error)
+	getProcessesMutex       sync.RWMutex
+	getProcessesArgsForCall []struct {
+		query []ccv3.Query
+	}
+	getProcessesReturns struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getProcessesReturnsOnCall map[int]struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetProcessInstancesStub        func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error)
 	getProcessInstancesMutex       sync.RWMutex
 	getProcessInstancesArgsForCall []struct {
@@ -688,6 +764,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateProcessStub        func(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
+	updateProcessMutex       sync.RWMutex
+	updateProcessArgsForCall []struct {
+		process ccv3.Process
+	}
+	updateProcessReturns struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateProcessReturnsOnCall map[int]struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateResourceMetadataStub        func(resource string, guid string, metadata ccv3.Metadata) (ccv3.ResourceMetadata, ccv3.Warnings, error)
+	updateResourceMetadataMutex       sync.RWMutex
+	updateResourceMetadataArgsForCall []struct {
+		resource string
+		guid     string
+		metadata ccv3.Metadata
+	}
+	updateResourceMetadataReturns struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateResourceMetadataReturnsOnCall map[int]struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -858,6 +966,57 @@ func (fake *FakeCloudControllerClient) AssignSpaceToIsolationSegmentReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CancelDeployment(deploymentGUID string) (ccv3.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("CancelDeployment", []interface{}{deploymentGUID})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelDeploymentReturns.result1, fake.cancelDeploymentReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return fake.cancelDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) CancelDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -952,6 +1111,61 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(appGUID string, dropletGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentReturns.result1, fake.createApplicationDeploymentReturns.result2, fake.createApplicationDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return fake.createApplicationDeploymentArgsForCall[i].appGUID, fake.createApplicationDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -1765,6 +1979,63 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationsWithIncludedResources(query ...ccv3.Query) ([]ccv3.Application, ccv3.IncludedResources, ccv3.Warnings, error) {
+	fake.getApplicationsWithIncludedResourcesMutex.Lock()
+	ret, specificReturn := fake.getApplicationsWithIncludedResourcesReturnsOnCall[len(fake.getApplicationsWithIncludedResourcesArgsForCall)]
+	fake.getApplicationsWithIncludedResourcesArgsForCall = append(fake.getApplicationsWithIncludedResourcesArgsForCall, struct {
+		query []ccv3.Query
+	}{query})
+	fake.recordInvocation("GetApplicationsWithIncludedResources", []interface{}{query})
+	fake.getApplicationsWithIncludedResourcesMutex.Unlock()
+	if fake.GetApplicationsWithIncludedResourcesStub != nil {
+		return fake.GetApplicationsWithIncludedResourcesStub(query...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getApplicationsWithIncludedResourcesReturns.result1, fake.getApplicationsWithIncludedResourcesReturns.result2, fake.getApplicationsWithIncludedResourcesReturns.result3, fake.getApplicationsWithIncludedResourcesReturns.result4
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsWithIncludedResourcesCallCount() int {
+	fake.getApplicationsWithIncludedResourcesMutex.RLock()
+	defer fake.getApplicationsWithIncludedResourcesMutex.RUnlock()
+	return len(fake.getApplicationsWithIncludedResourcesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsWithIncludedResourcesArgsForCall(i int) []ccv3.Query {
+	fake.getApplicationsWithIncludedResourcesMutex.RLock()
+	defer fake.getApplicationsWithIncludedResourcesMutex.RUnlock()
+	return fake.getApplicationsWithIncludedResourcesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsWithIncludedResourcesReturns(result1 []ccv3.Application, result2 ccv3.IncludedResources, result3 ccv3.Warnings, result4 error) {
+	fake.GetApplicationsWithIncludedResourcesStub = nil
+	fake.getApplicationsWithIncludedResourcesReturns = struct {
+		result1 []ccv3.Application
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationsWithIncludedResourcesReturnsOnCall(i int, result1 []ccv3.Application, result2 ccv3.IncludedResources, result3 ccv3.Warnings, result4 error) {
+	fake.GetApplicationsWithIncludedResourcesStub = nil
+	if fake.getApplicationsWithIncludedResourcesReturnsOnCall == nil {
+		fake.getApplicationsWithIncludedResourcesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Application
+			result2 ccv3.IncludedResources
+			result3 ccv3.Warnings
+			result4 error
+		})
+	}
+	fake.getApplicationsWithIncludedResourcesReturnsOnCall[i] = struct {
+		result1 []ccv3.Application
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -1874,6 +2145,60 @@ func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDeployment(deploymentGUID string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("GetDeployment", []interface{}{deploymentGUID})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentReturns.result1, fake.getDeploymentReturns.result2, fake.getDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return fake.getDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error) {
 	fake.getDropletMutex.Lock()
 	ret, specificReturn := fake.getDropletReturnsOnCall[len(fake.getDropletArgsForCall)]
@@ -2360,6 +2685,60 @@ func (fake *FakeCloudControllerClient) GetPackagesReturnsOnCall(i int, result1 [
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetProcesses(query ...ccv3.Query) ([]ccv3.Process, ccv3.Warnings, error) {
+	fake.getProcessesMutex.Lock()
+	ret, specificReturn := fake.getProcessesReturnsOnCall[len(fake.getProcessesArgsForCall)]
+	fake.getProcessesArgsForCall = append(fake.getProcessesArgsForCall, struct {
+		query []ccv3.Query
+	}{query})
+	fake.recordInvocation("GetProcesses", []interface{}{query})
+	fake.getProcessesMutex.Unlock()
+	if fake.GetProcessesStub != nil {
+		return fake.GetProcessesStub(query...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessesReturns.result1, fake.getProcessesReturns.result2, fake.getProcessesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetProcessesCallCount() int {
+	fake.getProcessesMutex.RLock()
+	defer fake.getProcessesMutex.RUnlock()
+	return len(fake.getProcessesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetProcessesArgsForCall(i int) []ccv3.Query {
+	fake.getProcessesMutex.RLock()
+	defer fake.getProcessesMutex.RUnlock()
+	return fake.getProcessesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetProcessesReturns(result1 []ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.GetProcessesStub = nil
+	fake.getProcessesReturns = struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetProcessesReturnsOnCall(i int, result1 []ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.GetProcessesStub = nil
+	if fake.getProcessesReturnsOnCall == nil {
+		fake.getProcessesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Process
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessesReturnsOnCall[i] = struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetProcessInstances(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
 	fake.getProcessInstancesMutex.Lock()
 	ret, specificReturn := fake.getProcessInstancesReturnsOnCall[len(fake.getProcessInstancesArgsForCall)]
@@ -3182,6 +3561,116 @@ func (fake *FakeCloudControllerClient) UpdateApplicationStopReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateProcess(process ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
+	fake.updateProcessMutex.Lock()
+	ret, specificReturn := fake.updateProcessReturnsOnCall[len(fake.updateProcessArgsForCall)]
+	fake.updateProcessArgsForCall = append(fake.updateProcessArgsForCall, struct {
+		process ccv3.Process
+	}{process})
+	fake.recordInvocation("UpdateProcess", []interface{}{process})
+	fake.updateProcessMutex.Unlock()
+	if fake.UpdateProcessStub != nil {
+		return fake.UpdateProcessStub(process)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateProcessReturns.result1, fake.updateProcessReturns.result2, fake.updateProcessReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessCallCount() int {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return len(fake.updateProcessArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessArgsForCall(i int) ccv3.Process {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return fake.updateProcessArgsForCall[i].process
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessReturns(result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateProcessStub = nil
+	fake.updateProcessReturns = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessReturnsOnCall(i int, result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateProcessStub = nil
+	if fake.updateProcessReturnsOnCall == nil {
+		fake.updateProcessReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Process
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateProcessReturnsOnCall[i] = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadata(resource string, guid string, metadata ccv3.Metadata) (ccv3.ResourceMetadata, ccv3.Warnings, error) {
+	fake.updateResourceMetadataMutex.Lock()
+	ret, specificReturn := fake.updateResourceMetadataReturnsOnCall[len(fake.updateResourceMetadataArgsForCall)]
+	fake.updateResourceMetadataArgsForCall = append(fake.updateResourceMetadataArgsForCall, struct {
+		resource string
+		guid     string
+		metadata ccv3.Metadata
+	}{resource, guid, metadata})
+	fake.recordInvocation("UpdateResourceMetadata", []interface{}{resource, guid, metadata})
+	fake.updateResourceMetadataMutex.Unlock()
+	if fake.UpdateResourceMetadataStub != nil {
+		return fake.UpdateResourceMetadataStub(resource, guid, metadata)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateResourceMetadataReturns.result1, fake.updateResourceMetadataReturns.result2, fake.updateResourceMetadataReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataCallCount() int {
+	fake.updateResourceMetadataMutex.RLock()
+	defer fake.updateResourceMetadataMutex.RUnlock()
+	return len(fake.updateResourceMetadataArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataArgsForCall(i int) (string, string, ccv3.Metadata) {
+	fake.updateResourceMetadataMutex.RLock()
+	defer fake.updateResourceMetadataMutex.RUnlock()
+	return fake.updateResourceMetadataArgsForCall[i].resource, fake.updateResourceMetadataArgsForCall[i].guid, fake.updateResourceMetadataArgsForCall[i].metadata
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataReturns(result1 ccv3.ResourceMetadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateResourceMetadataStub = nil
+	fake.updateResourceMetadataReturns = struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataReturnsOnCall(i int, result1 ccv3.ResourceMetadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateResourceMetadataStub = nil
+	if fake.updateResourceMetadataReturnsOnCall == nil {
+		fake.updateResourceMetadataReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ResourceMetadata
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateResourceMetadataReturnsOnCall[i] = struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskMutex.Lock()
 	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
@@ -3300,10 +3789,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.appSSHHostKeyFingerprintMutex.RUnlock()
 	fake.assignSpaceToIsolationSegmentMutex.RLock()
 	defer fake.assignSpaceToIsolationSegmentMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
@@ -3334,10 +3827,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationProcessesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getApplicationsWithIncludedResourcesMutex.RLock()
+	defer fake.getApplicationsWithIncludedResourcesMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
@@ -3356,6 +3853,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPackageMutex.RUnlock()
 	fake.getPackagesMutex.RLock()
 	defer fake.getPackagesMutex.RUnlock()
+	fake.getProcessesMutex.RLock()
+	defer fake.getProcessesMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
@@ -3386,6 +3885,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationStartMutex.RUnlock()
 	fake.updateApplicationStopMutex.RLock()
 	defer fake.updateApplicationStopMutex.RUnlock()
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	fake.updateResourceMetadataMutex.RLock()
+	defer fake.updateResourceMetadataMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
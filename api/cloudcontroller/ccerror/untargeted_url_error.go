@@ -0,0 +1,12 @@
+package ccerror
+
+// UntargetedURLError is returned when a request is made to a fully qualified
+// URL whose scheme or host does not match the targeted Cloud Controller.
+type UntargetedURLError struct {
+	URL string
+	API string
+}
+
+func (e UntargetedURLError) Error() string {
+	return "URL " + e.URL + " is not on the targeted API " + e.API
+}
@@ -1,6 +1,7 @@
 package ccv2_test
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -299,6 +300,62 @@ var _ = Describe("Application", func() {
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
 			})
 		})
+
+		Context("when the client requests pages concurrently", func() {
+			BeforeEach(func() {
+				server.Reset()
+				client = NewTestClient(Config{PaginationConcurrency: 2})
+
+				page3Requested := make(chan bool)
+				server.RouteToHandler(http.MethodGet, "/v2/apps", func(w http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					switch req.URL.Query().Get("page") {
+					case "":
+						w.Header().Set("X-Cf-Warnings", "warning-1")
+						fmt.Fprint(w, `{
+							"total_pages": 3,
+							"next_url": "/v2/apps?q=space_guid:some-space-guid&page=2&results-per-page=1",
+							"resources": [{"metadata": {"guid": "app-guid-1"}, "entity": {"name": "app-name-1"}}]
+						}`)
+					case "2":
+						Expect(req.URL.RawQuery).To(Equal("page=2&q=space_guid%3Asome-space-guid&results-per-page=1"))
+						Eventually(page3Requested).Should(Receive())
+						w.Header().Set("X-Cf-Warnings", "warning-2")
+						fmt.Fprint(w, `{
+							"total_pages": 3,
+							"next_url": "/v2/apps?q=space_guid:some-space-guid&page=3&results-per-page=1",
+							"resources": [{"metadata": {"guid": "app-guid-2"}, "entity": {"name": "app-name-2"}}]
+						}`)
+					case "3":
+						Expect(req.URL.RawQuery).To(Equal("page=3&q=space_guid%3Asome-space-guid&results-per-page=1"))
+						page3Requested <- true
+						w.Header().Set("X-Cf-Warnings", "warning-3")
+						fmt.Fprint(w, `{
+							"total_pages": 3,
+							"next_url": null,
+							"resources": [{"metadata": {"guid": "app-guid-3"}, "entity": {"name": "app-name-3"}}]
+						}`)
+					}
+				})
+			})
+
+			It("requests the remaining pages in parallel and preserves their order", func() {
+				apps, warnings, err := client.GetApplications(Filter{
+					Type:     constant.SpaceGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-space-guid"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(apps).To(Equal([]Application{
+					{Name: "app-name-1", GUID: "app-guid-1"},
+					{Name: "app-name-2", GUID: "app-guid-2"},
+					{Name: "app-name-3", GUID: "app-guid-3"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+			})
+		})
 	})
 
 	Describe("GetRouteApplications", func() {
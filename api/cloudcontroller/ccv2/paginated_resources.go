@@ -13,6 +13,7 @@ import (
 type PaginatedResources struct {
 	NextURL        string          `json:"next_url"`
 	ResourcesBytes json.RawMessage `json:"resources"`
+	TotalPages     int             `json:"total_pages"`
 	resourceType   reflect.Type
 }
 
@@ -38,34 +39,66 @@ func (pr PaginatedResources) Resources() ([]interface{}, error) {
 	return contents, err
 }
 
+// page is the result of requesting a single page of a paginated resource.
+type page struct {
+	wrapper  *PaginatedResources
+	warnings Warnings
+	err      error
+}
+
+// paginate requests every page of the provided request and passes each
+// resource to appendToExternalList.
+//
+// When the client is configured with a PaginationConcurrency greater than 1,
+// the remaining pages are requested in parallel once the first page reports
+// the total number of pages. Resources and warnings are still returned in
+// page order.
 func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
 
-	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
+	appendPage := func(wrapper *PaginatedResources) error {
+		list, err := wrapper.Resources()
+		if err != nil {
+			return err
 		}
 
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
+		for _, item := range list {
+			err = appendToExternalList(item)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		wrapper, warnings, err := client.getPage(request, obj)
+		fullWarningsList = append(fullWarningsList, warnings...)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		list, err := wrapper.Resources()
+		err = appendPage(wrapper)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
+		if wrapper.NextURL == "" {
+			break
 		}
 
-		if wrapper.NextURL == "" {
+		if pageURLs, ok := client.remainingPageURLs(wrapper); ok {
+			for _, remainingPage := range client.getPagesConcurrently(pageURLs, obj) {
+				fullWarningsList = append(fullWarningsList, remainingPage.warnings...)
+				if remainingPage.err != nil {
+					return fullWarningsList, remainingPage.err
+				}
+
+				err = appendPage(remainingPage.wrapper)
+				if err != nil {
+					return fullWarningsList, err
+				}
+			}
 			break
 		}
 
@@ -80,3 +113,46 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 
 	return fullWarningsList, nil
 }
+
+func (client Client) getPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, Warnings, error) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	return wrapper, response.Warnings, err
+}
+
+// getPagesConcurrently requests the pages at the provided URLs using at most
+// PaginationConcurrency workers, abandoning the remaining pages once one of
+// them fails. The returned pages are in the same order as the URLs.
+func (client Client) getPagesConcurrently(pageURLs []string, obj interface{}) []page {
+	pages := make([]page, len(pageURLs))
+
+	cloudcontroller.GetPagesConcurrently(len(pageURLs), client.paginationConcurrency, func(index int) error {
+		request, err := client.newHTTPRequest(requestOptions{
+			URI:    pageURLs[index],
+			Method: http.MethodGet,
+		})
+		if err == nil {
+			pages[index].wrapper, pages[index].warnings, err = client.getPage(request, obj)
+		}
+		pages[index].err = err
+		return err
+	})
+
+	return pages
+}
+
+// remainingPageURLs returns the URLs of every page after the current one. It
+// returns false when the pages should be requested sequentially instead,
+// either because concurrency is disabled or because the page numbers cannot
+// be determined from the next page link.
+func (client Client) remainingPageURLs(wrapper *PaginatedResources) ([]string, bool) {
+	if client.paginationConcurrency < 2 {
+		return nil, false
+	}
+
+	return cloudcontroller.RemainingPageURLs(wrapper.NextURL, wrapper.TotalPages)
+}
@@ -0,0 +1,75 @@
+package ccv2
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// MakeRawRequest sends a request with the provided method, headers and body
+// to the given path and returns the unprocessed response body along with the
+// HTTP response. The path can either be relative to the targeted Cloud
+// Controller or a fully qualified URL on the same scheme and host as the
+// targeted Cloud Controller; any other URL returns an UntargetedURLError so
+// that credentials are never sent to another host. When the Cloud Controller
+// responds with a 4xx or 5xx status code, the response body and HTTP response
+// are returned alongside the error.
+func (client *Client) MakeRawRequest(method string, path string, headers http.Header, body io.ReadSeeker) ([]byte, *http.Response, Warnings, error) {
+	options := requestOptions{
+		Method: method,
+		Body:   body,
+	}
+
+	if parsedURL, err := url.Parse(path); err == nil && parsedURL.IsAbs() {
+		if !client.isTargetedURL(parsedURL) {
+			return nil, nil, nil, ccerror.UntargetedURLError{URL: path, API: client.cloudControllerURL}
+		}
+		options.URL = path
+	} else {
+		options.URI = path
+	}
+
+	request, err := client.newHTTPRequest(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for key, values := range headers {
+		request.Header[key] = values
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.RawResponse, response.HTTPResponse, response.Warnings, err
+}
+
+// isTargetedURL returns true if the URL has the same scheme and host, including
+// the port, as the targeted Cloud Controller.
+func (client *Client) isTargetedURL(requestURL *url.URL) bool {
+	apiURL, err := url.Parse(client.cloudControllerURL)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(requestURL.Scheme, apiURL.Scheme) &&
+		strings.EqualFold(requestURL.Hostname(), apiURL.Hostname()) &&
+		urlPort(requestURL) == urlPort(apiURL)
+}
+
+func urlPort(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http":
+		return "80"
+	case "https":
+		return "443"
+	}
+	return ""
+}
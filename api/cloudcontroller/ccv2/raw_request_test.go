@@ -0,0 +1,131 @@
+package ccv2_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Raw Request", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("MakeRawRequest", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/some-path", "q=name:some-name"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyHeaderKV("X-Custom-Header", "some-value"),
+						VerifyBody([]byte(`{"some":"body"}`)),
+						RespondWith(http.StatusCreated, `{"some":"response"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the raw response body, HTTP response and warnings", func() {
+				headers := http.Header{
+					"Content-Type":    {"application/x-www-form-urlencoded"},
+					"X-Custom-Header": {"some-value"},
+				}
+				body, response, warnings, err := client.MakeRawRequest(http.MethodPost, "/v2/some-path?q=name:some-name", headers, bytes.NewReader([]byte(`{"some":"body"}`)))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(`{"some":"response"}`))
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the path is a fully qualified URL", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps", "page=2"),
+						RespondWith(http.StatusOK, `{"resources":[]}`),
+					),
+				)
+			})
+
+			It("makes the request to that URL", func() {
+				body, _, _, err := client.MakeRawRequest(http.MethodGet, server.URL()+"/v3/apps?page=2", nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(`{"resources":[]}`))
+			})
+		})
+
+		Context("when the path is a fully qualified URL on another host", func() {
+			var (
+				foreignServer  *Server
+				fakeTokenCache *wrapperfakes.FakeTokenCache
+			)
+
+			BeforeEach(func() {
+				foreignServer = NewTLSServer()
+				foreignServer.AllowUnhandledRequests = true
+
+				fakeTokenCache = new(wrapperfakes.FakeTokenCache)
+				fakeTokenCache.AccessTokenReturns("bearer some-token")
+				client = NewTestClient(Config{
+					Wrappers: []ConnectionWrapper{
+						wrapper.NewUAAAuthentication(new(wrapperfakes.FakeUAAClient), fakeTokenCache),
+					},
+				})
+			})
+
+			AfterEach(func() {
+				foreignServer.Close()
+			})
+
+			It("returns an UntargetedURLError without sending the request or its credentials", func() {
+				foreignURL := foreignServer.URL() + "/v2/apps"
+				accessTokenCalls := fakeTokenCache.AccessTokenCallCount()
+				_, _, _, err := client.MakeRawRequest(http.MethodGet, foreignURL, nil, nil)
+				Expect(err).To(MatchError(ccerror.UntargetedURLError{URL: foreignURL, API: server.URL()}))
+				Expect(foreignServer.ReceivedRequests()).To(BeEmpty())
+				Expect(fakeTokenCache.AccessTokenCallCount()).To(Equal(accessTokenCalls))
+			})
+
+			It("rejects the targeted host with a different scheme", func() {
+				insecureURL := "http://" + strings.TrimPrefix(server.URL(), "https://") + "/v2/apps"
+				_, _, _, err := client.MakeRawRequest(http.MethodGet, insecureURL, nil, nil)
+				Expect(err).To(MatchError(ccerror.UntargetedURLError{URL: insecureURL, API: server.URL()}))
+			})
+		})
+
+		Context("when the Cloud Controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10000,
+					"description": "Unknown request",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/some-path"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the response body, HTTP response, warnings and the error", func() {
+				body, response, warnings, err := client.MakeRawRequest(http.MethodGet, "/v2/some-path", nil, nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Unknown request"}))
+				Expect(string(body)).To(ContainSubstring("CF-NotFound"))
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
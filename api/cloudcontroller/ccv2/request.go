@@ -29,6 +29,10 @@ type requestOptions struct {
 	// URI is the URI of the request.
 	URI string
 
+	// URL is the fully qualified URL of the request. It takes precedence over
+	// URI and RequestName.
+	URL string
+
 	// URIParams are the list URI route parameters
 	URIParams Params
 }
@@ -38,7 +42,13 @@ type requestOptions struct {
 func (client Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontroller.Request, error) {
 	var request *http.Request
 	var err error
-	if passedRequest.URI != "" {
+	if passedRequest.URL != "" {
+		request, err = http.NewRequest(
+			passedRequest.Method,
+			passedRequest.URL,
+			passedRequest.Body,
+		)
+	} else if passedRequest.URI != "" {
 		request, err = http.NewRequest(
 			passedRequest.Method,
 			fmt.Sprintf("%s%s", client.API(), passedRequest.URI),
@@ -18,6 +18,8 @@ type Application struct {
 	LifecycleBuildpacks []string `json:"-"`
 	// LifecycleType is the type of the lifecycle.
 	LifecycleType constant.AppLifecycleType `json:"-"`
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata `json:"metadata,omitempty"`
 	// Name is the name given to the application.
 	Name string `json:"name,omitempty"`
 	// Relationships list the relationships to the application.
@@ -117,16 +119,23 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 
 // GetApplications lists applications with optional queries.
 func (client *Client) GetApplications(query ...Query) ([]Application, Warnings, error) {
+	apps, _, warnings, err := client.GetApplicationsWithIncludedResources(query...)
+	return apps, warnings, err
+}
+
+// GetApplicationsWithIncludedResources lists applications with optional
+// queries, along with the resources side-loaded by an Include query.
+func (client *Client) GetApplicationsWithIncludedResources(query ...Query) ([]Application, IncludedResources, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.GetApplicationsRequest,
 		Query:       query,
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, IncludedResources{}, nil, err
 	}
 
 	var fullAppsList []Application
-	warnings, err := client.paginate(request, Application{}, func(item interface{}) error {
+	includedResources, warnings, err := client.paginateWithIncludes(request, Application{}, func(item interface{}) error {
 		if app, ok := item.(Application); ok {
 			fullAppsList = append(fullAppsList, app)
 		} else {
@@ -138,7 +147,7 @@ func (client *Client) GetApplications(query ...Query) ([]Application, Warnings,
 		return nil
 	})
 
-	return fullAppsList, warnings, err
+	return fullAppsList, includedResources, warnings, err
 }
 
 // UpdateApplication updates an application with the given settings.
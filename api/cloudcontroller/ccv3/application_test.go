@@ -407,6 +407,70 @@ var _ = Describe("Application", func() {
 			})
 		})
 
+		Context("when the client requests pages concurrently", func() {
+			BeforeEach(func() {
+				client = NewTestClient(Config{AppName: "CF CLI API V3 Test", AppVersion: "Unknown", PaginationConcurrency: 2})
+
+				page3Requested := make(chan bool)
+				server.RouteToHandler(http.MethodGet, "/v3/apps", func(w http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					switch req.URL.Query().Get("page") {
+					case "":
+						Expect(req.URL.RawQuery).To(Equal("space_guids=some-space-guid"))
+						w.Header().Set("X-Cf-Warnings", "warning-1")
+						fmt.Fprintf(w, `{
+	"pagination": {
+		"total_pages": 3,
+		"next": {
+			"href": "%s/v3/apps?space_guids=some-space-guid&page=2&per_page=1"
+		}
+	},
+	"resources": [{"name": "app-name-1", "guid": "app-guid-1"}]
+}`, server.URL())
+					case "2":
+						Expect(req.URL.RawQuery).To(Equal("page=2&per_page=1&space_guids=some-space-guid"))
+						Eventually(page3Requested).Should(Receive())
+						w.Header().Set("X-Cf-Warnings", "warning-2")
+						fmt.Fprintf(w, `{
+	"pagination": {
+		"total_pages": 3,
+		"next": {
+			"href": "%s/v3/apps?space_guids=some-space-guid&page=3&per_page=1"
+		}
+	},
+	"resources": [{"name": "app-name-2", "guid": "app-guid-2"}]
+}`, server.URL())
+					case "3":
+						Expect(req.URL.RawQuery).To(Equal("page=3&per_page=1&space_guids=some-space-guid"))
+						page3Requested <- true
+						w.Header().Set("X-Cf-Warnings", "warning-3")
+						fmt.Fprint(w, `{
+	"pagination": {
+		"total_pages": 3,
+		"next": null
+	},
+	"resources": [{"name": "app-name-3", "guid": "app-guid-3"}]
+}`)
+					}
+				})
+
+				filters = []Query{
+					{Key: SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				}
+			})
+
+			It("requests the remaining pages in parallel and preserves their order", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+				Expect(apps).To(Equal([]Application{
+					{Name: "app-name-1", GUID: "app-guid-1"},
+					{Name: "app-name-2", GUID: "app-guid-2"},
+					{Name: "app-name-3", GUID: "app-guid-3"},
+				}))
+			})
+		})
+
 		Context("when the cloud controller returns errors and warnings", func() {
 			BeforeEach(func() {
 				response := `{
@@ -454,6 +518,198 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("GetApplicationsWithIncludedResources", func() {
+		var (
+			apps              []Application
+			includedResources IncludedResources
+			warnings          Warnings
+			executeErr        error
+		)
+
+		JustBeforeEach(func() {
+			apps, includedResources, warnings, executeErr = client.GetApplicationsWithIncludedResources(
+				Query{Key: LabelSelectorFilter, Values: []string{"env=prod"}},
+				Query{Key: Include, Values: []string{SpaceOrganizationInclude}},
+			)
+		})
+
+		Context("when applications exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/apps?include=space.organization&label_selector=env%%3Dprod&page=2&per_page=1"
+		}
+	},
+	"resources": [
+		{
+			"name": "app-name-1",
+			"guid": "app-guid-1",
+			"relationships": {
+				"space": {
+					"data": {
+						"guid": "space-guid-1"
+					}
+				}
+			}
+		}
+	],
+	"included": {
+		"spaces": [
+			{
+				"name": "space-name-1",
+				"guid": "space-guid-1",
+				"relationships": {
+					"organization": {
+						"data": {
+							"guid": "org-guid-1"
+						}
+					}
+				}
+			}
+		],
+		"organizations": [
+			{
+				"name": "org-name-1",
+				"guid": "org-guid-1"
+			}
+		]
+	}
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"name": "app-name-2",
+			"guid": "app-guid-2",
+			"relationships": {
+				"space": {
+					"data": {
+						"guid": "space-guid-2"
+					}
+				}
+			}
+		}
+	],
+	"included": {
+		"spaces": [
+			{
+				"name": "space-name-2",
+				"guid": "space-guid-2",
+				"relationships": {
+					"organization": {
+						"data": {
+							"guid": "org-guid-1"
+						}
+					}
+				}
+			}
+		],
+		"organizations": [
+			{
+				"name": "org-name-1",
+				"guid": "org-guid-1"
+			}
+		]
+	}
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps", "include=space.organization&label_selector=env%3Dprod"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps", "include=space.organization&label_selector=env%3Dprod&page=2&per_page=1"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns the applications with the included resources of every page and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+
+				Expect(apps).To(Equal([]Application{
+					{
+						Name: "app-name-1",
+						GUID: "app-guid-1",
+						Relationships: Relationships{
+							constant.RelationshipTypeSpace: Relationship{GUID: "space-guid-1"},
+						},
+					},
+					{
+						Name: "app-name-2",
+						GUID: "app-guid-2",
+						Relationships: Relationships{
+							constant.RelationshipTypeSpace: Relationship{GUID: "space-guid-2"},
+						},
+					},
+				}))
+				Expect(includedResources).To(Equal(IncludedResources{
+					Spaces: []Space{
+						{
+							Name: "space-name-1",
+							GUID: "space-guid-1",
+							Relationships: Relationships{
+								constant.RelationshipTypeOrganization: Relationship{GUID: "org-guid-1"},
+							},
+						},
+						{
+							Name: "space-name-2",
+							GUID: "space-guid-2",
+							Relationships: Relationships{
+								constant.RelationshipTypeOrganization: Relationship{GUID: "org-guid-1"},
+							},
+						},
+					},
+					Organizations: []Organization{
+						{Name: "org-name-1", GUID: "org-guid-1"},
+						{Name: "org-name-1", GUID: "org-guid-1"},
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "The request is semantically invalid: command presence",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(includedResources).To(Equal(IncludedResources{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("UpdateApplication", func() {
 		var (
 			appToUpdate Application
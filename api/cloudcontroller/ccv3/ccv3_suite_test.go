@@ -106,6 +106,9 @@ func SetupV3Response() {
 			"builds": {
 				"href": "SERVER_URL/v3/builds"
 			},
+			"deployments": {
+				"href": "SERVER_URL/v3/deployments"
+			},
 			"organizations": {
 				"href": "SERVER_URL/v3/organizations"
 			},
@@ -97,6 +97,8 @@ type Client struct {
 
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
+
+	paginationConcurrency int
 }
 
 // Config allows the Client to be configured
@@ -113,6 +115,11 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PaginationConcurrency is the maximum number of pages of a list request
+	// that are requested in parallel. Pages are requested sequentially when it
+	// is less than 2.
+	PaginationConcurrency int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -124,6 +131,8 @@ func NewClient(config Config) *Client {
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+
+		paginationConcurrency: config.PaginationConcurrency,
+		wrappers:              append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
@@ -0,0 +1,19 @@
+package constant
+
+// DeploymentState is the state of the deployment.
+type DeploymentState string
+
+const (
+	// DeploymentDeploying is a deployment that is replacing the old instances
+	// of an application.
+	DeploymentDeploying DeploymentState = "DEPLOYING"
+	// DeploymentDeployed is a deployment that has replaced all the old
+	// instances of an application.
+	DeploymentDeployed DeploymentState = "DEPLOYED"
+	// DeploymentCanceling is a deployment that is rolling back to the old
+	// instances of an application.
+	DeploymentCanceling DeploymentState = "CANCELING"
+	// DeploymentCanceled is a deployment that has been rolled back to the old
+	// instances of an application.
+	DeploymentCanceled DeploymentState = "CANCELED"
+)
@@ -9,6 +9,10 @@ const (
 	// application.
 	RelationshipTypeApplication RelationshipType = "app"
 
+	// RelationshipTypeOrganization is a relationship with a Cloud Controller
+	// organization.
+	RelationshipTypeOrganization RelationshipType = "organization"
+
 	// RelationshipTypeSpace is a relationship with a CloudController space.
 	RelationshipTypeSpace RelationshipType = "space"
 )
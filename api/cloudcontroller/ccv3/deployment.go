@@ -0,0 +1,134 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Deployment represents a Cloud Controller V3 Deployment, which gradually
+// replaces the running instances of an application with instances running a
+// new droplet.
+type Deployment struct {
+	// GUID is the unique deployment identifier.
+	GUID string
+	// DropletGUID is the unique identifier of the droplet being deployed.
+	DropletGUID string
+	// Relationships list the relationships to the deployment.
+	Relationships Relationships
+	// State is the state of the deployment.
+	State constant.DeploymentState
+}
+
+// MarshalJSON converts a Deployment into a Cloud Controller Deployment.
+func (d Deployment) MarshalJSON() ([]byte, error) {
+	type Droplet struct {
+		GUID string `json:"guid,omitempty"`
+	}
+
+	var ccDeployment struct {
+		Droplet       *Droplet      `json:"droplet,omitempty"`
+		Relationships Relationships `json:"relationships,omitempty"`
+	}
+
+	if d.DropletGUID != "" {
+		ccDeployment.Droplet = &Droplet{GUID: d.DropletGUID}
+	}
+	ccDeployment.Relationships = d.Relationships
+
+	return json.Marshal(ccDeployment)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	var ccDeployment struct {
+		GUID          string                   `json:"guid"`
+		Relationships Relationships            `json:"relationships"`
+		State         constant.DeploymentState `json:"state"`
+		Droplet       struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccDeployment)
+	if err != nil {
+		return err
+	}
+
+	d.GUID = ccDeployment.GUID
+	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.Relationships = ccDeployment.Relationships
+	d.State = ccDeployment.State
+
+	return nil
+}
+
+// CancelDeployment rolls back the deployment with the given GUID to the
+// previous droplet of the application.
+func (client *Client) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentActionCancelRequest,
+		URIParams:   internal.Params{"deployment_guid": deploymentGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+// CreateApplicationDeployment starts a rolling deployment of the given
+// droplet to the application with the given GUID. If the droplet GUID is
+// empty, the current droplet of the application is deployed.
+func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string) (Deployment, Warnings, error) {
+	bodyBytes, err := json.Marshal(Deployment{
+		DropletGUID: dropletGUID,
+		Relationships: Relationships{
+			constant.RelationshipTypeApplication: Relationship{GUID: appGUID},
+		},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDeploymentRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
+
+// GetDeployment returns the deployment with the given GUID.
+func (client *Client) GetDeployment(deploymentGUID string) (Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentRequest,
+		URIParams:   internal.Params{"deployment_guid": deploymentGUID},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
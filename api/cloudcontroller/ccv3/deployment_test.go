@@ -0,0 +1,203 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deployment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateApplicationDeployment", func() {
+		Context("when the deployment is successfully created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYING",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"droplet": map[string]interface{}{
+						"guid": "some-droplet-guid",
+					},
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created deployment and warnings", func() {
+				deployment, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:        "some-deployment-guid",
+					DropletGUID: "some-droplet-guid",
+					State:       constant.DeploymentDeploying,
+					Relationships: Relationships{
+						constant.RelationshipTypeApplication: Relationship{GUID: "some-app-guid"},
+					},
+				}))
+			})
+		})
+
+		Context("when no droplet is provided", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, `{"guid": "some-deployment-guid"}`),
+					),
+				)
+			})
+
+			It("omits the droplet from the request", func() {
+				deployment, _, err := client.CreateApplicationDeployment("some-app-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deployment.GUID).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when cc returns back an error or warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDeployment", func() {
+		Context("when the deployment exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYED",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and warnings", func() {
+				deployment, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:        "some-deployment-guid",
+					DropletGUID: "some-droplet-guid",
+					State:       constant.DeploymentDeployed,
+				}))
+			})
+		})
+
+		Context("when the deployment does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Deployment not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				_, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Deployment not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CancelDeployment", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/cancel"),
+					RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("cancels the deployment and returns warnings", func() {
+			warnings, err := client.CancelDeployment("some-deployment-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+})
@@ -18,6 +18,8 @@ type Droplet struct {
 	GUID string `json:"guid"`
 	// Image is the Docker image name.
 	Image string `json:"image"`
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata `json:"metadata,omitempty"`
 	// Stack is the root filesystem to use with the buildpack.
 	Stack string `json:"stack,omitempty"`
 	// State is the current state of the droplet.
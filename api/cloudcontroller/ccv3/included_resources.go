@@ -0,0 +1,17 @@
+package ccv3
+
+// IncludedResources represents the related resources that the Cloud
+// Controller side-loads into a response when requested with the Include
+// QueryKey.
+type IncludedResources struct {
+	// Organizations are the included organizations.
+	Organizations []Organization `json:"organizations,omitempty"`
+	// Spaces are the included spaces.
+	Spaces []Space `json:"spaces,omitempty"`
+}
+
+func (included IncludedResources) merge(other IncludedResources) IncludedResources {
+	included.Organizations = append(included.Organizations, other.Organizations...)
+	included.Spaces = append(included.Spaces, other.Spaces...)
+	return included
+}
@@ -0,0 +1,72 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata represents the user defined labels and annotations of a Cloud
+// Controller V3 resource. A label or annotation with an unset value is
+// removed from the resource when the metadata is updated.
+type Metadata struct {
+	// Labels are key/value pairs that can be used to select resources.
+	Labels map[string]types.NullString `json:"labels,omitempty"`
+	// Annotations are key/value pairs that hold additional information about
+	// the resource.
+	Annotations map[string]types.NullString `json:"annotations,omitempty"`
+}
+
+// ResourceMetadata represents the metadata section of a Cloud Controller V3
+// resource.
+type ResourceMetadata struct {
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// UpdateResourceMetadata updates the metadata of the resource of the given
+// type and GUID. The supported resource types are "app", "org" and "space".
+func (client *Client) UpdateResourceMetadata(resource string, guid string, metadata Metadata) (ResourceMetadata, Warnings, error) {
+	var (
+		requestName string
+		uriParams   internal.Params
+	)
+	switch resource {
+	case "app":
+		requestName = internal.PatchApplicationRequest
+		uriParams = internal.Params{"app_guid": guid}
+	case "org":
+		requestName = internal.PatchOrganizationRequest
+		uriParams = internal.Params{"organization_guid": guid}
+	case "space":
+		requestName = internal.PatchSpaceRequest
+		uriParams = internal.Params{"space_guid": guid}
+	default:
+		return ResourceMetadata{}, nil, fmt.Errorf("unknown resource type (%s) requested", resource)
+	}
+
+	bodyBytes, err := json.Marshal(ResourceMetadata{Metadata: &metadata})
+	if err != nil {
+		return ResourceMetadata{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return ResourceMetadata{}, nil, err
+	}
+
+	var responseMetadata ResourceMetadata
+	response := cloudcontroller.Response{
+		Result: &responseMetadata,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseMetadata, response.Warnings, err
+}
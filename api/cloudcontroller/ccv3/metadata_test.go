@@ -0,0 +1,128 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Metadata", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("UpdateResourceMetadata", func() {
+		var (
+			metadataToUpdate Metadata
+
+			updatedMetadata ResourceMetadata
+			warnings        Warnings
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			metadataToUpdate = Metadata{
+				Labels: map[string]types.NullString{
+					"env":  types.NewNullString("prod"),
+					"tier": types.NewNullString(),
+				},
+			}
+		})
+
+		DescribeTable("when the metadata is successfully updated",
+			func(resource string, path string) {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, path),
+						VerifyJSON(`{"metadata": {"labels": {"env": "prod", "tier": null}}}`),
+						RespondWith(http.StatusOK, `{"metadata": {"labels": {"env": "prod"}, "annotations": {}}}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				updatedMetadata, warnings, executeErr = client.UpdateResourceMetadata(resource, "some-guid", metadataToUpdate)
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(updatedMetadata).To(Equal(ResourceMetadata{
+					Metadata: &Metadata{
+						Labels:      map[string]types.NullString{"env": types.NewNullString("prod")},
+						Annotations: map[string]types.NullString{},
+					},
+				}))
+			},
+
+			Entry("for an app", "app", "/v3/apps/some-guid"),
+			Entry("for an org", "org", "/v3/organizations/some-guid"),
+			Entry("for a space", "space", "/v3/spaces/some-guid"),
+		)
+
+		Context("when only annotations are provided", func() {
+			BeforeEach(func() {
+				metadataToUpdate = Metadata{
+					Annotations: map[string]types.NullString{
+						"contact": types.NewNullString("team@example.com"),
+						"owner":   types.NewNullString(),
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-guid"),
+						VerifyJSON(`{"metadata": {"annotations": {"contact": "team@example.com", "owner": null}}}`),
+						RespondWith(http.StatusOK, `{"metadata": {"labels": {}, "annotations": {"contact": "team@example.com"}}}`),
+					),
+				)
+			})
+
+			It("sends only the annotations", func() {
+				updatedMetadata, _, executeErr = client.UpdateResourceMetadata("app", "some-guid", metadataToUpdate)
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(updatedMetadata.Metadata.Annotations).To(Equal(map[string]types.NullString{
+					"contact": types.NewNullString("team@example.com"),
+				}))
+			})
+		})
+
+		Context("when the resource type is unknown", func() {
+			It("returns an error", func() {
+				_, _, executeErr = client.UpdateResourceMetadata("some-resource", "some-guid", metadataToUpdate)
+				Expect(executeErr).To(MatchError("unknown resource type (some-resource) requested"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Metadata label key error: 'tier' contains invalid characters",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, executeErr = client.UpdateResourceMetadata("app", "some-guid", metadataToUpdate)
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Metadata label key error: 'tier' contains invalid characters",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
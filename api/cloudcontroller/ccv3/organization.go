@@ -9,6 +9,8 @@ import (
 type Organization struct {
 	// GUID is the unique organization identifier.
 	GUID string `json:"guid"`
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata `json:"metadata,omitempty"`
 	// Name is the name of the organization.
 	Name string `json:"name"`
 }
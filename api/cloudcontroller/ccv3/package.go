@@ -34,6 +34,9 @@ type Package struct {
 	// Links are links to related resources.
 	Links APILinks
 
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata
+
 	// Relationships are a list of relationships to other resources.
 	Relationships Relationships
 
@@ -55,6 +58,7 @@ func (p Package) MarshalJSON() ([]byte, error) {
 		GUID          string                `json:"guid,omitempty"`
 		CreatedAt     string                `json:"created_at,omitempty"`
 		Links         APILinks              `json:"links,omitempty"`
+		Metadata      *Metadata             `json:"metadata,omitempty"`
 		Relationships Relationships         `json:"relationships,omitempty"`
 		State         constant.PackageState `json:"state,omitempty"`
 		Type          constant.PackageType  `json:"type,omitempty"`
@@ -64,6 +68,7 @@ func (p Package) MarshalJSON() ([]byte, error) {
 	ccPackage.GUID = p.GUID
 	ccPackage.CreatedAt = p.CreatedAt
 	ccPackage.Links = p.Links
+	ccPackage.Metadata = p.Metadata
 	ccPackage.Relationships = p.Relationships
 	ccPackage.State = p.State
 	ccPackage.Type = p.Type
@@ -84,6 +89,7 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 		GUID          string                `json:"guid,omitempty"`
 		CreatedAt     string                `json:"created_at,omitempty"`
 		Links         APILinks              `json:"links,omitempty"`
+		Metadata      *Metadata             `json:"metadata,omitempty"`
 		Relationships Relationships         `json:"relationships,omitempty"`
 		State         constant.PackageState `json:"state,omitempty"`
 		Type          constant.PackageType  `json:"type,omitempty"`
@@ -101,6 +107,7 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 	p.GUID = ccPackage.GUID
 	p.CreatedAt = ccPackage.CreatedAt
 	p.Links = ccPackage.Links
+	p.Metadata = ccPackage.Metadata
 	p.Relationships = ccPackage.Relationships
 	p.State = ccPackage.State
 	p.Type = ccPackage.Type
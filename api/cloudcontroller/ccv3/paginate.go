@@ -6,45 +6,130 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
-func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
-	fullWarningsList := Warnings{}
+// page is the result of requesting a single page of a paginated resource.
+type page struct {
+	wrapper  *PaginatedResources
+	warnings Warnings
+	err      error
+}
 
-	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
-		}
+// paginate requests every page of a paginated resource and passes each
+// resource to appendToExternalList.
+func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	_, warnings, err := client.paginateWithIncludes(request, obj, appendToExternalList)
+	return warnings, err
+}
 
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
-		if err != nil {
-			return fullWarningsList, err
-		}
+// paginateWithIncludes behaves like paginate and additionally returns the
+// included resources of all pages.
+//
+// When the client is configured with a PaginationConcurrency greater than 1,
+// the remaining pages are requested in parallel once the first page reports
+// the total number of pages. Resources and warnings are still returned in
+// page order.
+func (client Client) paginateWithIncludes(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (IncludedResources, Warnings, error) {
+	fullWarningsList := Warnings{}
+	var fullIncludedResources IncludedResources
 
+	appendPage := func(wrapper *PaginatedResources) error {
 		list, err := wrapper.Resources()
 		if err != nil {
-			return fullWarningsList, err
+			return err
 		}
 
 		for _, item := range list {
 			err = appendToExternalList(item)
 			if err != nil {
-				return fullWarningsList, err
+				return err
 			}
 		}
 
+		fullIncludedResources = fullIncludedResources.merge(wrapper.IncludedResources)
+		return nil
+	}
+
+	for {
+		wrapper, warnings, err := client.getPage(request, obj)
+		fullWarningsList = append(fullWarningsList, warnings...)
+		if err != nil {
+			return IncludedResources{}, fullWarningsList, err
+		}
+
+		err = appendPage(wrapper)
+		if err != nil {
+			return IncludedResources{}, fullWarningsList, err
+		}
+
 		if wrapper.NextPage() == "" {
 			break
 		}
 
+		if pageURLs, ok := client.remainingPageURLs(wrapper); ok {
+			for _, remainingPage := range client.getPagesConcurrently(pageURLs, obj) {
+				fullWarningsList = append(fullWarningsList, remainingPage.warnings...)
+				if remainingPage.err != nil {
+					return IncludedResources{}, fullWarningsList, remainingPage.err
+				}
+
+				err = appendPage(remainingPage.wrapper)
+				if err != nil {
+					return IncludedResources{}, fullWarningsList, err
+				}
+			}
+			break
+		}
+
 		request, err = client.newHTTPRequest(requestOptions{
 			URL:    wrapper.NextPage(),
 			Method: http.MethodGet,
 		})
 		if err != nil {
-			return fullWarningsList, err
+			return IncludedResources{}, fullWarningsList, err
 		}
 	}
 
-	return fullWarningsList, nil
+	return fullIncludedResources, fullWarningsList, nil
+}
+
+func (client Client) getPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, Warnings, error) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	return wrapper, response.Warnings, err
+}
+
+// getPagesConcurrently requests the pages at the provided URLs using at most
+// PaginationConcurrency workers, abandoning the remaining pages once one of
+// them fails. The returned pages are in the same order as the URLs.
+func (client Client) getPagesConcurrently(pageURLs []string, obj interface{}) []page {
+	pages := make([]page, len(pageURLs))
+
+	cloudcontroller.GetPagesConcurrently(len(pageURLs), client.paginationConcurrency, func(index int) error {
+		request, err := client.newHTTPRequest(requestOptions{
+			URL:    pageURLs[index],
+			Method: http.MethodGet,
+		})
+		if err == nil {
+			pages[index].wrapper, pages[index].warnings, err = client.getPage(request, obj)
+		}
+		pages[index].err = err
+		return err
+	})
+
+	return pages
+}
+
+// remainingPageURLs returns the URLs of every page after the current one. It
+// returns false when the pages should be requested sequentially instead,
+// either because concurrency is disabled or because the page numbers cannot
+// be determined from the next page link.
+func (client Client) remainingPageURLs(wrapper *PaginatedResources) ([]string, bool) {
+	if client.paginationConcurrency < 2 {
+		return nil, false
+	}
+
+	return cloudcontroller.RemainingPageURLs(wrapper.NextPage(), wrapper.Pagination.TotalPages)
 }
@@ -18,6 +18,8 @@ func NewPaginatedResources(exampleResource interface{}) *PaginatedResources {
 type PaginatedResources struct {
 	// Pagination represents information about the paginated resource.
 	Pagination struct {
+		// TotalPages is the total number of pages of the resource.
+		TotalPages int `json:"total_pages"`
 		// Next represents a link to the next page.
 		Next struct {
 			// HREF is the HREF of the next page.
@@ -26,7 +28,10 @@ type PaginatedResources struct {
 	} `json:"pagination"`
 	// ResourceBytes is the list of resources for the current page.
 	ResourcesBytes json.RawMessage `json:"resources"`
-	resourceType   reflect.Type
+	// IncludedResources are the related resources side-loaded into the current
+	// page.
+	IncludedResources IncludedResources `json:"included"`
+	resourceType      reflect.Type
 }
 
 // NextPage returns the HREF of the next page of results.
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"path"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -12,28 +13,49 @@ import (
 )
 
 type Process struct {
-	GUID                string           `json:"guid"`
-	Type                string           `json:"type"`
-	HealthCheckType     string           `json:"-"`
-	HealthCheckEndpoint string           `json:"-"`
-	Instances           types.NullInt    `json:"instances"`
-	MemoryInMB          types.NullUint64 `json:"memory_in_mb"`
-	DiskInMB            types.NullUint64 `json:"disk_in_mb"`
+	AppGUID string `json:"-"`
+	GUID    string `json:"guid"`
+	Type    string `json:"type"`
+	// Command is the command used to start the process. When it is not set,
+	// the process uses the start command detected during staging.
+	Command             types.FilteredString `json:"command"`
+	HealthCheckType     string               `json:"-"`
+	HealthCheckEndpoint string               `json:"-"`
+	// HealthCheckTimeout is the number of seconds the process has to become
+	// healthy after starting. 0 means the Cloud Controller default.
+	HealthCheckTimeout int              `json:"-"`
+	Instances          types.NullInt    `json:"instances"`
+	MemoryInMB         types.NullUint64 `json:"memory_in_mb"`
+	DiskInMB           types.NullUint64 `json:"disk_in_mb"`
 }
 
+// MarshalJSON converts the updatable fields of a Process into a Cloud
+// Controller process request body. The health check is only included when a
+// health check type is provided.
 func (p Process) MarshalJSON() ([]byte, error) {
+	type healthCheckData struct {
+		Endpoint interface{} `json:"endpoint"`
+		Timeout  int         `json:"timeout,omitempty"`
+	}
+	type healthCheck struct {
+		Type string          `json:"type"`
+		Data healthCheckData `json:"data"`
+	}
 	var ccProcess struct {
-		HealthCheck struct {
-			Type string `json:"type"`
-			Data struct {
-				Endpoint interface{} `json:"endpoint"`
-			} `json:"data"`
-		} `json:"health_check"`
+		Command     *types.FilteredString `json:"command,omitempty"`
+		HealthCheck *healthCheck          `json:"health_check,omitempty"`
 	}
 
-	ccProcess.HealthCheck.Type = p.HealthCheckType
-	if p.HealthCheckEndpoint != "" {
-		ccProcess.HealthCheck.Data.Endpoint = p.HealthCheckEndpoint
+	if p.Command.IsSet {
+		ccProcess.Command = &p.Command
+	}
+
+	if p.HealthCheckType != "" {
+		ccProcess.HealthCheck = &healthCheck{Type: p.HealthCheckType}
+		if p.HealthCheckEndpoint != "" {
+			ccProcess.HealthCheck.Data.Endpoint = p.HealthCheckEndpoint
+		}
+		ccProcess.HealthCheck.Data.Timeout = p.HealthCheckTimeout
 	}
 	return json.Marshal(ccProcess)
 }
@@ -47,8 +69,14 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 			Type string `json:"type"`
 			Data struct {
 				Endpoint string `json:"endpoint"`
+				Timeout  int    `json:"timeout"`
 			} `json:"data"`
 		} `json:"health_check"`
+		Links struct {
+			App struct {
+				HREF string `json:"href"`
+			} `json:"app"`
+		} `json:"links"`
 	}
 
 	ccProcess.rawProcess = (*rawProcess)(p)
@@ -57,8 +85,13 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	if ccProcess.Links.App.HREF != "" {
+		p.AppGUID = path.Base(ccProcess.Links.App.HREF)
+	}
+
 	p.HealthCheckEndpoint = ccProcess.HealthCheck.Data.Endpoint
 	p.HealthCheckType = ccProcess.HealthCheck.Type
+	p.HealthCheckTimeout = ccProcess.HealthCheck.Data.Timeout
 	return nil
 }
 
@@ -149,6 +182,32 @@ func (client *Client) GetApplicationProcesses(appGUID string) ([]Process, Warnin
 	return fullProcessesList, warnings, err
 }
 
+// GetProcesses lists processes with optional filters.
+func (client *Client) GetProcesses(query ...Query) ([]Process, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetProcessesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullProcessesList []Process
+	warnings, err := client.paginate(request, Process{}, func(item interface{}) error {
+		if process, ok := item.(Process); ok {
+			fullProcessesList = append(fullProcessesList, process)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Process{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullProcessesList, warnings, err
+}
+
 // PatchApplicationProcessHealthCheck updates application health check type
 func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (Process, Warnings, error) {
 	body, err := json.Marshal(Process{
@@ -175,3 +234,28 @@ func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, pro
 	err = client.connection.Make(request, &response)
 	return responceProcess, response.Warnings, err
 }
+
+// UpdateProcess updates the command and health check of the process with the
+// provided GUID. Only the fields that are set on the process are changed.
+func (client *Client) UpdateProcess(process Process) (Process, Warnings, error) {
+	body, err := json.Marshal(process)
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchProcessRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   internal.Params{"process_guid": process.GUID},
+	})
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	var responseProcess Process
+	response := cloudcontroller.Response{
+		Result: &responseProcess,
+	}
+	err = client.connection.Make(request, &response)
+	return responseProcess, response.Warnings, err
+}
@@ -74,6 +74,43 @@ var _ = Describe("Process", func() {
 					Expect(string(processBytes)).To(MatchJSON(`{"health_check":{"type":"process", "data": {"endpoint": null}}}`))
 				})
 			})
+
+			Context("when a health check timeout is provided", func() {
+				BeforeEach(func() {
+					process = Process{
+						HealthCheckType:    "port",
+						HealthCheckTimeout: 80,
+					}
+				})
+
+				It("sets the timeout in the health check data", func() {
+					Expect(string(processBytes)).To(MatchJSON(`{"health_check":{"type":"port", "data": {"endpoint": null, "timeout": 80}}}`))
+				})
+			})
+
+			Context("when only a command is provided", func() {
+				BeforeEach(func() {
+					process = Process{
+						Command: types.FilteredString{IsSet: true, Value: "some-command"},
+					}
+				})
+
+				It("only sets the command", func() {
+					Expect(string(processBytes)).To(MatchJSON(`{"command":"some-command"}`))
+				})
+			})
+
+			Context("when the command is reset to the default", func() {
+				BeforeEach(func() {
+					process = Process{
+						Command: types.FilteredString{IsSet: true},
+					}
+				})
+
+				It("sets the command to an empty string", func() {
+					Expect(string(processBytes)).To(MatchJSON(`{"command":""}`))
+				})
+			})
 		})
 
 		Describe("UnmarshalJSON", func() {
@@ -126,6 +163,20 @@ var _ = Describe("Process", func() {
 					}))
 				})
 			})
+
+			Context("when a command and health check timeout are provided", func() {
+				BeforeEach(func() {
+					processBytes = []byte(`{"command": "some-command", "health_check":{"type":"port", "data": {"endpoint": null, "timeout": 80}}}`)
+				})
+
+				It("sets the command and the timeout", func() {
+					Expect(process).To(Equal(Process{
+						Command:            types.FilteredString{IsSet: true, Value: "some-command"},
+						HealthCheckType:    "port",
+						HealthCheckTimeout: 80,
+					}))
+				})
+			})
 		})
 	})
 
@@ -317,6 +368,7 @@ var _ = Describe("Process", func() {
 					MemoryInMB:          types.NullUint64{Value: 32, IsSet: true},
 					HealthCheckType:     "http",
 					HealthCheckEndpoint: "/health",
+					HealthCheckTimeout:  90,
 				}))
 			})
 		})
@@ -481,12 +533,14 @@ var _ = Describe("Process", func() {
 						MemoryInMB:          types.NullUint64{Value: 64, IsSet: true},
 						HealthCheckType:     "http",
 						HealthCheckEndpoint: "/health",
+						HealthCheckTimeout:  60,
 					},
 					Process{
-						GUID:            "process-3-guid",
-						Type:            "console",
-						MemoryInMB:      types.NullUint64{Value: 128, IsSet: true},
-						HealthCheckType: "process",
+						GUID:               "process-3-guid",
+						Type:               "console",
+						MemoryInMB:         types.NullUint64{Value: 128, IsSet: true},
+						HealthCheckType:    "process",
+						HealthCheckTimeout: 90,
 					},
 				))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
@@ -519,6 +573,108 @@ var _ = Describe("Process", func() {
 		})
 	})
 
+	Describe("GetProcesses", func() {
+		Context("when processes exist", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`
+					{
+						"pagination": {
+							"next": null
+						},
+						"resources": [
+							{
+								"guid": "process-1-guid",
+								"type": "web",
+								"memory_in_mb": 32,
+								"links": {
+									"app": {
+										"href": "%s/v3/apps/app-guid-1"
+									}
+								}
+							},
+							{
+								"guid": "process-2-guid",
+								"type": "worker",
+								"memory_in_mb": 64,
+								"links": {
+									"app": {
+										"href": "%s/v3/apps/app-guid-2"
+									}
+								}
+							}
+						]
+					}`, server.URL(), server.URL())
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/processes", "app_guids=app-guid-1,app-guid-2"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the processes with their application GUIDs and all warnings", func() {
+				processes, warnings, err := client.GetProcesses(Query{
+					Key:    AppGUIDFilter,
+					Values: []string{"app-guid-1", "app-guid-2"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(processes).To(ConsistOf(
+					Process{
+						AppGUID:    "app-guid-1",
+						GUID:       "process-1-guid",
+						Type:       constant.ProcessTypeWeb,
+						MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+					},
+					Process{
+						AppGUID:    "app-guid-2",
+						GUID:       "process-2-guid",
+						Type:       "worker",
+						MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The query parameter is invalid",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/processes"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetProcesses()
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The query parameter is invalid",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("PatchApplicationProcessHealthCheck", func() {
 		var (
 			endpoint string
@@ -682,4 +838,99 @@ var _ = Describe("Process", func() {
 			})
 		})
 	})
+
+	Describe("UpdateProcess", func() {
+		var (
+			inputProcess Process
+
+			process  Process
+			warnings []string
+			err      error
+		)
+
+		BeforeEach(func() {
+			inputProcess = Process{
+				GUID:               "some-process-guid",
+				Command:            types.FilteredString{IsSet: true, Value: "bundle exec rake work"},
+				HealthCheckType:    "process",
+				HealthCheckTimeout: 120,
+			}
+		})
+
+		JustBeforeEach(func() {
+			process, warnings, err = client.UpdateProcess(inputProcess)
+		})
+
+		Context("when updating the process succeeds", func() {
+			BeforeEach(func() {
+				expectedBody := `{
+					"command": "bundle exec rake work",
+					"health_check": {
+						"type": "process",
+						"data": {
+							"endpoint": null,
+							"timeout": 120
+						}
+					}
+				}`
+				responseBody := `{
+					"guid": "some-process-guid",
+					"type": "worker",
+					"command": "bundle exec rake work",
+					"health_check": {
+						"type": "process",
+						"data": {
+							"endpoint": null,
+							"timeout": 120
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, responseBody, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the updated process and warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(process).To(Equal(Process{
+					GUID:               "some-process-guid",
+					Type:               "worker",
+					Command:            types.FilteredString{IsSet: true, Value: "bundle exec rake work"},
+					HealthCheckType:    "process",
+					HealthCheckTimeout: 120,
+				}))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "Process not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ProcessNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(ccerror.ProcessNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -1,8 +1,10 @@
 package ccv3
 
 import (
+	"fmt"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // QueryKey is the type of query that is being selected on.
@@ -11,8 +13,14 @@ type QueryKey string
 const (
 	// AppGUIDFilter is a query parameter for listing objects by app GUID.
 	AppGUIDFilter QueryKey = "app_guids"
+	// CreatedAtsFilter is a query parameter for listing objects by creation
+	// timestamp. It is usually used in conjunction with a QueryOperator.
+	CreatedAtsFilter QueryKey = "created_ats"
 	// GUIDFilter is a query parameter for listing objects by GUID.
 	GUIDFilter QueryKey = "guids"
+	// LabelSelectorFilter is a query parameter for listing objects by label
+	// selector requirements, such as "env=prod" or "tier notin (backend)".
+	LabelSelectorFilter QueryKey = "label_selector"
 	// NameFilter is a query parameter for listing objects by name.
 	NameFilter QueryKey = "names"
 	// OrganizationGUIDFilter is a query parameter for listing objects by Organization GUID.
@@ -21,7 +29,19 @@ const (
 	SequenceIDFilter QueryKey = "sequence_ids"
 	// SpaceGUIDFilter is a query parameter for listing objects by Space GUID.
 	SpaceGUIDFilter QueryKey = "space_guids"
+	// StacksFilter is a query parameter for listing objects by stack name.
+	StacksFilter QueryKey = "stacks"
+	// StatesFilter is a query parameter for listing objects by state.
+	StatesFilter QueryKey = "states"
+	// TypesFilter is a query parameter for listing objects by type.
+	TypesFilter QueryKey = "types"
+	// UpdatedAtsFilter is a query parameter for listing objects by last updated
+	// timestamp. It is usually used in conjunction with a QueryOperator.
+	UpdatedAtsFilter QueryKey = "updated_ats"
 
+	// Include is a query parameter for side-loading related resources into the
+	// 'included' section of the response.
+	Include QueryKey = "include"
 	// OrderBy is a query parameter to specify how to order objects.
 	OrderBy QueryKey = "order_by"
 	// PerPage is a query parameter for specifying the number of results per page.
@@ -30,13 +50,52 @@ const (
 	// NameOrder is a query value for ordering by name. This value is used in
 	// conjunction with the OrderBy QueryKey.
 	NameOrder = "name"
+
+	// OrganizationInclude is a query value for including the organization of a
+	// resource. This value is used in conjunction with the Include QueryKey.
+	OrganizationInclude = "organization"
+	// SpaceInclude is a query value for including the space of a resource. This
+	// value is used in conjunction with the Include QueryKey.
+	SpaceInclude = "space"
+	// SpaceOrganizationInclude is a query value for including the space of a
+	// resource and the organization of that space. This value is used in
+	// conjunction with the Include QueryKey.
+	SpaceOrganizationInclude = "space.organization"
+)
+
+// QueryOperator is the comparison used by a query when the Cloud Controller
+// supports more than an equality check, e.g. for timestamps.
+type QueryOperator string
+
+const (
+	// GreaterThanOperator matches objects whose value is after the query value.
+	GreaterThanOperator QueryOperator = "gt"
+	// GreaterThanOrEqualOperator matches objects whose value is at or after the
+	// query value.
+	GreaterThanOrEqualOperator QueryOperator = "gte"
+	// LessThanOperator matches objects whose value is before the query value.
+	LessThanOperator QueryOperator = "lt"
+	// LessThanOrEqualOperator matches objects whose value is at or before the
+	// query value.
+	LessThanOrEqualOperator QueryOperator = "lte"
 )
 
 // Query is additional settings that can be passed to some requests that can
 // filter, sort, etc. the results.
 type Query struct {
-	Key    QueryKey
-	Values []string
+	Key      QueryKey
+	Operator QueryOperator
+	Values   []string
+}
+
+// NewTimestampQuery returns a Query that compares the timestamp field
+// selected by key against the provided time using the provided operator.
+func NewTimestampQuery(key QueryKey, operator QueryOperator, timestamp time.Time) Query {
+	return Query{
+		Key:      key,
+		Operator: operator,
+		Values:   []string{timestamp.UTC().Format(time.RFC3339)},
+	}
 }
 
 // FormatQueryParameters converts a Query object into a collection that
@@ -44,7 +103,11 @@ type Query struct {
 func FormatQueryParameters(queries []Query) url.Values {
 	params := url.Values{}
 	for _, query := range queries {
-		params.Add(string(query.Key), strings.Join(query.Values, ","))
+		key := string(query.Key)
+		if query.Operator != "" {
+			key = fmt.Sprintf("%s[%s]", key, query.Operator)
+		}
+		params.Add(key, strings.Join(query.Values, ","))
 	}
 
 	return params
@@ -0,0 +1,54 @@
+package ccv3_test
+
+import (
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query", func() {
+	Describe("FormatQueryParameters", func() {
+		It("joins the values of each query with commas", func() {
+			params := FormatQueryParameters([]Query{
+				{Key: NameFilter, Values: []string{"app-1", "app-2"}},
+				{Key: StatesFilter, Values: []string{"STARTED"}},
+				{Key: LabelSelectorFilter, Values: []string{"env=prod", "tier notin (backend,worker)"}},
+				{Key: Include, Values: []string{SpaceOrganizationInclude}},
+			})
+
+			Expect(params).To(Equal(url.Values{
+				"names":          {"app-1,app-2"},
+				"states":         {"STARTED"},
+				"label_selector": {"env=prod,tier notin (backend,worker)"},
+				"include":        {"space.organization"},
+			}))
+		})
+
+		It("appends the operator to the key", func() {
+			params := FormatQueryParameters([]Query{
+				{Key: CreatedAtsFilter, Operator: GreaterThanOperator, Values: []string{"2018-01-01T00:00:00Z"}},
+				{Key: CreatedAtsFilter, Operator: LessThanOrEqualOperator, Values: []string{"2018-02-01T00:00:00Z"}},
+			})
+
+			Expect(params).To(Equal(url.Values{
+				"created_ats[gt]":  {"2018-01-01T00:00:00Z"},
+				"created_ats[lte]": {"2018-02-01T00:00:00Z"},
+			}))
+		})
+	})
+
+	Describe("NewTimestampQuery", func() {
+		It("formats the timestamp in UTC", func() {
+			timestamp := time.Date(2018, time.March, 4, 5, 6, 7, 0, time.FixedZone("some-zone", 3600))
+
+			Expect(NewTimestampQuery(UpdatedAtsFilter, GreaterThanOrEqualOperator, timestamp)).To(Equal(Query{
+				Key:      UpdatedAtsFilter,
+				Operator: GreaterThanOrEqualOperator,
+				Values:   []string{"2018-03-04T04:06:07Z"},
+			}))
+		})
+	})
+})
@@ -9,6 +9,10 @@ import (
 type Space struct {
 	Name string `json:"name"`
 	GUID string `json:"guid"`
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	// Relationships list the relationships to the space.
+	Relationships Relationships `json:"relationships,omitempty"`
 }
 
 // GetSpaces lists spaces with optional filters.
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -80,6 +81,52 @@ var _ = Describe("Spaces", func() {
 			})
 		})
 
+		Context("when the spaces have relationships", func() {
+			BeforeEach(func() {
+				response := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"name": "space-name-1",
+			"guid": "space-guid-1",
+			"relationships": {
+				"organization": {
+					"data": {
+						"guid": "org-guid-1"
+					}
+				}
+			}
+		}
+	]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/spaces", "names=space-name-1"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the spaces with their relationships", func() {
+				spaces, warnings, err := client.GetSpaces(
+					Query{Key: NameFilter, Values: []string{"space-name-1"}},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(spaces).To(ConsistOf(Space{
+					Name: "space-name-1",
+					GUID: "space-guid-1",
+					Relationships: Relationships{
+						constant.RelationshipTypeOrganization: Relationship{GUID: "org-guid-1"},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
 		Context("when the cloud controller returns errors and warnings", func() {
 			BeforeEach(func() {
 				response := `{
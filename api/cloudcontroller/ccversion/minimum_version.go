@@ -16,6 +16,8 @@ const (
 	MinVersionRunTaskV3          = "3.0.0"
 	MinVersionIsolationSegmentV3 = "3.11.0"
 	MinVersionShareServiceV3     = "3.36.0"
+	MinVersionMetadataV3         = "3.63.0"
+	MinVersionZeroDowntimePushV3 = "3.57.0"
 
 	MinVersionManifestBuildpacksV3 = "3.25.0"
 )
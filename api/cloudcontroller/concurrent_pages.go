@@ -0,0 +1,76 @@
+package cloudcontroller
+
+import (
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// RemainingPageURLs returns the URLs of every page from the one nextURL points
+// to through totalPages, built by replacing the 'page' query parameter of
+// nextURL. It returns false when the remaining pages should be requested
+// sequentially instead because the page numbers cannot be determined from
+// nextURL or only one page remains.
+func RemainingPageURLs(nextURL string, totalPages int) ([]string, bool) {
+	parsedURL, err := url.Parse(nextURL)
+	if err != nil {
+		return nil, false
+	}
+
+	query := parsedURL.Query()
+	nextPage, err := strconv.Atoi(query.Get("page"))
+	if err != nil || nextPage >= totalPages {
+		return nil, false
+	}
+
+	var urls []string
+	for pageNumber := nextPage; pageNumber <= totalPages; pageNumber++ {
+		query.Set("page", strconv.Itoa(pageNumber))
+		parsedURL.RawQuery = query.Encode()
+		urls = append(urls, parsedURL.String())
+	}
+
+	return urls, true
+}
+
+// GetPagesConcurrently calls getPage for every index from 0 to count-1 using
+// at most maxConcurrency goroutines. Indexes are handed out in order and, once
+// a call returns an error, the remaining indexes are abandoned; calls that are
+// already in flight are waited for before returning. Every index before the
+// first failed one is therefore always requested.
+func GetPagesConcurrently(count int, maxConcurrency int, getPage func(index int) error) {
+	workers := maxConcurrency
+	if workers > count {
+		workers = count
+	}
+
+	var (
+		wg       sync.WaitGroup
+		failOnce sync.Once
+	)
+	failed := make(chan struct{})
+	indexes := make(chan int)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if err := getPage(index); err != nil {
+					failOnce.Do(func() { close(failed) })
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for index := 0; index < count; index++ {
+		select {
+		case indexes <- index:
+		case <-failed:
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+}
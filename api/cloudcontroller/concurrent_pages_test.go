@@ -0,0 +1,107 @@
+package cloudcontroller_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Concurrent Pages", func() {
+	Describe("RemainingPageURLs", func() {
+		It("returns the URL of every remaining page", func() {
+			urls, ok := RemainingPageURLs("/v2/apps?order-direction=asc&page=2&results-per-page=50", 4)
+			Expect(ok).To(BeTrue())
+			Expect(urls).To(Equal([]string{
+				"/v2/apps?order-direction=asc&page=2&results-per-page=50",
+				"/v2/apps?order-direction=asc&page=3&results-per-page=50",
+				"/v2/apps?order-direction=asc&page=4&results-per-page=50",
+			}))
+		})
+
+		It("keeps fully qualified URLs fully qualified", func() {
+			urls, ok := RemainingPageURLs("https://api.example.com/v3/apps?page=2&per_page=5", 3)
+			Expect(ok).To(BeTrue())
+			Expect(urls).To(Equal([]string{
+				"https://api.example.com/v3/apps?page=2&per_page=5",
+				"https://api.example.com/v3/apps?page=3&per_page=5",
+			}))
+		})
+
+		DescribeTable("returns false when the pages cannot be requested concurrently",
+			func(nextURL string, totalPages int) {
+				_, ok := RemainingPageURLs(nextURL, totalPages)
+				Expect(ok).To(BeFalse())
+			},
+
+			Entry("only one page remains", "/v2/apps?page=3", 3),
+			Entry("the page number is missing", "/v2/apps?token=abc", 3),
+			Entry("the URL cannot be parsed", "%zz", 3),
+		)
+	})
+
+	Describe("GetPagesConcurrently", func() {
+		It("requests every page with at most the given number of workers", func() {
+			var (
+				inFlight    int32
+				maxInFlight int32
+				requested   = make([]bool, 10)
+				lock        sync.Mutex
+			)
+
+			GetPagesConcurrently(10, 3, func(index int) error {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+
+				lock.Lock()
+				if current > maxInFlight {
+					maxInFlight = current
+				}
+				requested[index] = true
+				lock.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+				return nil
+			})
+
+			Expect(requested).ToNot(ContainElement(false))
+			Expect(maxInFlight).To(BeNumerically("<=", 3))
+		})
+
+		Context("when a page fails", func() {
+			It("requests every page before it and abandons most of the remaining pages", func() {
+				var (
+					requested = make([]bool, 100)
+					lock      sync.Mutex
+				)
+
+				GetPagesConcurrently(100, 2, func(index int) error {
+					lock.Lock()
+					requested[index] = true
+					lock.Unlock()
+
+					if index == 4 {
+						return errors.New("some-error")
+					}
+					time.Sleep(time.Millisecond)
+					return nil
+				})
+
+				Expect(requested[:5]).ToNot(ContainElement(false))
+
+				var requestedCount int
+				for _, wasRequested := range requested {
+					if wasRequested {
+						requestedCount++
+					}
+				}
+				Expect(requestedCount).To(BeNumerically("<", 10))
+			})
+		})
+	})
+})
@@ -0,0 +1,119 @@
+package wrapper
+
+import (
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/requestlog"
+)
+
+//go:generate counterfeiter . JSONRequestLoggerOutput
+
+// JSONRequestLoggerOutput is the interface for writing request log entries
+type JSONRequestLoggerOutput interface {
+	HandleInternalError(err error)
+	WriteEntry(entry requestlog.Entry) error
+}
+
+// JSONRequestLogger is the wrapper that logs each request to the Cloud
+// Controller, together with its response, as a single entry
+type JSONRequestLogger struct {
+	connection cloudcontroller.Connection
+	output     JSONRequestLoggerOutput
+}
+
+// NewJSONRequestLogger returns a pointer to a JSONRequestLogger wrapper
+func NewJSONRequestLogger(output JSONRequestLoggerOutput) *JSONRequestLogger {
+	return &JSONRequestLogger{
+		output: output,
+	}
+}
+
+// Make records the request and the response as a single entry. The entry ID
+// is sent as the X-Vcap-Request-Id header, unless one is already set, so that
+// the entry can be correlated with the Cloud Controller logs.
+func (logger *JSONRequestLogger) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	entry := requestlog.Entry{
+		ID:           request.Header.Get("X-Vcap-Request-Id"),
+		Source:       requestlog.SourceCloudController,
+		StartTime:    time.Now(),
+		RetryAttempt: retryAttempt(request.Request),
+	}
+	if entry.ID == "" {
+		entry.ID = requestlog.NewID()
+		request.Header.Set("X-Vcap-Request-Id", entry.ID)
+	}
+
+	var err error
+	entry.Request, err = logger.newRequest(request)
+	if err != nil {
+		logger.output.HandleInternalError(err)
+	}
+
+	err = logger.connection.Make(request, passedResponse)
+	entry.DurationMS = requestlog.Duration(entry.StartTime, time.Now())
+
+	if passedResponse.HTTPResponse != nil {
+		entry.Response = &requestlog.Response{
+			StatusCode: passedResponse.HTTPResponse.StatusCode,
+			Headers:    requestlog.NewHeaders(passedResponse.HTTPResponse.Header, redactHeaders),
+			Size:       len(passedResponse.RawResponse),
+		}
+		if len(passedResponse.RawResponse) > 0 {
+			entry.Response.Body = &requestlog.Body{
+				Raw:         passedResponse.RawResponse,
+				ContentType: passedResponse.HTTPResponse.Header.Get("Content-Type"),
+			}
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	writeErr := logger.output.WriteEntry(entry)
+	if writeErr != nil {
+		logger.output.HandleInternalError(writeErr)
+	}
+
+	return err
+}
+
+// Wrap sets the connection on the JSONRequestLogger and returns itself
+func (logger *JSONRequestLogger) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	logger.connection = innerconnection
+	return logger
+}
+
+func (logger *JSONRequestLogger) newRequest(request *cloudcontroller.Request) (requestlog.Request, error) {
+	loggedRequest := requestlog.Request{
+		Method:  request.Method,
+		URL:     request.URL.String(),
+		Headers: requestlog.NewHeaders(request.Header, redactHeaders),
+	}
+
+	if request.Body == nil {
+		return loggedRequest, nil
+	}
+
+	contentType := request.Header.Get("Content-Type")
+	loggedRequest.Body = &requestlog.Body{ContentType: contentType}
+
+	// Only JSON and form bodies are read; other bodies, such as application
+	// bits, are hidden without being read into memory.
+	if !strings.Contains(contentType, "json") && !strings.Contains(contentType, "x-www-form-urlencoded") {
+		loggedRequest.Size = int(request.ContentLength)
+		return loggedRequest, nil
+	}
+
+	rawRequestBody, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return loggedRequest, err
+	}
+	defer request.ResetBody()
+
+	loggedRequest.Size = len(rawRequestBody)
+	loggedRequest.Body.Raw = rawRequestBody
+	return loggedRequest, nil
+}
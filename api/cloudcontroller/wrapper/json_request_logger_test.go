@@ -0,0 +1,154 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/util/requestlog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSON Request Logger", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeJSONRequestLoggerOutput
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeJSONRequestLoggerOutput)
+
+		wrapper = NewJSONRequestLogger(fakeOutput).Wrap(fakeConnection)
+
+		body := bytes.NewReader([]byte(`{"name":"some-app"}`))
+		req, err := http.NewRequest(http.MethodPost, "https://api.example.com/v3/apps?names=some-app", body)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "bearer some-token")
+		request = cloudcontroller.NewRequest(req, body)
+
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.RawResponse = []byte(`{"guid":"some-app-guid"}`)
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusCreated,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+			}
+			return nil
+		}
+		response = &cloudcontroller.Response{}
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Describe("Make", func() {
+		It("writes the request and response as a single entry", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+
+			Expect(fakeOutput.WriteEntryCallCount()).To(Equal(1))
+			entry := fakeOutput.WriteEntryArgsForCall(0)
+			Expect(entry.ID).To(MatchRegexp("^[0-9a-f]{32}$"))
+			Expect(entry.Source).To(Equal(requestlog.SourceCloudController))
+			Expect(entry.StartTime).To(BeTemporally("~", time.Now(), time.Second))
+			Expect(entry.DurationMS).To(BeNumerically(">=", 0))
+			Expect(entry.Error).To(BeEmpty())
+
+			Expect(entry.Request.Method).To(Equal(http.MethodPost))
+			Expect(entry.Request.URL).To(Equal("https://api.example.com/v3/apps?names=some-app"))
+			Expect(entry.Request.Headers["Authorization"]).To(Equal([]string{"[PRIVATE DATA HIDDEN]"}))
+			Expect(entry.Request.Headers["X-Vcap-Request-Id"]).To(Equal([]string{entry.ID}))
+			Expect(entry.Request.Size).To(Equal(len(`{"name":"some-app"}`)))
+			Expect(entry.Request.Body).To(Equal(&requestlog.Body{
+				Raw:         []byte(`{"name":"some-app"}`),
+				ContentType: "application/json",
+			}))
+
+			Expect(entry.Response).To(Equal(&requestlog.Response{
+				StatusCode: http.StatusCreated,
+				Headers:    map[string][]string{"Content-Type": {"application/json"}},
+				Size:       len(`{"guid":"some-app-guid"}`),
+				Body: &requestlog.Body{
+					Raw:         []byte(`{"guid":"some-app-guid"}`),
+					ContentType: "application/json",
+				},
+			}))
+		})
+
+		It("sends the entry ID as the request ID and resets the body", func() {
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			passedRequest, _ := fakeConnection.MakeArgsForCall(0)
+			entry := fakeOutput.WriteEntryArgsForCall(0)
+			Expect(passedRequest.Header.Get("X-Vcap-Request-Id")).To(Equal(entry.ID))
+
+			buff := new(bytes.Buffer)
+			_, err := buff.ReadFrom(passedRequest.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buff.String()).To(Equal(`{"name":"some-app"}`))
+		})
+
+		Context("when the request already has a request ID", func() {
+			BeforeEach(func() {
+				request.Header.Set("X-Vcap-Request-Id", "some-request-id")
+			})
+
+			It("uses it as the entry ID", func() {
+				Expect(fakeOutput.WriteEntryArgsForCall(0).ID).To(Equal("some-request-id"))
+			})
+		})
+
+		Context("when the body is not JSON", func() {
+			BeforeEach(func() {
+				request.Header.Set("Content-Type", "multipart/form-data")
+				request.ContentLength = 1234
+			})
+
+			It("does not read the body", func() {
+				entry := fakeOutput.WriteEntryArgsForCall(0)
+				Expect(entry.Request.Size).To(Equal(1234))
+				Expect(entry.Request.Body).To(Equal(&requestlog.Body{ContentType: "multipart/form-data"}))
+			})
+		})
+
+		Context("when the connection errors without a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeReturns(errors.New("some-error"))
+				fakeConnection.MakeStub = nil
+			})
+
+			It("writes the error and returns it", func() {
+				Expect(makeErr).To(MatchError("some-error"))
+
+				entry := fakeOutput.WriteEntryArgsForCall(0)
+				Expect(entry.Error).To(Equal("some-error"))
+				Expect(entry.Response).To(BeNil())
+			})
+		})
+
+		Context("when writing the entry errors", func() {
+			BeforeEach(func() {
+				fakeOutput.WriteEntryReturns(errors.New("some-write-error"))
+			})
+
+			It("handles the error and returns the connection's result", func() {
+				Expect(makeErr).ToNot(HaveOccurred())
+				Expect(fakeOutput.HandleInternalErrorCallCount()).To(Equal(1))
+				Expect(fakeOutput.HandleInternalErrorArgsForCall(0)).To(MatchError("some-write-error"))
+			})
+		})
+	})
+})
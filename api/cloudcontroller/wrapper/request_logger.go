@@ -77,6 +77,12 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	if err != nil {
 		return err
 	}
+	if attempt := retryAttempt(request.Request); attempt > 0 {
+		err = logger.output.DisplayMessage(fmt.Sprintf("[Retry attempt %d]", attempt))
+		if err != nil {
+			return err
+		}
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
@@ -0,0 +1,370 @@
+package wrapper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+//go:generate counterfeiter . ResponseCacheUser
+
+// ResponseCacheUser returns the name of the user whose responses are cached.
+type ResponseCacheUser interface {
+	CurrentUserName() (string, error)
+}
+
+// ResponseCache is a wrapper that caches the GET responses of read-heavy list
+// endpoints on disk per target and user. Cached responses are used without a
+// request while the Cache-Control max-age allows it, and are revalidated with
+// If-None-Match and If-Modified-Since afterwards. Any other request removes
+// the cached responses of the resource collections it changes, including the
+// collections nested in them.
+type ResponseCache struct {
+	dir        string
+	user       ResponseCacheUser
+	bypass     bool
+	connection cloudcontroller.Connection
+}
+
+// NewResponseCache returns a pointer to a ResponseCache wrapper that stores
+// responses in dir. When bypass is true cached responses are neither used nor
+// stored, but changes still remove them.
+func NewResponseCache(dir string, user ResponseCacheUser, bypass bool) *ResponseCache {
+	return &ResponseCache{
+		dir:    dir,
+		user:   user,
+		bypass: bypass,
+	}
+}
+
+type cachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	Warnings   []string    `json:"warnings"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+}
+
+// Make returns the cached response to GET requests when it is still fresh or
+// the Cloud Controller reports it as not modified. Other requests invalidate
+// the cached responses of their resource collection.
+func (cache *ResponseCache) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	scopeDir, ok := cache.scopeDir(request)
+	if !ok {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	if request.Method != http.MethodGet {
+		defer cache.invalidate(scopeDir, request.URL.Path)
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	collection, ok := cachedListCollection(request.URL.Path)
+	if !ok || cache.bypass || request.Header.Get("Range") != "" {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	path := filepath.Join(scopeDir, collection, sha256Hex(request.URL.String())+".json")
+	cached, found := cache.read(path)
+	if found && time.Now().Before(cached.ExpiresAt) {
+		return cache.populateResponse(request, cached, passedResponse)
+	}
+
+	if found {
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			request.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			request.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	// The inner connection cannot decode the empty body of a 304, so the result
+	// is decoded here instead.
+	result := passedResponse.Result
+	passedResponse.Result = nil
+	defer func() {
+		passedResponse.Result = result
+	}()
+
+	err := cache.connection.Make(request, passedResponse)
+	if err != nil {
+		return err
+	}
+
+	httpResponse := passedResponse.HTTPResponse
+	switch {
+	case found && httpResponse != nil && httpResponse.StatusCode == http.StatusNotModified:
+		for name, values := range httpResponse.Header {
+			cached.Header[name] = values
+		}
+		cached.ExpiresAt = expiresAt(cached.Header)
+		cache.write(path, cached)
+		passedResponse.Result = result
+		return cache.populateResponse(request, cached, passedResponse)
+	case httpResponse != nil && httpResponse.StatusCode == http.StatusOK && cacheable(httpResponse.Header):
+		cache.write(path, cachedResponse{
+			StatusCode: httpResponse.StatusCode,
+			Header:     httpResponse.Header,
+			Body:       passedResponse.RawResponse,
+			Warnings:   passedResponse.Warnings,
+			ExpiresAt:  expiresAt(httpResponse.Header),
+		})
+	}
+
+	if result != nil {
+		return cloudcontroller.DecodeJSON(passedResponse.RawResponse, result)
+	}
+	return nil
+}
+
+// Wrap sets the connection in the ResponseCache and returns itself.
+func (cache *ResponseCache) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+// populateResponse fills in the passed response from the cached response.
+func (*ResponseCache) populateResponse(request *cloudcontroller.Request, cached cachedResponse, passedResponse *cloudcontroller.Response) error {
+	passedResponse.HTTPResponse = &http.Response{
+		StatusCode: cached.StatusCode,
+		Status:     http.StatusText(cached.StatusCode),
+		Header:     cached.Header,
+		Request:    request.Request,
+	}
+	passedResponse.RawResponse = cached.Body
+	passedResponse.Warnings = cached.Warnings
+
+	if passedResponse.Result != nil {
+		return cloudcontroller.DecodeJSON(cached.Body, passedResponse.Result)
+	}
+	return nil
+}
+
+// scopeDir returns the directory holding the cached responses of the request's
+// target and the current user. Requests are not cached when there is no
+// current user.
+func (cache *ResponseCache) scopeDir(request *cloudcontroller.Request) (string, bool) {
+	userName, err := cache.user.CurrentUserName()
+	if err != nil || userName == "" {
+		return "", false
+	}
+
+	return filepath.Join(cache.dir, sha256Hex(request.URL.Host+" "+userName)), true
+}
+
+// invalidate removes the cached responses of every collection in the path of
+// a changing request, along with the collections that depend on them. Only
+// the directories of cached collections are removed.
+func (*ResponseCache) invalidate(scopeDir string, path string) {
+	for _, collection := range invalidatedCollections(path) {
+		if cachedCollections[collection] {
+			_ = os.RemoveAll(filepath.Join(scopeDir, collection))
+		}
+	}
+}
+
+func (*ResponseCache) read(path string) (cachedResponse, bool) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cachedResponse{}, false
+	}
+
+	var cached cachedResponse
+	if err := json.Unmarshal(raw, &cached); err != nil || cached.Header == nil {
+		return cachedResponse{}, false
+	}
+	return cached, true
+}
+
+// write stores the cached response. The cache is best effort, so failing to
+// store a response does not fail the request.
+func (*ResponseCache) write(path string, cached cachedResponse) {
+	raw, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(path), "response")
+	if err != nil {
+		return
+	}
+
+	_, err = file.Write(raw)
+	closeErr := file.Close()
+	if err != nil || closeErr != nil || os.Rename(file.Name(), path) != nil {
+		_ = os.Remove(file.Name())
+	}
+}
+
+// cacheable returns true when the response can be stored and either
+// revalidated or used while fresh.
+func cacheable(header http.Header) bool {
+	directives := cacheControl(header)
+	if _, ok := directives["no-store"]; ok {
+		return false
+	}
+
+	return header.Get("ETag") != "" ||
+		header.Get("Last-Modified") != "" ||
+		time.Now().Before(expiresAt(header))
+}
+
+// expiresAt returns when the response has to be revalidated based on the
+// Cache-Control max-age and no-cache directives.
+func expiresAt(header http.Header) time.Time {
+	directives := cacheControl(header)
+	if _, ok := directives["no-cache"]; ok {
+		return time.Time{}
+	}
+
+	maxAge, err := strconv.Atoi(directives["max-age"])
+	if err != nil || maxAge <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(maxAge) * time.Second)
+}
+
+// cacheControl returns the directives of the Cache-Control header.
+func cacheControl(header http.Header) map[string]string {
+	directives := map[string]string{}
+	for _, value := range header["Cache-Control"] {
+		for _, directive := range strings.Split(value, ",") {
+			parts := strings.SplitN(strings.TrimSpace(directive), "=", 2)
+			name := strings.ToLower(parts[0])
+			if len(parts) == 2 {
+				directives[name] = strings.Trim(parts[1], `"`)
+			} else {
+				directives[name] = ""
+			}
+		}
+	}
+	return directives
+}
+
+// cachedCollections are the read-heavy collections whose lists are cached.
+// Collections that can contain credentials, such as service instances, service
+// bindings and service keys, are deliberately left out.
+var cachedCollections = map[string]bool{
+	"apps":                    true,
+	"buildpacks":              true,
+	"domains":                 true,
+	"isolation_segments":      true,
+	"organizations":           true,
+	"private_domains":         true,
+	"processes":               true,
+	"quota_definitions":       true,
+	"routes":                  true,
+	"service_brokers":         true,
+	"service_plans":           true,
+	"services":                true,
+	"shared_domains":          true,
+	"space_quota_definitions": true,
+	"spaces":                  true,
+	"stacks":                  true,
+}
+
+// credentialCollections are the path segments of endpoints that return
+// credentials. Their responses are never cached.
+var credentialCollections = map[string]bool{
+	"credentials":                     true,
+	"env":                             true,
+	"environment_variables":           true,
+	"service_bindings":                true,
+	"service_credential_bindings":     true,
+	"service_keys":                    true,
+	"user_provided_service_instances": true,
+}
+
+// dependentCollections lists the collections whose resources are changed or
+// removed along with a resource of the collection, e.g. deleting a space
+// deletes its apps.
+var dependentCollections = map[string][]string{
+	"apps":              {"processes", "routes", "service_bindings"},
+	"organizations":     {"domains", "private_domains", "quota_definitions", "spaces"},
+	"service_brokers":   {"service_plans", "services"},
+	"service_instances": {"service_bindings", "service_keys"},
+	"services":          {"service_plans"},
+	"spaces":            {"apps", "routes", "service_instances", "space_quota_definitions"},
+}
+
+// cachedListCollection returns the collection listed by the path when its
+// responses can be cached, e.g. "apps" for both /v3/apps and
+// /v2/spaces/some-guid/apps. Single resources and endpoints returning
+// credentials are not cached.
+func cachedListCollection(path string) (string, bool) {
+	segments := pathSegments(path)
+	if len(segments)%2 == 0 {
+		return "", false
+	}
+
+	for _, segment := range segments {
+		if credentialCollections[segment] {
+			return "", false
+		}
+	}
+
+	collection := segments[len(segments)-1]
+	return collection, cachedCollections[collection]
+}
+
+// invalidatedCollections returns the collections in the path, e.g. "apps" and
+// "service_bindings" for /v2/apps/some-guid/service_bindings, along with the
+// collections depending on them.
+func invalidatedCollections(path string) []string {
+	var collections []string
+	seen := map[string]bool{}
+
+	var add func(string)
+	add = func(collection string) {
+		if seen[collection] {
+			return
+		}
+		seen[collection] = true
+		collections = append(collections, collection)
+		for _, dependent := range dependentCollections[collection] {
+			add(dependent)
+		}
+	}
+
+	segments := pathSegments(path)
+	for i := 0; i < len(segments); i += 2 {
+		add(segments[i])
+	}
+	return collections
+}
+
+// pathSegments returns the segments of the path, ignoring the API version.
+// Segments that cannot be used as a directory name are replaced by "root".
+func pathSegments(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 1 && (segments[0] == "v2" || segments[0] == "v3") {
+		segments = segments[1:]
+	}
+
+	for i, segment := range segments {
+		switch segment {
+		case "", ".", "..":
+			segments[i] = "root"
+		}
+	}
+	return segments
+}
+
+func sha256Hex(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
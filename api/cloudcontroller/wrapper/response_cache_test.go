@@ -0,0 +1,291 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Response Cache", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeUser       *wrapperfakes.FakeResponseCacheUser
+		cacheDir       string
+		bypass         bool
+
+		responseHeader http.Header
+		responseBody   string
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeUser = new(wrapperfakes.FakeResponseCacheUser)
+		fakeUser.CurrentUserNameReturns("some-user", nil)
+		bypass = false
+
+		var err error
+		cacheDir, err = ioutil.TempDir("", "cf-response-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		responseHeader = http.Header{"Etag": {`"some-etag"`}}
+		responseBody = `{"name":"some-app"}`
+		fakeConnection.MakeStub = func(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			if request.Header.Get("If-None-Match") == `"some-etag"` {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotModified, Header: http.Header{}}
+				passedResponse.RawResponse = []byte{}
+				return nil
+			}
+
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: responseHeader}
+			passedResponse.RawResponse = []byte(responseBody)
+			passedResponse.Warnings = []string{"some-warning"}
+			if passedResponse.Result != nil {
+				return cloudcontroller.DecodeJSON(passedResponse.RawResponse, passedResponse.Result)
+			}
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	makeRequest := func(method string, url string) (map[string]string, *cloudcontroller.Response, error) {
+		req, err := http.NewRequest(method, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		result := map[string]string{}
+		response := &cloudcontroller.Response{Result: &result}
+		err = NewResponseCache(cacheDir, fakeUser, bypass).Wrap(fakeConnection).Make(cloudcontroller.NewRequest(req, nil), response)
+		return result, response, err
+	}
+
+	Describe("Make", func() {
+		Context("when a GET response has an ETag", func() {
+			BeforeEach(func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("revalidates the cached response with If-None-Match", func() {
+				result, response, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+
+				Expect(result).To(Equal(map[string]string{"name": "some-app"}))
+				Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+				Expect(string(response.RawResponse)).To(Equal(`{"name":"some-app"}`))
+				Expect(response.Warnings).To(ConsistOf("some-warning"))
+			})
+
+			It("does not share the response with other users", func() {
+				fakeUser.CurrentUserNameReturns("other-user", nil)
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+				Expect(err).ToNot(HaveOccurred())
+
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+			})
+
+			It("does not share the response with other targets", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.other.com/v3/apps?names=some-app")
+				Expect(err).ToNot(HaveOccurred())
+
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+			})
+
+			Context("when a request changes the same resource collection", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodDelete, "https://api.example.com/v2/apps/other-guid")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("removes the cached response", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+					Expect(err).ToNot(HaveOccurred())
+
+					request, _ := fakeConnection.MakeArgsForCall(2)
+					Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+				})
+			})
+
+			Context("when a request changes another resource collection", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodPost, "https://api.example.com/v3/stacks")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("keeps the cached response", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+					Expect(err).ToNot(HaveOccurred())
+
+					request, _ := fakeConnection.MakeArgsForCall(2)
+					Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+				})
+			})
+
+			Context("when a request deletes the space of the apps", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodDelete, "https://api.example.com/v2/spaces/some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("removes the cached response", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+					Expect(err).ToNot(HaveOccurred())
+
+					request, _ := fakeConnection.MakeArgsForCall(2)
+					Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+				})
+			})
+
+			Context("when the cache is bypassed", func() {
+				BeforeEach(func() {
+					bypass = true
+				})
+
+				It("does not revalidate the cached response", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+					Expect(err).ToNot(HaveOccurred())
+
+					request, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when a nested list is cached", func() {
+			BeforeEach(func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid/apps")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("revalidates the cached response", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid/apps")
+				Expect(err).ToNot(HaveOccurred())
+
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+			})
+
+			Context("when a request changes the parent resource", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodDelete, "https://api.example.com/v2/spaces/some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("removes the cached response", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid/apps")
+					Expect(err).ToNot(HaveOccurred())
+
+					request, _ := fakeConnection.MakeArgsForCall(2)
+					Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+				})
+			})
+
+			Context("when a request changes a resource of the nested collection", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodPut, "https://api.example.com/v2/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("removes the cached response", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/spaces/some-space-guid/apps")
+					Expect(err).ToNot(HaveOccurred())
+
+					request, _ := fakeConnection.MakeArgsForCall(2)
+					Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+				})
+			})
+		})
+
+		DescribeTable("when the GET request is not for a cached list",
+			func(url string) {
+				_, _, err := makeRequest(http.MethodGet, url)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.ReadDir(cacheDir)).To(BeEmpty())
+			},
+
+			Entry("a single resource", "https://api.example.com/v3/apps/some-guid"),
+			Entry("a collection that is not cached", "https://api.example.com/v3/tasks"),
+			Entry("environment variables", "https://api.example.com/v3/apps/some-guid/env"),
+			Entry("V2 environment variables", "https://api.example.com/v2/apps/some-guid/env"),
+			Entry("service bindings", "https://api.example.com/v2/service_bindings"),
+			Entry("nested service bindings", "https://api.example.com/v2/apps/some-guid/service_bindings"),
+			Entry("service keys", "https://api.example.com/v2/service_instances/some-guid/service_keys"),
+			Entry("user provided service instances", "https://api.example.com/v2/user_provided_service_instances"),
+		)
+
+		Context("when a GET response has a Cache-Control max-age", func() {
+			BeforeEach(func() {
+				responseHeader = http.Header{"Cache-Control": {"private, max-age=60"}}
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/stacks")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses the cached response without a request while it is fresh", func() {
+				result, response, err := makeRequest(http.MethodGet, "https://api.example.com/v2/stacks")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(result).To(Equal(map[string]string{"name": "some-app"}))
+				Expect(response.Warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when a GET response has Cache-Control no-store", func() {
+			BeforeEach(func() {
+				responseHeader = http.Header{"Etag": {`"some-etag"`}, "Cache-Control": {"no-store"}}
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/stacks")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not cache the response", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v2/stacks")
+				Expect(err).ToNot(HaveOccurred())
+
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+			})
+		})
+
+		Context("when there is no current user", func() {
+			BeforeEach(func() {
+				fakeUser.CurrentUserNameReturns("", nil)
+			})
+
+			It("does not cache the response", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.ReadDir(cacheDir)).To(BeEmpty())
+			})
+		})
+
+		Context("when the connection errors", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturns(errors.New("some-error"))
+			})
+
+			It("returns the error without caching the response", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps?names=some-app")
+				Expect(err).To(MatchError("some-error"))
+				Expect(ioutil.ReadDir(cacheDir)).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -1,37 +1,63 @@
 package wrapper
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/backoff"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// IdempotencyKeyHeader marks a POST request as safe to retry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type retryAttemptKey struct{}
+
+// RetryRequest is a wrapper that retries failed requests if they contain a
+// 429 or 5XX status code, or fail before a response is received. Retries are
+// delayed by an exponential backoff with jitter, or by the response's
+// Retry-After header.
 type RetryRequest struct {
 	maxRetries int
+	backoff    backoff.Backoff
 	connection cloudcontroller.Connection
 }
 
 // NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+func NewRetryRequest(maxRetries int, backoff backoff.Backoff) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		backoff:    backoff,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 429 or 5XX status code or
+// a network error. Only idempotent requests are retried.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 
+	originalRequest := request.Request
+	defer func() {
+		request.Request = originalRequest
+	}()
+
 	for i := 0; i < retry.maxRetries+1; i++ {
+		if i > 0 {
+			time.Sleep(retry.backoff.Delay(i, passedResponse.HTTPResponse))
+			request.Request = originalRequest.WithContext(context.WithValue(originalRequest.Context(), retryAttemptKey{}, i))
+		}
+
+		// Clear the previous attempt's response so that a failure without a
+		// response is not mistaken for a retryable status code.
+		passedResponse.HTTPResponse = nil
 		err = retry.connection.Make(request, passedResponse)
 		if err == nil {
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		if retry.skipRetry(request.Request, passedResponse.HTTPResponse, err) {
 			break
 		}
 
@@ -53,13 +79,30 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 	return retry
 }
 
-// skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+// skipRetry will skip retry if the request is not idempotent, or if it failed
+// with a response that does not have one of the following http status codes:
+// 429, 500, 502, 503, 504. Requests that failed without a response are only
+// retried on network errors.
+func (*RetryRequest) skipRetry(request *http.Request, response *http.Response, err error) bool {
+	if request.Method == http.MethodPost && request.Header.Get(IdempotencyKeyHeader) == "" {
+		return true
+	}
+
+	if response == nil {
+		_, isRequestError := err.(ccerror.RequestError)
+		return !isRequestError
+	}
+
+	return response.StatusCode != http.StatusTooManyRequests &&
+		response.StatusCode != http.StatusInternalServerError &&
+		response.StatusCode != http.StatusBadGateway &&
+		response.StatusCode != http.StatusServiceUnavailable &&
+		response.StatusCode != http.StatusGatewayTimeout
+}
+
+// retryAttempt returns the retry attempt the request is sent for, or 0 when it
+// is sent for the first time.
+func retryAttempt(request *http.Request) int {
+	attempt, _ := request.Context().Value(retryAttemptKey{}).(int)
+	return attempt
 }
@@ -5,11 +5,14 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/util/backoff"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -25,11 +28,7 @@ var _ = Describe("Retry Request", func() {
 			Expect(err).NotTo(HaveOccurred())
 			request := cloudcontroller.NewRequest(req, body)
 
-			response := &cloudcontroller.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: responseStatusCode,
-				},
-			}
+			response := &cloudcontroller.Response{}
 
 			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
 			expectedErr := ccerror.RawHTTPStatusError{
@@ -40,15 +39,19 @@ var _ = Describe("Retry Request", func() {
 				body, readErr := ioutil.ReadAll(request.Body)
 				Expect(readErr).ToNot(HaveOccurred())
 				Expect(string(body)).To(Equal(rawRequestBody))
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: responseStatusCode,
+				}
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(2, backoff.Backoff{}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
 		},
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
@@ -62,6 +65,127 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
+	Describe("retried requests", func() {
+		var (
+			request        *cloudcontroller.Request
+			response       *cloudcontroller.Response
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+			connection     cloudcontroller.Connection
+			retryBackoff   backoff.Backoff
+			makeErr        error
+		)
+
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			response = &cloudcontroller.Response{}
+
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			connection = fakeConnection
+			retryBackoff = backoff.Backoff{}
+		})
+
+		JustBeforeEach(func() {
+			makeErr = NewRetryRequest(2, retryBackoff).Wrap(connection).Make(request, response)
+		})
+
+		Context("when the request fails with a network error", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeReturns(ccerror.RequestError{Err: errors.New("connection reset by peer")})
+			})
+
+			It("retries the request", func() {
+				Expect(makeErr).To(MatchError("connection reset by peer"))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+		})
+
+		Context("when the retried requests are logged", func() {
+			var fakeOutput *wrapperfakes.FakeRequestLoggerOutput
+
+			BeforeEach(func() {
+				fakeConnection.MakeReturns(ccerror.RequestError{Err: errors.New("connection reset by peer")})
+				fakeOutput = new(wrapperfakes.FakeRequestLoggerOutput)
+				connection = NewRequestLogger(fakeOutput).Wrap(fakeConnection)
+			})
+
+			It("displays the retry attempt", func() {
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(2))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Retry attempt 1]"))
+				Expect(fakeOutput.DisplayMessageArgsForCall(1)).To(Equal("[Retry attempt 2]"))
+			})
+		})
+
+		Context("when the request fails without a response for another reason", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeReturns(ccerror.UnverifiedServerError{URL: "https://foo.bar.com"})
+			})
+
+			It("does not retry the request", func() {
+				Expect(makeErr).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a retryable status code is followed by a failure without a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					if fakeConnection.MakeCallCount() > 1 {
+						return ccerror.UnverifiedServerError{URL: "https://foo.bar.com"}
+					}
+					passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusServiceUnavailable}
+					return ccerror.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+				}
+			})
+
+			It("does not retry the failure", func() {
+				Expect(makeErr).To(MatchError(ccerror.UnverifiedServerError{URL: "https://foo.bar.com"}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(response.HTTPResponse).To(BeNil())
+			})
+		})
+
+		Context("when a POST request has an idempotency key", func() {
+			BeforeEach(func() {
+				request.Method = http.MethodPost
+				request.Header.Set(IdempotencyKeyHeader, "some-key")
+				fakeConnection.MakeReturns(ccerror.RequestError{Err: errors.New("connection reset by peer")})
+			})
+
+			It("retries the request", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			var start time.Time
+
+			BeforeEach(func() {
+				start = time.Now()
+				retryBackoff = backoff.Backoff{Max: 100 * time.Millisecond}
+				fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					if fakeConnection.MakeCallCount() > 1 {
+						passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+						return nil
+					}
+					passedResponse.HTTPResponse = &http.Response{
+						StatusCode: http.StatusTooManyRequests,
+						Header:     http.Header{"Retry-After": {"1"}},
+					}
+					return ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+				}
+			})
+
+			It("waits before retrying, up to the maximum backoff", func() {
+				Expect(makeErr).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+				Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+			})
+		})
+	})
+
 	It("does not retry on success", func() {
 		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -73,7 +197,7 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(2, backoff.Backoff{}).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
@@ -95,17 +219,18 @@ var _ = Describe("Retry Request", func() {
 			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", body)
 			Expect(err).NotTo(HaveOccurred())
 			request = cloudcontroller.NewRequest(req, body)
-			response = &cloudcontroller.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: http.StatusInternalServerError,
-				},
-			}
+			response = &cloudcontroller.Response{}
 
 			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
 			expectedErr = errors.New("oh noes")
-			fakeConnection.MakeReturns(expectedErr)
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusInternalServerError,
+				}
+				return expectedErr
+			}
 
-			wrapper = NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper = NewRetryRequest(2, backoff.Backoff{}).Wrap(fakeConnection)
 		})
 
 		It("sets the err on PipeSeekError", func() {
@@ -1,6 +1,8 @@
 package wrapper
 
 import (
+	"sync"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -29,6 +31,10 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenLock serializes token refreshes between requests made concurrently,
+	// e.g. when fetching pages in parallel.
+	tokenLock sync.RWMutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -48,18 +54,18 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 		return t.connection.Make(request, passedResponse)
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	t.tokenLock.RLock()
+	accessToken := t.cache.AccessToken()
+	t.tokenLock.RUnlock()
+	request.Header.Set("Authorization", accessToken)
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, err := t.refreshToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if request.Body != nil {
 			err = request.ResetBody()
 			if err != nil {
@@ -69,13 +75,35 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 				return err
 			}
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		requestErr = t.connection.Make(request, passedResponse)
 	}
 
 	return requestErr
 }
 
+// refreshToken refreshes the cached tokens and returns the new access token.
+// When another request has already replaced the rejected access token while
+// this one was waiting for the lock, that token is returned instead of
+// refreshing again.
+func (t *UAAAuthentication) refreshToken(rejectedAccessToken string) (string, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if accessToken := t.cache.AccessToken(); accessToken != rejectedAccessToken {
+		return accessToken, nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	return tokens.AuthorizationToken(), nil
+}
+
 // SetClient sets the UAA client that the wrapper will use.
 func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -106,6 +108,43 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when several requests are rejected at the same time", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("what")
+			})
+
+			It("refreshes the token once and resends every request with the new token", func() {
+				fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") == "what" {
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+				fakeClient.RefreshAccessTokenStub = func(string) (uaa.RefreshedTokens, error) {
+					time.Sleep(10 * time.Millisecond)
+					return uaa.RefreshedTokens{AccessToken: "foobar-2", RefreshToken: "bananananananana", Type: "bearer"}, nil
+				}
+
+				var wg sync.WaitGroup
+				errs := make(chan error, 10)
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						errs <- wrapper.Make(&cloudcontroller.Request{Request: &http.Request{Header: http.Header{}}}, nil)
+					}()
+				}
+				wg.Wait()
+				close(errs)
+
+				for err := range errs {
+					Expect(err).ToNot(HaveOccurred())
+				}
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(inMemoryCache.AccessToken()).To(Equal("bearer foobar-2"))
+			})
+		})
+
 		Context("when the token is invalid", func() {
 			var (
 				expectedBody string
@@ -12,9 +12,12 @@ type PluginRepository struct {
 }
 
 type PluginBinary struct {
-	Platform string `json:"platform"`
-	URL      string `json:"url"`
-	Checksum string `json:"checksum"`
+	Platform  string `json:"platform"`
+	URL       string `json:"url"`
+	Checksum  string `json:"checksum"`
+	SHA256    string `json:"sha256"`
+	SHA512    string `json:"sha512"`
+	Signature string `json:"signature"`
 }
 
 type Plugin struct {
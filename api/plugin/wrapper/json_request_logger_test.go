@@ -0,0 +1,102 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	. "code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/api/plugin/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/util/requestlog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSON Request Logger", func() {
+	var (
+		fakeConnection  *pluginfakes.FakeConnection
+		fakeOutput      *wrapperfakes.FakeJSONRequestLoggerOutput
+		fakeProxyReader *pluginfakes.FakeProxyReader
+
+		wrapper plugin.Connection
+
+		request  *http.Request
+		response *plugin.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(pluginfakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeJSONRequestLoggerOutput)
+		fakeProxyReader = new(pluginfakes.FakeProxyReader)
+
+		wrapper = NewJSONRequestLogger(fakeOutput).Wrap(fakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://plugins.example.com/list", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *plugin.Response, _ plugin.ProxyReader) error {
+			passedResponse.RawResponse = []byte(`{"plugins":[]}`)
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+			}
+			return nil
+		}
+		response = &plugin.Response{}
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response, fakeProxyReader)
+	})
+
+	Describe("Make", func() {
+		It("writes the request and response as a single entry", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			_, _, proxyReader := fakeConnection.MakeArgsForCall(0)
+			Expect(proxyReader).To(Equal(fakeProxyReader))
+
+			Expect(fakeOutput.WriteEntryCallCount()).To(Equal(1))
+			entry := fakeOutput.WriteEntryArgsForCall(0)
+			Expect(entry.Source).To(Equal(requestlog.SourcePluginRepository))
+			Expect(entry.ID).ToNot(BeEmpty())
+			Expect(entry.Request.Method).To(Equal(http.MethodGet))
+			Expect(entry.Request.URL).To(Equal("https://plugins.example.com/list"))
+			Expect(entry.Request.Body).To(BeNil())
+			Expect(entry.Response).To(Equal(&requestlog.Response{
+				StatusCode: http.StatusOK,
+				Headers:    map[string][]string{"Content-Type": {"application/json"}},
+				Size:       len(`{"plugins":[]}`),
+				Body: &requestlog.Body{
+					Raw:         []byte(`{"plugins":[]}`),
+					ContentType: "application/json",
+				},
+			}))
+		})
+
+		It("does not send a request ID to the plugin repository", func() {
+			passedRequest, _, _ := fakeConnection.MakeArgsForCall(0)
+			Expect(passedRequest.Header.Get("X-Vcap-Request-Id")).To(BeEmpty())
+		})
+
+		Context("when the connection errors without a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturns(errors.New("some-error"))
+			})
+
+			It("writes the error and returns it", func() {
+				Expect(makeErr).To(MatchError("some-error"))
+
+				entry := fakeOutput.WriteEntryArgsForCall(0)
+				Expect(entry.Error).To(Equal("some-error"))
+				Expect(entry.Response).To(BeNil())
+			})
+		})
+	})
+})
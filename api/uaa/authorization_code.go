@@ -0,0 +1,65 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// AuthorizationCodeURL returns the UAA URL that the user logs in through in a
+// browser. Once the user has logged in, UAA redirects the browser to
+// redirectURI with an authorization code and the given state. The code
+// challenge is the base64url encoded SHA256 hash of the code verifier that is
+// later passed to AuthenticateWithAuthorizationCode (PKCE, RFC 7636).
+func (client Client) AuthorizationCodeURL(redirectURI string, codeChallenge string, state string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetOAuthAuthorizeRequest,
+		Query: url.Values{
+			"client_id":             {client.config.UAAOAuthClient()},
+			"code_challenge":        {codeChallenge},
+			"code_challenge_method": {"S256"},
+			"redirect_uri":          {redirectURI},
+			"response_type":         {"code"},
+			"state":                 {state},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
+
+// AuthenticateWithAuthorizationCode exchanges the authorization code that UAA
+// redirected the browser with for an access token and a refresh token.
+func (client Client) AuthenticateWithAuthorizationCode(code string, codeVerifier string, redirectURI string) (string, string, error) {
+	requestBody := url.Values{
+		"code":          {code},
+		"code_verifier": {codeVerifier},
+		"grant_type":    {string(constant.GrantTypeAuthorizationCode)},
+		"redirect_uri":  {redirectURI},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	responseBody := AuthResponse{}
+	response := Response{
+		Result: &responseBody,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBody.AccessToken, responseBody.RefreshToken, err
+}
@@ -4,9 +4,15 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used for exchanging the authorization code
+	// that UAA redirects the browser with.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypeDeviceCode is used for polling for the tokens of a device
+	// authorization that the user approves on another machine.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
@@ -0,0 +1,85 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// DeviceAuthorization represents the UAA response to a device authorization
+// request (RFC 8628).
+type DeviceAuthorization struct {
+	// DeviceCode identifies the authorization when polling for tokens.
+	DeviceCode string `json:"device_code"`
+	// UserCode is the code that the user enters on the verification page.
+	UserCode string `json:"user_code"`
+	// VerificationURI is the page where the user enters the user code.
+	VerificationURI string `json:"verification_uri"`
+	// VerificationURIComplete is the verification page with the user code
+	// already filled in. It is optional.
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	// ExpiresIn is the number of seconds that the device code is valid for.
+	ExpiresIn int `json:"expires_in"`
+	// Interval is the minimum number of seconds between polls for tokens.
+	Interval int `json:"interval"`
+}
+
+// AuthorizeDevice starts a device authorization for the CLI's UAA client.
+func (client Client) AuthorizeDevice() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	var authorization DeviceAuthorization
+	response := Response{
+		Result: &authorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	return authorization, err
+}
+
+// AuthenticateWithDeviceCode polls once for the tokens of a device
+// authorization. An AuthorizationPendingError is returned until the user has
+// approved the authorization.
+func (client Client) AuthenticateWithDeviceCode(deviceCode string) (string, string, error) {
+	requestBody := url.Values{
+		"client_id":   {client.config.UAAOAuthClient()},
+		"device_code": {deviceCode},
+		"grant_type":  {string(constant.GrantTypeDeviceCode)},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	responseBody := AuthResponse{}
+	response := Response{
+		Result: &responseBody,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBody.AccessToken, responseBody.RefreshToken, err
+}
@@ -83,6 +83,22 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				Context("device authorization errors", func() {
+					It("returns the matching error", func() {
+						for errorType, expectedErr := range map[string]error{
+							"authorization_pending": AuthorizationPendingError{Message: "some-description"},
+							"slow_down":             SlowDownError{Message: "some-description"},
+							"expired_token":         ExpiredTokenError{Message: "some-description"},
+							"access_denied":         AccessDeniedError{Message: "some-description"},
+						} {
+							fakeConnectionErr.RawResponse = []byte(`{"error":"` + errorType + `","error_description":"some-description"}`)
+							fakeConnection.MakeReturns(fakeConnectionErr)
+
+							Expect(wrapper.Make(request, response)).To(MatchError(expectedErr))
+						}
+					})
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
@@ -88,3 +88,42 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned when polling for the tokens of a
+// device authorization that the user has not approved yet.
+type AuthorizationPendingError struct {
+	Message string
+}
+
+func (e AuthorizationPendingError) Error() string {
+	return e.Message
+}
+
+// SlowDownError is returned when polling for the tokens of a device
+// authorization more often than UAA allows.
+type SlowDownError struct {
+	Message string
+}
+
+func (e SlowDownError) Error() string {
+	return e.Message
+}
+
+// ExpiredTokenError is returned when polling for the tokens of a device
+// authorization that has expired.
+type ExpiredTokenError struct {
+	Message string
+}
+
+func (e ExpiredTokenError) Error() string {
+	return e.Message
+}
+
+// AccessDeniedError is returned when the user denied the authorization.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e AccessDeniedError) Error() string {
+	return e.Message
+}
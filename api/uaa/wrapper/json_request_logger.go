@@ -0,0 +1,114 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/requestlog"
+)
+
+//go:generate counterfeiter . JSONRequestLoggerOutput
+
+// JSONRequestLoggerOutput is the interface for writing request log entries
+type JSONRequestLoggerOutput interface {
+	HandleInternalError(err error)
+	WriteEntry(entry requestlog.Entry) error
+}
+
+// JSONRequestLogger is the wrapper that logs each request to the UAA server,
+// together with its response, as a single entry
+type JSONRequestLogger struct {
+	connection uaa.Connection
+	output     JSONRequestLoggerOutput
+}
+
+// NewJSONRequestLogger returns a pointer to a JSONRequestLogger wrapper
+func NewJSONRequestLogger(output JSONRequestLoggerOutput) *JSONRequestLogger {
+	return &JSONRequestLogger{
+		output: output,
+	}
+}
+
+// Make records the request and the response as a single entry. The entry ID
+// is sent as the X-Vcap-Request-Id header, unless one is already set, so that
+// the entry can be correlated with the UAA logs.
+func (logger *JSONRequestLogger) Make(request *http.Request, passedResponse *uaa.Response) error {
+	entry := requestlog.Entry{
+		ID:           request.Header.Get("X-Vcap-Request-Id"),
+		Source:       requestlog.SourceUAA,
+		StartTime:    time.Now(),
+		RetryAttempt: retryAttempt(request),
+	}
+	if entry.ID == "" {
+		entry.ID = requestlog.NewID()
+		request.Header.Set("X-Vcap-Request-Id", entry.ID)
+	}
+
+	var err error
+	entry.Request, err = logger.newRequest(request)
+	if err != nil {
+		logger.output.HandleInternalError(err)
+	}
+
+	err = logger.connection.Make(request, passedResponse)
+	entry.DurationMS = requestlog.Duration(entry.StartTime, time.Now())
+
+	if passedResponse.HTTPResponse != nil {
+		entry.Response = &requestlog.Response{
+			StatusCode: passedResponse.HTTPResponse.StatusCode,
+			Headers:    requestlog.NewHeaders(passedResponse.HTTPResponse.Header, redactHeaders),
+			Size:       len(passedResponse.RawResponse),
+		}
+		if len(passedResponse.RawResponse) > 0 {
+			entry.Response.Body = &requestlog.Body{
+				Raw:         passedResponse.RawResponse,
+				ContentType: passedResponse.HTTPResponse.Header.Get("Content-Type"),
+			}
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	writeErr := logger.output.WriteEntry(entry)
+	if writeErr != nil {
+		logger.output.HandleInternalError(writeErr)
+	}
+
+	return err
+}
+
+// Wrap sets the connection on the JSONRequestLogger and returns itself
+func (logger *JSONRequestLogger) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	logger.connection = innerconnection
+	return logger
+}
+
+func (logger *JSONRequestLogger) newRequest(request *http.Request) (requestlog.Request, error) {
+	loggedRequest := requestlog.Request{
+		Method:  request.Method,
+		URL:     request.URL.String(),
+		Headers: requestlog.NewHeaders(request.Header, redactHeaders),
+	}
+
+	if request.Body == nil {
+		return loggedRequest, nil
+	}
+
+	rawRequestBody, err := ioutil.ReadAll(request.Body)
+	defer request.Body.Close()
+	if err != nil {
+		return loggedRequest, err
+	}
+	request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+
+	loggedRequest.Size = len(rawRequestBody)
+	loggedRequest.Body = &requestlog.Body{
+		Raw:         rawRequestBody,
+		ContentType: request.Header.Get("Content-Type"),
+	}
+	return loggedRequest, nil
+}
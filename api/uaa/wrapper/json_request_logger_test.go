@@ -0,0 +1,113 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/util/requestlog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JSON Request Logger", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeJSONRequestLoggerOutput
+
+		wrapper uaa.Connection
+
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeJSONRequestLoggerOutput)
+
+		wrapper = NewJSONRequestLogger(fakeOutput).Wrap(fakeConnection)
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", strings.NewReader("grant_type=password&password=some-password"))
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		request.Header.Set("Authorization", "Basic some-credentials")
+
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			passedResponse.RawResponse = []byte(`{"access_token":"some-token"}`)
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+			}
+			return nil
+		}
+		response = &uaa.Response{}
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	Describe("Make", func() {
+		It("writes the request and response as a single entry", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+
+			Expect(fakeOutput.WriteEntryCallCount()).To(Equal(1))
+			entry := fakeOutput.WriteEntryArgsForCall(0)
+			Expect(entry.Source).To(Equal(requestlog.SourceUAA))
+			Expect(entry.ID).ToNot(BeEmpty())
+
+			Expect(entry.Request.Method).To(Equal(http.MethodPost))
+			Expect(entry.Request.URL).To(Equal("https://uaa.example.com/oauth/token"))
+			Expect(entry.Request.Headers["Authorization"]).To(Equal([]string{"[PRIVATE DATA HIDDEN]"}))
+			Expect(entry.Request.Body.ContentType).To(Equal("application/x-www-form-urlencoded"))
+			Expect(entry.Request.Size).To(Equal(len("grant_type=password&password=some-password")))
+
+			Expect(entry.Response.StatusCode).To(Equal(http.StatusOK))
+			Expect(entry.Response.Size).To(Equal(len(`{"access_token":"some-token"}`)))
+			Expect(entry.Response.Body.ContentType).To(Equal("application/json"))
+		})
+
+		It("sends the entry ID as the request ID and keeps the body readable", func() {
+			passedRequest, _ := fakeConnection.MakeArgsForCall(0)
+			Expect(passedRequest.Header.Get("X-Vcap-Request-Id")).To(Equal(fakeOutput.WriteEntryArgsForCall(0).ID))
+
+			body, err := ioutil.ReadAll(passedRequest.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(Equal([]byte("grant_type=password&password=some-password")))
+		})
+
+		Context("when the connection errors without a response", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturns(errors.New("some-error"))
+			})
+
+			It("writes the error and returns it", func() {
+				Expect(makeErr).To(MatchError("some-error"))
+
+				entry := fakeOutput.WriteEntryArgsForCall(0)
+				Expect(entry.Error).To(Equal("some-error"))
+				Expect(entry.Response).To(BeNil())
+			})
+		})
+
+		Context("when writing the entry errors", func() {
+			BeforeEach(func() {
+				fakeOutput.WriteEntryReturns(errors.New("some-write-error"))
+			})
+
+			It("handles the error", func() {
+				Expect(makeErr).ToNot(HaveOccurred())
+				Expect(fakeOutput.HandleInternalErrorArgsForCall(0)).To(MatchError("some-write-error"))
+			})
+		})
+	})
+})
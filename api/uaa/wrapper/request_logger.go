@@ -2,6 +2,7 @@ package wrapper
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"sort"
@@ -18,6 +19,7 @@ type RequestLoggerOutput interface {
 	DisplayJSONBody(body []byte) error
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
+	DisplayMessage(msg string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
@@ -76,6 +78,12 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	if err != nil {
 		return err
 	}
+	if attempt := retryAttempt(request); attempt > 0 {
+		err = logger.output.DisplayMessage(fmt.Sprintf("[Retry attempt %d]", attempt))
+		if err != nil {
+			return err
+		}
+	}
 	err = logger.output.DisplayRequestHeader(request.Method, request.URL.RequestURI(), request.Proto)
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package wrapper_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -8,6 +9,8 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/util/backoff"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -22,11 +25,7 @@ var _ = Describe("Retry Request", func() {
 			rawRequestBody := "banana pants"
 			request.Body = ioutil.NopCloser(strings.NewReader(rawRequestBody))
 
-			response := &uaa.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: responseStatusCode,
-				},
-			}
+			response := &uaa.Response{}
 
 			fakeConnection := new(uaafakes.FakeConnection)
 			expectedErr := uaa.RawHTTPStatusError{
@@ -37,15 +36,19 @@ var _ = Describe("Retry Request", func() {
 				body, readErr := ioutil.ReadAll(request.Body)
 				Expect(readErr).ToNot(HaveOccurred())
 				Expect(string(body)).To(Equal(rawRequestBody))
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: responseStatusCode,
+				}
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(2, backoff.Backoff{}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
 		},
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
@@ -69,10 +72,84 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(uaafakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(2, backoff.Backoff{}).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	Describe("retried requests", func() {
+		var (
+			request        *http.Request
+			response       *uaa.Response
+			fakeConnection *uaafakes.FakeConnection
+			connection     uaa.Connection
+			makeErr        error
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			response = &uaa.Response{}
+
+			fakeConnection = new(uaafakes.FakeConnection)
+			fakeConnection.MakeReturns(uaa.RequestError{Err: errors.New("connection reset by peer")})
+			connection = fakeConnection
+		})
+
+		JustBeforeEach(func() {
+			makeErr = NewRetryRequest(2, backoff.Backoff{}).Wrap(connection).Make(request, response)
+		})
+
+		It("does not retry a POST request", func() {
+			Expect(makeErr).To(MatchError("connection reset by peer"))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+
+		Context("when a POST request has an idempotency key", func() {
+			BeforeEach(func() {
+				request.Header.Set(IdempotencyKeyHeader, "some-key")
+			})
+
+			It("retries the request after network errors", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+
+			Context("when a retryable status code is followed by a failure without a response", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+						if fakeConnection.MakeCallCount() > 1 {
+							return errors.New("some error")
+						}
+						passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusServiceUnavailable}
+						return uaa.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+					}
+				})
+
+				It("does not retry the failure", func() {
+					Expect(makeErr).To(MatchError("some error"))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+					Expect(response.HTTPResponse).To(BeNil())
+				})
+			})
+
+			Context("when the retried requests are logged", func() {
+				var fakeOutput *wrapperfakes.FakeJSONRequestLoggerOutput
+
+				BeforeEach(func() {
+					fakeOutput = new(wrapperfakes.FakeJSONRequestLoggerOutput)
+					connection = NewJSONRequestLogger(fakeOutput).Wrap(fakeConnection)
+				})
+
+				It("writes the retry attempt", func() {
+					Expect(fakeOutput.WriteEntryCallCount()).To(Equal(3))
+					Expect(fakeOutput.WriteEntryArgsForCall(0).RetryAttempt).To(Equal(0))
+					Expect(fakeOutput.WriteEntryArgsForCall(1).RetryAttempt).To(Equal(1))
+					Expect(fakeOutput.WriteEntryArgsForCall(2).RetryAttempt).To(Equal(2))
+				})
+			})
+		})
+	})
 })
@@ -3,32 +3,39 @@ package application
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/crypto/ssh"
 
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type SSH struct {
-	ui            terminal.UI
-	config        coreconfig.Reader
-	gateway       net.Gateway
-	appReq        requirements.ApplicationRequirement
-	sshCodeGetter commands.SSHCodeGetter
-	opts          *options.SSHOptions
-	secureShell   sshCmd.SecureShell
+	ui               terminal.UI
+	config           coreconfig.Reader
+	gateway          net.Gateway
+	appReq           requirements.ApplicationRequirement
+	appInstancesRepo appinstances.Repository
+	sshCodeGetter    commands.SSHCodeGetter
+	opts             *options.SSHOptions
+	secureShell      sshCmd.SecureShell
 }
 
 type sshInfo struct {
@@ -43,6 +50,8 @@ func init() {
 func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["L"] = &flags.StringSliceFlag{ShortName: "L", Usage: T("Local port forward specification. This flag can be defined more than once.")}
+	fs["R"] = &flags.StringSliceFlag{ShortName: "R", Usage: T("Remote port forward specification. This flag can be defined more than once.")}
+	fs["D"] = &flags.StringSliceFlag{ShortName: "D", Usage: T("Dynamic port forward specification, as a local SOCKS5 proxy. This flag can be defined more than once.")}
 	fs["command"] = &flags.StringSliceFlag{Name: "command", ShortName: "c", Usage: T("Command to run. This flag can be defined more than once.")}
 	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
 	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
@@ -50,12 +59,15 @@ func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs["request-pseudo-tty"] = &flags.BoolFlag{Name: "request-pseudo-tty", ShortName: "t", Usage: T("Request pseudo-tty allocation")}
 	fs["force-pseudo-tty"] = &flags.BoolFlag{Name: "force-pseudo-tty", ShortName: "tt", Usage: T("Force pseudo-tty allocation")}
 	fs["disable-pseudo-tty"] = &flags.BoolFlag{Name: "disable-pseudo-tty", ShortName: "T", Usage: T("Disable pseudo-tty allocation")}
+	fs["all-instances"] = &flags.BoolFlag{Name: "all-instances", Usage: T("Run the command on every running instance in parallel, prefixing output with the instance index")}
+	fs["max-in-flight"] = &flags.IntFlag{Name: "max-in-flight", Value: 10, Usage: T("Maximum number of instances to run the command on at once with --all-instances (Default: 10)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "ssh",
 		Description: T("SSH to an application container instance"),
 		Usage: []string{
-			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [-R [bind_address:]port:host:hostport] [-D [bind_address:]port] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			T("CF_NAME ssh APP_NAME --all-instances -c command [--max-in-flight number] [--skip-host-validation]"),
 		},
 		Flags: fs,
 	}
@@ -90,6 +102,7 @@ func (cmd *SSH) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.gateway = deps.Gateways["cloud-controller"]
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 
 	if deps.WildcardDependency != nil {
 		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
@@ -120,6 +133,10 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		return errors.New(T("Error getting SSH info:") + err.Error())
 	}
 
+	if cmd.opts.AllInstances {
+		return cmd.executeOnAllInstances(app, info)
+	}
+
 	sshAuthCode, err := cmd.sshCodeGetter.Get()
 	if err != nil {
 		return errors.New(T("Error getting one time auth code: ") + err.Error())
@@ -150,6 +167,16 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		return errors.New(T("Error forwarding port: ") + err.Error())
 	}
 
+	err = cmd.secureShell.RemotePortForward()
+	if err != nil {
+		return errors.New(T("Error forwarding port: ") + err.Error())
+	}
+
+	err = cmd.secureShell.DynamicPortForward()
+	if err != nil {
+		return errors.New(T("Error forwarding port: ") + err.Error())
+	}
+
 	if cmd.opts.SkipRemoteExecution {
 		err = cmd.secureShell.Wait()
 	} else {
@@ -173,6 +200,120 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 	return nil
 }
 
+// executeOnAllInstances runs the command on every running instance of the app,
+// with at most --max-in-flight sessions open at once. Each line of output is
+// prefixed with the index of the instance it came from.
+func (cmd *SSH) executeOnAllInstances(app models.Application, info sshInfo) error {
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		return errors.New(T("Error getting application instances: ") + err.Error())
+	}
+
+	var indexes []int
+	for index, instance := range instances {
+		if instance.State == models.InstanceRunning {
+			indexes = append(indexes, index)
+		}
+	}
+	if len(indexes) == 0 {
+		return errors.New(T("Application {{.AppName}} has no running instances", map[string]interface{}{"AppName": app.Name}))
+	}
+
+	outLock := &sync.Mutex{}
+	errLock := &sync.Mutex{}
+	codeLock := &sync.Mutex{}
+	runErrs := make([]error, len(indexes))
+	exitStatuses := make([]int, len(indexes))
+	semaphore := make(chan struct{}, cmd.opts.MaxInFlight)
+	wg := &sync.WaitGroup{}
+
+	for i, index := range indexes {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, index int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			prefix := fmt.Sprintf("[%d] ", index)
+			stdout := ui.NewLinePrefixWriter(cmd.ui.Writer(), prefix, outLock)
+			stderr := ui.NewLinePrefixWriter(os.Stderr, prefix, errLock)
+
+			exitStatuses[i], runErrs[i] = cmd.runOnInstance(app, info, index, codeLock, stdout, stderr)
+
+			_ = stdout.Flush()
+			_ = stderr.Flush()
+		}(i, index)
+	}
+	wg.Wait()
+
+	cmd.ui.Say("")
+	table := cmd.ui.Table([]string{T("instance"), T("exit status")})
+
+	var failedIndexes []string
+	for i, index := range indexes {
+		status := fmt.Sprint(exitStatuses[i])
+		if runErrs[i] != nil {
+			status = runErrs[i].Error()
+		}
+		if runErrs[i] != nil || exitStatuses[i] != 0 {
+			failedIndexes = append(failedIndexes, fmt.Sprint(index))
+		}
+
+		table.Add(fmt.Sprintf("#%d", index), status)
+	}
+
+	err = table.Print()
+	if err != nil {
+		return err
+	}
+
+	if len(failedIndexes) > 0 {
+		return errors.New(T("Command failed on instances: {{.InstanceIndexes}}", map[string]interface{}{
+			"InstanceIndexes": strings.Join(failedIndexes, ", "),
+		}))
+	}
+	return nil
+}
+
+// runOnInstance runs the command on the instance at index over its own
+// connection and returns the command's exit status. The one time auth code is
+// fetched just before connecting, so that codes are not spent on instances
+// still waiting for a session. codeLock serializes the fetches, which may
+// refresh the access token.
+func (cmd *SSH) runOnInstance(app models.Application, info sshInfo, index int, codeLock *sync.Mutex, stdout io.Writer, stderr io.Writer) (int, error) {
+	codeLock.Lock()
+	sshAuthCode, err := cmd.sshCodeGetter.Get()
+	codeLock.Unlock()
+	if err != nil {
+		return 0, errors.New(T("Error getting one time auth code: ") + err.Error())
+	}
+
+	//use a new secureShell for each instance unless one was set by SetDependency() with fakes
+	secureShell := cmd.secureShell
+	if secureShell == nil {
+		secureShell = sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+		)
+	}
+
+	opts := *cmd.opts
+	opts.Index = uint(index)
+	err = secureShell.Connect(&opts)
+	if err != nil {
+		return 0, errors.New(T("Error opening SSH connection: ") + err.Error())
+	}
+	defer secureShell.Close()
+
+	return secureShell.RunCommand(stdout, stderr)
+}
+
 func (cmd *SSH) getSSHEndpointInfo() (sshInfo, error) {
 	info := sshInfo{}
 	err := cmd.gateway.GetResource(cmd.config.APIEndpoint()+"/v2/info", &info)
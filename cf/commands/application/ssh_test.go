@@ -2,11 +2,14 @@ package application_test
 
 import (
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"time"
 
+	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -14,9 +17,11 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
 	testcmd "code.cloudfoundry.org/cli/cf/util/testhelpers/commands"
 	testconfig "code.cloudfoundry.org/cli/cf/util/testhelpers/configuration"
+	io_helpers "code.cloudfoundry.org/cli/cf/util/testhelpers/io"
 	testnet "code.cloudfoundry.org/cli/cf/util/testhelpers/net"
 	testterm "code.cloudfoundry.org/cli/cf/util/testhelpers/terminal"
 
@@ -38,7 +43,8 @@ var _ = Describe("SSH command", func() {
 		deps                commandregistry.Dependency
 		ccGateway           net.Gateway
 
-		fakeSecureShell *sshfakes.FakeSecureShell
+		fakeSecureShell  *sshfakes.FakeSecureShell
+		appInstancesRepo *appinstancesfakes.FakeAppInstancesRepository
 	)
 
 	BeforeEach(func() {
@@ -47,6 +53,9 @@ var _ = Describe("SSH command", func() {
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		deps.Gateways = make(map[string]net.Gateway)
 
+		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
+		deps.RepoLocator = api.RepositoryLocator{}.SetAppInstancesRepository(appInstancesRepo)
+
 		//save original command and restore later
 		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
 
@@ -305,6 +314,40 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("remote listen error"))
+
+					runCommand("my-app", "-R", "8000:localhost:8000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "remote listen error"},
+					))
+				})
+			})
+
+			Context("Error port forwarding when -D is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.DynamicPortForwardReturns(errors.New("socks listen error"))
+
+					runCommand("my-app", "-D", "1080")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "socks listen error"},
+					))
+				})
+			})
+
+			Context("when -R and -D are provided", func() {
+				It("starts the remote and dynamic port forwarding before the session", func() {
+					runCommand("my-app", "-R", "8000:localhost:8000", "-D", "1080")
+
+					Expect(fakeSecureShell.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
@@ -327,6 +370,92 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("when --all-instances is provided", func() {
+				BeforeEach(func() {
+					appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+						{State: models.InstanceRunning},
+						{State: models.InstanceCrashed},
+						{State: models.InstanceRunning},
+					}, nil)
+					sshCodeGetter.GetReturns("some-code", nil)
+				})
+
+				It("runs the command on each running instance with its own auth code", func() {
+					Expect(runCommand("my-app", "--all-instances", "-c", "uptime", "--max-in-flight", "1")).To(BeTrue())
+
+					Expect(appInstancesRepo.GetInstancesArgsForCall(0)).To(Equal("my-app-guid"))
+					Expect(sshCodeGetter.GetCallCount()).To(Equal(2))
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(2))
+					Expect(fakeSecureShell.ConnectArgsForCall(0).Index).To(Equal(uint(0)))
+					Expect(fakeSecureShell.ConnectArgsForCall(0).Command).To(Equal([]string{"uptime"}))
+					Expect(fakeSecureShell.ConnectArgsForCall(1).Index).To(Equal(uint(2)))
+					Expect(fakeSecureShell.RunCommandCallCount()).To(Equal(2))
+					Expect(fakeSecureShell.CloseCallCount()).To(Equal(2))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"instance", "exit status"},
+						[]string{"#0", "0"},
+						[]string{"#2", "0"},
+					))
+				})
+
+				It("prefixes each line of output with the instance index", func() {
+					fakeSecureShell.RunCommandStub = func(stdout io.Writer, _ io.Writer) (int, error) {
+						_, err := io.WriteString(stdout, "some-output\nsome-more-output\n")
+						Expect(err).NotTo(HaveOccurred())
+						return 0, nil
+					}
+
+					output := io_helpers.CaptureOutput(func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "uptime", "--max-in-flight", "1")).To(BeTrue())
+					})
+					Expect(output).To(ContainElement("[0] some-output"))
+					Expect(output).To(ContainElement("[0] some-more-output"))
+					Expect(output).To(ContainElement("[2] some-output"))
+					Expect(output).To(ContainElement("[2] some-more-output"))
+				})
+
+				Context("when the command fails on an instance", func() {
+					BeforeEach(func() {
+						fakeSecureShell.RunCommandReturns(3, nil)
+						fakeSecureShell.ConnectStub = func(opts *options.SSHOptions) error {
+							if opts.Index == 2 {
+								return errors.New("dial error")
+							}
+							return nil
+						}
+					})
+
+					It("shows each instance's result and fails with the failed instances", func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeFalse())
+
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"#0", "3"},
+							[]string{"#2", "Error opening SSH connection", "dial error"},
+							[]string{"FAILED"},
+							[]string{"Command failed on instances: 0, 2"},
+						))
+					})
+				})
+
+				Context("when the app has no running instances", func() {
+					BeforeEach(func() {
+						appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+							{State: models.InstanceCrashed},
+						}, nil)
+					})
+
+					It("notifies users", func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "uptime")).To(BeFalse())
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Application my-app has no running instances"},
+						))
+						Expect(fakeSecureShell.ConnectCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when Wait() or InteractiveSession() returns error", func() {
 
 				It("notifities users", func() {
@@ -2,8 +2,11 @@ package coreconfig
 
 import (
 	"encoding/json"
+	"os"
+	"reflect"
 
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type AuthPromptType string
@@ -42,6 +45,40 @@ type Data struct {
 	UAAGrantType             string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	CurrentProfile           string                     `json:",omitempty"`
+	Profiles                 map[string]json.RawMessage `json:",omitempty"`
+
+	// profileOverride is the profile named by CF_PROFILE. It is targeted in
+	// place of CurrentProfile without being written to disk.
+	profileOverride string
+
+	// credentialStore keeps the access token, refresh token and client secret
+	// out of the config file, in the same way as the configv3 package. When it
+	// is nil they are written to the config file.
+	credentialStore   configv3.CredentialStore
+	configFilePath    string
+	storedCredentials map[string]configv3.Credentials
+}
+
+// profileData is the part of Data that is kept for each named profile.
+type profileData struct {
+	AccessToken              string
+	APIVersion               string
+	AuthorizationEndpoint    string
+	DopplerEndPoint          string
+	MinCLIVersion            string
+	MinRecommendedCLIVersion string
+	OrganizationFields       models.OrganizationFields
+	RefreshToken             string
+	RoutingAPIEndpoint       string
+	SpaceFields              models.SpaceFields
+	SSHOAuthClient           string
+	SSLDisabled              bool
+	Target                   string
+	UaaEndpoint              string
+	UAAGrantType             string
+	UAAOAuthClient           string
+	UAAOAuthClientSecret     string
 }
 
 func NewData() *Data {
@@ -55,7 +92,30 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = 3
-	return json.MarshalIndent(d, "", "  ")
+	err := d.storeCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	data := *d
+	if d.credentialStore != nil {
+		data.AccessToken = ""
+		data.RefreshToken = ""
+		data.UAAOAuthClientSecret = ""
+	}
+	if !d.profileOverridden() {
+		return json.MarshalIndent(data, "", "  ")
+	}
+
+	data.Profiles = make(map[string]json.RawMessage, len(d.Profiles))
+	for name, profile := range d.Profiles {
+		data.Profiles[name] = profile
+	}
+	err = data.switchProfile(d.profileOverride, data.currentProfileName())
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(data, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -65,9 +125,172 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 	}
 
 	if d.ConfigVersion != 3 {
-		*d = Data{}
+		*d = Data{
+			credentialStore: d.credentialStore,
+			configFilePath:  d.configFilePath,
+		}
+		return nil
+	}
+
+	d.profileOverride = os.Getenv("CF_PROFILE")
+	if d.profileOverridden() {
+		err = d.switchProfile(d.currentProfileName(), d.profileOverride)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.loadCredentials()
+}
+
+// useCredentialStore keeps the credentials of the config file at
+// configFilePath in the store.
+func (d *Data) useCredentialStore(store configv3.CredentialStore, configFilePath string) {
+	d.credentialStore = store
+	d.configFilePath = configFilePath
+}
+
+func (d *Data) activeProfileName() string {
+	if d.profileOverride != "" {
+		return d.profileOverride
+	}
+	return d.currentProfileName()
+}
+
+// loadCredentials fills in the credentials of the targeted profile from the
+// credential store. Credentials still in the config file take precedence and
+// are moved to the store the next time the config is written.
+func (d *Data) loadCredentials() error {
+	if d.credentialStore == nil {
 		return nil
 	}
 
+	credentials, err := configv3.LoadCredentials(d.credentialStore, d.configFilePath)
+	if err != nil {
+		return err
+	}
+	d.storedCredentials = credentials
+
+	stored := credentials[d.activeProfileName()]
+	if d.AccessToken == "" {
+		d.AccessToken = stored.AccessToken
+	}
+	if d.RefreshToken == "" {
+		d.RefreshToken = stored.RefreshToken
+	}
+	if d.UAAOAuthClientSecret == "" {
+		d.UAAOAuthClientSecret = stored.UAAOAuthClientSecret
+	}
+	return nil
+}
+
+// storeCredentials writes the credentials of the targeted profile to the
+// credential store, keeping those of the other profiles. The store is only
+// written when the credentials have changed.
+func (d *Data) storeCredentials() error {
+	if d.credentialStore == nil {
+		return nil
+	}
+
+	credentials := make(map[string]configv3.Credentials, len(d.storedCredentials)+1)
+	for name, profileCredentials := range d.storedCredentials {
+		credentials[name] = profileCredentials
+	}
+
+	active := configv3.Credentials{
+		AccessToken:          d.AccessToken,
+		RefreshToken:         d.RefreshToken,
+		UAAOAuthClientSecret: d.UAAOAuthClientSecret,
+	}
+	if active == (configv3.Credentials{}) {
+		delete(credentials, d.activeProfileName())
+	} else {
+		credentials[d.activeProfileName()] = active
+	}
+
+	if reflect.DeepEqual(credentials, d.storedCredentials) {
+		return nil
+	}
+
+	err := configv3.SaveCredentials(d.credentialStore, d.configFilePath, credentials)
+	if err != nil {
+		return err
+	}
+	d.storedCredentials = credentials
+	return nil
+}
+
+func (d *Data) currentProfileName() string {
+	if d.CurrentProfile == "" {
+		return "default"
+	}
+	return d.CurrentProfile
+}
+
+func (d *Data) profileOverridden() bool {
+	return d.profileOverride != "" && d.profileOverride != d.currentProfileName()
+}
+
+// switchProfile saves the targeted fields as the activeName profile and
+// replaces them with the name profile, in the same way as the configv3
+// package.
+func (d *Data) switchProfile(activeName string, name string) error {
+	active, err := json.Marshal(profileData{
+		AccessToken:              d.AccessToken,
+		APIVersion:               d.APIVersion,
+		AuthorizationEndpoint:    d.AuthorizationEndpoint,
+		DopplerEndPoint:          d.DopplerEndPoint,
+		MinCLIVersion:            d.MinCLIVersion,
+		MinRecommendedCLIVersion: d.MinRecommendedCLIVersion,
+		OrganizationFields:       d.OrganizationFields,
+		RefreshToken:             d.RefreshToken,
+		RoutingAPIEndpoint:       d.RoutingAPIEndpoint,
+		SpaceFields:              d.SpaceFields,
+		SSHOAuthClient:           d.SSHOAuthClient,
+		SSLDisabled:              d.SSLDisabled,
+		Target:                   d.Target,
+		UaaEndpoint:              d.UaaEndpoint,
+		UAAGrantType:             d.UAAGrantType,
+		UAAOAuthClient:           d.UAAOAuthClient,
+		UAAOAuthClientSecret:     d.UAAOAuthClientSecret,
+	})
+	if err != nil {
+		return err
+	}
+
+	if d.Profiles == nil {
+		d.Profiles = map[string]json.RawMessage{}
+	}
+	d.Profiles[activeName] = active
+
+	profile := profileData{
+		SSHOAuthClient: "ssh-proxy",
+		UAAOAuthClient: "cf",
+	}
+	if raw, ok := d.Profiles[name]; ok {
+		err = json.Unmarshal(raw, &profile)
+		if err != nil {
+			return err
+		}
+	}
+	delete(d.Profiles, name)
+
+	d.AccessToken = profile.AccessToken
+	d.APIVersion = profile.APIVersion
+	d.AuthorizationEndpoint = profile.AuthorizationEndpoint
+	d.DopplerEndPoint = profile.DopplerEndPoint
+	d.MinCLIVersion = profile.MinCLIVersion
+	d.MinRecommendedCLIVersion = profile.MinRecommendedCLIVersion
+	d.OrganizationFields = profile.OrganizationFields
+	d.RefreshToken = profile.RefreshToken
+	d.RoutingAPIEndpoint = profile.RoutingAPIEndpoint
+	d.SpaceFields = profile.SpaceFields
+	d.SSHOAuthClient = profile.SSHOAuthClient
+	d.SSLDisabled = profile.SSLDisabled
+	d.Target = profile.Target
+	d.UaaEndpoint = profile.UaaEndpoint
+	d.UAAGrantType = profile.UAAGrantType
+	d.UAAOAuthClient = profile.UAAOAuthClient
+	d.UAAOAuthClientSecret = profile.UAAOAuthClientSecret
 	return nil
 }
@@ -1,6 +1,9 @@
 package coreconfig_test
 
 import (
+	"encoding/json"
+	"os"
+
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 
@@ -216,5 +219,60 @@ var _ = Describe("V3 Config files", func() {
 
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
+
+		Context("when CF_PROFILE names another profile", func() {
+			var profilesJSON = `
+			{
+				"ConfigVersion": 3,
+				"Target": "api.prod.com",
+				"AccessToken": "prod-token",
+				"Locale": "fr_FR",
+				"CurrentProfile": "prod",
+				"Profiles": {
+					"staging": {
+						"Target": "api.staging.com",
+						"AccessToken": "staging-token",
+						"SSLDisabled": true,
+						"SpaceFields": {"GUID": "staging-space-guid", "Name": "staging-space"}
+					}
+				}
+			}`
+
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+			})
+
+			It("targets that profile and writes it back to the profiles", func() {
+				data := coreconfig.NewData()
+				err := data.JSONUnmarshalV3([]byte(profilesJSON))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(data.Target).To(Equal("api.staging.com"))
+				Expect(data.AccessToken).To(Equal("staging-token"))
+				Expect(data.SSLDisabled).To(BeTrue())
+				Expect(data.SpaceFields.Name).To(Equal("staging-space"))
+				Expect(data.Locale).To(Equal("fr_FR"))
+
+				data.AccessToken = "new-staging-token"
+				jsonData, err := data.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+
+				var written map[string]interface{}
+				Expect(json.Unmarshal(jsonData, &written)).To(Succeed())
+				Expect(written["Target"]).To(Equal("api.prod.com"))
+				Expect(written["AccessToken"]).To(Equal("prod-token"))
+				Expect(written["CurrentProfile"]).To(Equal("prod"))
+
+				profiles := written["Profiles"].(map[string]interface{})
+				Expect(profiles).To(HaveLen(1))
+				staging := profiles["staging"].(map[string]interface{})
+				Expect(staging["Target"]).To(Equal("api.staging.com"))
+				Expect(staging["AccessToken"]).To(Equal("new-staging-token"))
+			})
+		})
 	})
 })
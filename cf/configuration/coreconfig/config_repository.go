@@ -1,11 +1,14 @@
 package coreconfig
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -29,11 +32,17 @@ type CCInfo struct {
 	RoutingAPIEndpoint       string `json:"routing_endpoint"`
 }
 
-func NewRepositoryFromFilepath(filepath string, errorHandler func(error)) Repository {
+func NewRepositoryFromFilepath(configPath string, errorHandler func(error)) Repository {
 	if errorHandler == nil {
 		return nil
 	}
-	return NewRepositoryFromPersistor(configuration.NewDiskPersistor(filepath), errorHandler)
+
+	repository := NewRepositoryFromPersistor(configuration.NewDiskPersistor(configPath), errorHandler).(*ConfigRepository)
+	repository.data.useCredentialStore(
+		configv3.NewCredentialStore(filepath.Dir(configPath), os.Getenv("CF_CREDENTIAL_HELPER")),
+		configPath,
+	)
+	return repository
 }
 
 func NewRepositoryFromPersistor(persistor configuration.Persistor, errorHandler func(error)) Repository {
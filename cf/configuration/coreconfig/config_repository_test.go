@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/configurationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 
@@ -242,6 +243,47 @@ var _ = Describe("Configuration Repository", func() {
 			})
 		})
 
+		Context("when credentials are set", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "test-config")
+				Expect(err).NotTo(HaveOccurred())
+
+				configPath = filepath.Join(tmpDir, ".cf", "config.json")
+				// Keep the test out of the OS keychain of the machine running it.
+				Expect(os.Setenv("CF_CREDENTIAL_HELPER", configv3.FileCredentialHelper)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+				Expect(os.Unsetenv("CF_CREDENTIAL_HELPER")).To(Succeed())
+			})
+
+			It("keeps them in the credential store instead of the config file", func() {
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+					panic(err)
+				})
+				config.SetAPIEndpoint("https://api.example.com")
+				config.SetAccessToken("some-access-token")
+				config.SetRefreshToken("some-refresh-token")
+				config.Close()
+
+				rawConfig, err := ioutil.ReadFile(configPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(rawConfig)).To(ContainSubstring("https://api.example.com"))
+				Expect(string(rawConfig)).NotTo(ContainSubstring("some-access-token"))
+				Expect(string(rawConfig)).NotTo(ContainSubstring("some-refresh-token"))
+
+				config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+					panic(err)
+				})
+				Expect(config.AccessToken()).To(Equal("some-access-token"))
+				Expect(config.RefreshToken()).To(Equal("some-refresh-token"))
+			})
+		})
+
 		Context("when the configuration version is older than the current version", func() {
 			BeforeEach(func() {
 				cwd, err := os.Getwd()
@@ -1,6 +1,7 @@
 package options
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -29,6 +30,16 @@ type SSHOptions struct {
 	SkipRemoteExecution bool
 	TerminalRequest     TTYRequest
 	ForwardSpecs        []ForwardSpec
+	// RemoteForwardSpecs listen in the app instance and connect from the
+	// local machine.
+	RemoteForwardSpecs []ForwardSpec
+	// DynamicForwardAddresses are the local addresses of SOCKS5 proxies that
+	// connect from the app instance.
+	DynamicForwardAddresses []string
+	// AllInstances runs Command on every running instance instead of the
+	// instance at Index, with at most MaxInFlight sessions open at once.
+	AllInstances bool
+	MaxInFlight  int
 }
 
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
@@ -50,6 +61,26 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		}
 	}
 
+	if fc.IsSet("R") {
+		for _, arg := range fc.StringSlice("R") {
+			forwardSpec, err := sshOptions.parseForwardingSpec(arg, "remote")
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.RemoteForwardSpecs = append(sshOptions.RemoteForwardSpecs, *forwardSpec)
+		}
+	}
+
+	if fc.IsSet("D") {
+		for _, arg := range fc.StringSlice("D") {
+			address, err := sshOptions.parseDynamicForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.DynamicForwardAddresses = append(sshOptions.DynamicForwardAddresses, address)
+		}
+	}
+
 	if fc.IsSet("t") && fc.Bool("t") {
 		sshOptions.TerminalRequest = RequestTTYYes
 	}
@@ -62,21 +93,55 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		sshOptions.TerminalRequest = RequestTTYNo
 	}
 
+	if fc.Bool("all-instances") {
+		sshOptions.AllInstances = true
+		sshOptions.MaxInFlight = fc.Int("max-in-flight")
+
+		err := sshOptions.validateAllInstances(fc)
+		if err != nil {
+			return sshOptions, err
+		}
+	}
+
 	return sshOptions, nil
 }
 
-func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
-	arg = strings.TrimSpace(arg)
+// validateAllInstances returns an error when --all-instances is used without
+// a command or with flags that only apply to a single session.
+func (o *SSHOptions) validateAllInstances(fc flags.FlagContext) error {
+	if len(o.Command) == 0 {
+		return errors.New("--all-instances requires a command, given with -c")
+	}
 
-	parts := []string{}
-	for remainder := arg; remainder != ""; {
-		part, r, err := tokenizeForward(remainder)
-		if err != nil {
-			return nil, err
+	var conflicts []string
+	for _, name := range []string{"i", "L", "R", "D", "N", "t", "tt"} {
+		if fc.IsSet(name) {
+			conflicts = append(conflicts, "-"+name)
 		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("--all-instances cannot be used with %s", strings.Join(conflicts, ", "))
+	}
 
-		parts = append(parts, part)
-		remainder = r
+	if o.MaxInFlight < 1 {
+		return errors.New("--max-in-flight must be greater than 0")
+	}
+
+	return nil
+}
+
+func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
+	return o.parseForwardingSpec(arg, "local")
+}
+
+// parseForwardingSpec parses a [bind_address:]port:host:hostport
+// specification. The bind address defaults to localhost.
+func (o *SSHOptions) parseForwardingSpec(arg string, kind string) (*ForwardSpec, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return nil, err
 	}
 
 	forwardSpec := &ForwardSpec{}
@@ -91,12 +156,49 @@ func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error)
 		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
 		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
 	default:
-		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+		return nil, fmt.Errorf("Unable to parse %s forwarding argument: %q", kind, arg)
 	}
 
 	return forwardSpec, nil
 }
 
+// parseDynamicForwardingSpec parses a [bind_address:]port specification and
+// returns the address to listen on. The bind address defaults to localhost.
+func (o *SSHOptions) parseDynamicForwardingSpec(arg string) (string, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardingSpec(arg)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(parts) {
+	case 2:
+		if parts[0] == "*" {
+			parts[0] = ""
+		}
+		return fmt.Sprintf("%s:%s", parts[0], parts[1]), nil
+	case 1:
+		return fmt.Sprintf("localhost:%s", parts[0]), nil
+	default:
+		return "", fmt.Errorf("Unable to parse dynamic forwarding argument: %q", arg)
+	}
+}
+
+func tokenizeForwardingSpec(arg string) ([]string, error) {
+	parts := []string{}
+	for remainder := arg; remainder != ""; {
+		part, r, err := tokenizeForward(remainder)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+		remainder = r
+	}
+	return parts, nil
+}
+
 func tokenizeForward(arg string) (string, string, error) {
 	switch arg[0] {
 	case ':':
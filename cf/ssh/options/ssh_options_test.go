@@ -20,6 +20,8 @@ var _ = Describe("SSHOptions", func() {
 		BeforeEach(func() {
 			fc = flags.New()
 			fc.NewStringSliceFlag("L", "", "")
+			fc.NewStringSliceFlag("R", "", "")
+			fc.NewStringSliceFlag("D", "", "")
 			fc.NewStringSliceFlag("command", "c", "")
 			fc.NewIntFlag("app-instance-index", "i", "")
 			fc.NewBoolFlag("skip-host-validation", "k", "")
@@ -27,6 +29,8 @@ var _ = Describe("SSHOptions", func() {
 			fc.NewBoolFlag("request-pseudo-tty", "t", "")
 			fc.NewBoolFlag("force-pseudo-tty", "tt", "")
 			fc.NewBoolFlag("disable-pseudo-tty", "T", "")
+			fc.NewBoolFlag("all-instances", "", "")
+			fc.NewIntFlagWithDefault("max-in-flight", "", "", 10)
 
 			args = []string{}
 			parseError = nil
@@ -281,6 +285,105 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when remote port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "-R", "9999:localhost:8888", "-R", "[::]:8080:example.com:80")
+			})
+
+			It("sets the remote forward specs", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.RemoteForwardSpecs).To(ConsistOf(
+					options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"},
+					options.ForwardSpec{ListenAddress: "[::]:8080", ConnectAddress: "example.com:80"},
+				))
+			})
+
+			Context("when the specification is malformed", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse remote forwarding argument: "9999:localhost"`))
+				})
+			})
+		})
+
+		Context("when dynamic port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "-D", "1080", "-D", "*:1081", "-D", "[::1]:1082")
+			})
+
+			It("sets the dynamic forward addresses", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.DynamicForwardAddresses).To(ConsistOf("localhost:1080", ":1081", "[::1]:1082"))
+			})
+
+			Context("when the specification is malformed", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "localhost:1080:remote")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse dynamic forwarding argument: "localhost:1080:remote"`))
+				})
+			})
+		})
+
+		Context("when --all-instances is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--all-instances", "-c", "uptime")
+			})
+
+			It("runs the command on every instance, 10 at a time", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AllInstances).To(BeTrue())
+				Expect(opts.MaxInFlight).To(Equal(10))
+				Expect(opts.Command).To(Equal([]string{"uptime"}))
+			})
+
+			Context("with --max-in-flight", func() {
+				BeforeEach(func() {
+					args = append(args, "--max-in-flight", "3")
+				})
+
+				It("sets the maximum number of sessions", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.MaxInFlight).To(Equal(3))
+				})
+			})
+
+			Context("when --max-in-flight is less than 1", func() {
+				BeforeEach(func() {
+					args = append(args, "--max-in-flight", "0")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--max-in-flight must be greater than 0"))
+				})
+			})
+
+			Context("without a command", func() {
+				BeforeEach(func() {
+					args = []string{"app-name", "--all-instances"}
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances requires a command, given with -c"))
+				})
+			})
+
+			Context("with flags that only apply to a single session", func() {
+				BeforeEach(func() {
+					args = append(args, "-i", "1", "-L", "9999:localhost:8080", "-N")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with -i, -L, -N"))
+				})
+			})
+		})
+
 		Context("when -N is specified", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name", "-N")
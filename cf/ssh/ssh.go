@@ -23,6 +23,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/util/clissh"
 	"github.com/moby/moby/pkg/term"
 )
 
@@ -37,7 +38,10 @@ const (
 type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
+	RunCommand(stdout io.Writer, stderr io.Writer) (int, error)
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
 	Wait() error
 	Close() error
 }
@@ -54,6 +58,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -90,7 +95,7 @@ type secureShell struct {
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
 
-	localListeners []net.Listener
+	listeners []net.Listener
 }
 
 func NewSecureShell(
@@ -112,7 +117,7 @@ func NewSecureShell(
 		sshEndpointFingerprint: sshEndpointFingerprint,
 		sshEndpoint:            sshEndpoint,
 		token:                  token,
-		localListeners:         []net.Listener{},
+		listeners:              []net.Listener{},
 	}
 }
 
@@ -141,7 +146,7 @@ func (c *secureShell) Connect(opts *options.SSHOptions) error {
 }
 
 func (c *secureShell) Close() error {
-	for _, listener := range c.localListeners {
+	for _, listener := range c.listeners {
 		_ = listener.Close()
 	}
 	return c.secureClient.Close()
@@ -153,15 +158,56 @@ func (c *secureShell) LocalPortForward() error {
 		if err != nil {
 			return err
 		}
-		c.localListeners = append(c.localListeners, listener)
+		c.listeners = append(c.listeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			handleForwardConnection(conn, c.secureClient.Dial, connectAddress)
+		})
+	}
+
+	return nil
+}
+
+// RemotePortForward requests that the app instance listens on the listen
+// address of each remote forward spec, and forwards the connections it accepts
+// to the connect address, dialed from the local machine.
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return fmt.Errorf("remote port forwarding on %s failed: %s", forwardSpec.ListenAddress, err.Error())
+		}
+		c.listeners = append(c.listeners, listener)
 
-		go c.localForwardAcceptLoop(listener, forwardSpec.ConnectAddress)
+		connectAddress := forwardSpec.ConnectAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			handleForwardConnection(conn, net.Dial, connectAddress)
+		})
 	}
 
 	return nil
 }
 
-func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+// DynamicPortForward starts a SOCKS5 proxy on each dynamic forward address
+// that connects to the requested addresses from the app instance.
+func (c *secureShell) DynamicPortForward() error {
+	for _, address := range c.opts.DynamicForwardAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
+
+		go acceptLoop(listener, c.handleSOCKSConnection)
+	}
+
+	return nil
+}
+
+// acceptLoop handles each connection accepted by the listener until it is
+// closed.
+func acceptLoop(listener net.Listener, handle func(conn net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -174,14 +220,37 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
-func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+func (c *secureShell) handleSOCKSConnection(conn net.Conn) {
+	targetAddr, err := clissh.SOCKS5Handshake(conn)
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	handleForwardConnection(conn, func(network string, address string) (net.Conn, error) {
+		target, dialErr := c.secureClient.Dial(network, address)
+		if dialErr != nil {
+			_ = clissh.SOCKS5Reply(conn, clissh.SOCKS5ReplyHostUnreachable)
+			return nil, dialErr
+		}
+		if replyErr := clissh.SOCKS5Reply(conn, clissh.SOCKS5ReplySucceeded); replyErr != nil {
+			target.Close()
+			return nil, replyErr
+		}
+		return target, nil
+	}, targetAddr)
+}
+
+// handleForwardConnection dials the target address and copies data in both
+// directions until either side closes its connection.
+func handleForwardConnection(conn net.Conn, dial func(network string, address string) (net.Conn, error), targetAddr string) {
 	defer conn.Close()
 
-	target, err := c.secureClient.Dial("tcp", targetAddr)
+	target, err := dial("tcp", targetAddr)
 	if err != nil {
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
@@ -313,6 +382,57 @@ func (c *secureShell) InteractiveSession() error {
 	return result
 }
 
+// RunCommand runs the command from the options passed to Connect without a
+// pseudo-tty, copying its output to stdout and stderr, and returns its exit
+// status.
+func (c *secureShell) RunCommand(stdout io.Writer, stderr io.Writer) (int, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return 0, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return 0, err
+	}
+
+	err = session.Start(strings.Join(c.opts.Command, " "))
+	if err != nil {
+		return 0, err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+
+	if exitErr, ok := result.(exitStatusError); ok {
+		return exitErr.ExitStatus(), nil
+	}
+	return 0, result
+}
+
+// exitStatusError is implemented by *ssh.ExitError.
+type exitStatusError interface {
+	error
+	ExitStatus() int
+}
+
 func (c *secureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -477,6 +597,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -7,11 +7,13 @@
 package sshCmd_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
@@ -1165,6 +1167,244 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts       *options.SSHOptions
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:5005",
+					ConnectAddress: echoListener.Addr().String(),
+				}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward()
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the listen address in the app instance", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:5005"))
+		})
+
+		It("copies data between the remote connections and the connect address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			expectEcho(conn, "Hello from the app instance\n")
+		})
+
+		Context("when listening in the app instance fails", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("remote port forwarding on localhost:5005 failed: tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			opts       *options.SSHOptions
+			forwardErr error
+
+			echoListener  net.Listener
+			localListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+
+			opts = &options.SSHOptions{
+				AppName:                 "app-1",
+				DynamicForwardAddresses: []string{"localhost:1080"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward()
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("connects to the requested address through the app instance", func() {
+			conn, err := net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+			methodReply := make([]byte, 2)
+			_, err = io.ReadFull(conn, methodReply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(methodReply).To(Equal([]byte{0x05, 0x00}))
+
+			port := echoListener.Addr().(*net.TCPAddr).Port
+			_, err = conn.Write([]byte{0x05, 0x01, 0x00, 0x01, 127, 0, 0, 1, byte(port >> 8), byte(port)})
+			Expect(err).NotTo(HaveOccurred())
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x00}))
+
+			_, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			expectEcho(conn, "Hello through the proxy\n")
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				localListener.Close()
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
+	Describe("RunCommand", func() {
+		var (
+			opts           *options.SSHOptions
+			stdout, stderr *bytes.Buffer
+			exitStatus     int
+			runErr         error
+		)
+
+		BeforeEach(func() {
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				Command: []string{"some-command", "some-args"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some-stdout"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("some-stderr"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			exitStatus, runErr = secureShell.RunCommand(stdout, stderr)
+		})
+
+		It("starts the command without requesting a pty and copies its output", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(exitStatus).To(Equal(0))
+
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("some-command some-args"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+
+			Expect(stdout.String()).To(Equal("some-stdout"))
+			Expect(stderr.String()).To(Equal("some-stderr"))
+		})
+
+		Context("when the command exits non-zero", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(fakeExitError{status: 3})
+			})
+
+			It("returns the exit status", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Expect(exitStatus).To(Equal(3))
+			})
+		})
+
+		Context("when the session ends without an exit status", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("some-wait-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("some-wait-error"))
+			})
+		})
+
+		Context("when starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("some-start-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("some-start-error"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
@@ -1264,3 +1504,38 @@ var _ = Describe("SSH", func() {
 		})
 	})
 })
+
+func serveEcho(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			_, _ = io.Copy(conn, conn)
+			_ = conn.Close()
+		}()
+	}
+}
+
+func expectEcho(conn net.Conn, msg string) {
+	_, err := conn.Write([]byte(msg))
+	Expect(err).NotTo(HaveOccurred())
+
+	response := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, response)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(response)).To(Equal(msg))
+}
+
+type fakeExitError struct {
+	status int
+}
+
+func (e fakeExitError) Error() string {
+	return "some-exit-error"
+}
+
+func (e fakeExitError) ExitStatus() int {
+	return e.status
+}
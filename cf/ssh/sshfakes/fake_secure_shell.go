@@ -2,6 +2,7 @@
 package sshfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/ssh"
@@ -23,12 +24,34 @@ type FakeSecureShell struct {
 	interactiveSessionReturns     struct {
 		result1 error
 	}
+	RunCommandStub        func(stdout io.Writer, stderr io.Writer) (int, error)
+	runCommandMutex       sync.RWMutex
+	runCommandArgsForCall []struct {
+		stdout io.Writer
+		stderr io.Writer
+	}
+	runCommandReturns struct {
+		result1 int
+		result2 error
+	}
 	LocalPortForwardStub        func() error
 	localPortForwardMutex       sync.RWMutex
 	localPortForwardArgsForCall []struct{}
 	localPortForwardReturns     struct {
 		result1 error
 	}
+	RemotePortForwardStub        func() error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct{}
+	remotePortForwardReturns     struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func() error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct{}
+	dynamicPortForwardReturns     struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
@@ -93,6 +116,8 @@ func (fake *FakeSecureShell) InteractiveSession() error {
 func (fake *FakeSecureShell) InteractiveSessionCallCount() int {
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
 	return len(fake.interactiveSessionArgsForCall)
 }
 
@@ -103,6 +128,41 @@ func (fake *FakeSecureShell) InteractiveSessionReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) RunCommand(stdout io.Writer, stderr io.Writer) (int, error) {
+	fake.runCommandMutex.Lock()
+	fake.runCommandArgsForCall = append(fake.runCommandArgsForCall, struct {
+		stdout io.Writer
+		stderr io.Writer
+	}{stdout, stderr})
+	fake.recordInvocation("RunCommand", []interface{}{stdout, stderr})
+	fake.runCommandMutex.Unlock()
+	if fake.RunCommandStub != nil {
+		return fake.RunCommandStub(stdout, stderr)
+	} else {
+		return fake.runCommandReturns.result1, fake.runCommandReturns.result2
+	}
+}
+
+func (fake *FakeSecureShell) RunCommandCallCount() int {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return len(fake.runCommandArgsForCall)
+}
+
+func (fake *FakeSecureShell) RunCommandArgsForCall(i int) (io.Writer, io.Writer) {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return fake.runCommandArgsForCall[i].stdout, fake.runCommandArgsForCall[i].stderr
+}
+
+func (fake *FakeSecureShell) RunCommandReturns(result1 int, result2 error) {
+	fake.RunCommandStub = nil
+	fake.runCommandReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureShell) LocalPortForward() error {
 	fake.localPortForwardMutex.Lock()
 	fake.localPortForwardArgsForCall = append(fake.localPortForwardArgsForCall, struct{}{})
@@ -128,6 +188,56 @@ func (fake *FakeSecureShell) LocalPortForwardReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) RemotePortForward() error {
+	fake.remotePortForwardMutex.Lock()
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("RemotePortForward", []interface{}{})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub()
+	} else {
+		return fake.remotePortForwardReturns.result1
+	}
+}
+
+func (fake *FakeSecureShell) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForward() error {
+	fake.dynamicPortForwardMutex.Lock()
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("DynamicPortForward", []interface{}{})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub()
+	} else {
+		return fake.dynamicPortForwardReturns.result1
+	}
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturns(result1 error) {
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Wait() error {
 	fake.waitMutex.Lock()
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct{}{})
@@ -187,6 +297,10 @@ func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/backoff"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -24,11 +25,12 @@ type FakeConfig struct {
 	addPluginArgsForCall []struct {
 		arg1 configv3.Plugin
 	}
-	AddPluginRepositoryStub        func(name string, url string)
+	AddPluginRepositoryStub        func(name string, url string, publicKey string)
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
-		name string
-		url  string
+		name      string
+		url       string
+		publicKey string
 	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
@@ -66,6 +68,15 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CurrentProfileStub        func() string
+	currentProfileMutex       sync.RWMutex
+	currentProfileArgsForCall []struct{}
+	currentProfileReturns     struct {
+		result1 string
+	}
+	currentProfileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct{}
@@ -77,6 +88,22 @@ type FakeConfig struct {
 		result1 configv3.User
 		result2 error
 	}
+	CurrentUserNameStub        func() (string, error)
+	currentUserNameMutex       sync.RWMutex
+	currentUserNameArgsForCall []struct{}
+	currentUserNameReturns     struct {
+		result1 string
+		result2 error
+	}
+	currentUserNameReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DeleteProfileStub        func(name string)
+	deleteProfileMutex       sync.RWMutex
+	deleteProfileArgsForCall []struct {
+		name string
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct{}
@@ -166,6 +193,15 @@ type FakeConfig struct {
 	minCLIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NoCacheStub        func() bool
+	noCacheMutex       sync.RWMutex
+	noCacheArgsForCall []struct{}
+	noCacheReturns     struct {
+		result1 bool
+	}
+	noCacheReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	NOAARequestRetryCountStub        func() int
 	nOAARequestRetryCountMutex       sync.RWMutex
 	nOAARequestRetryCountArgsForCall []struct{}
@@ -184,6 +220,15 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PaginationConcurrencyStub        func() int
+	paginationConcurrencyMutex       sync.RWMutex
+	paginationConcurrencyArgsForCall []struct{}
+	paginationConcurrencyReturns     struct {
+		result1 int
+	}
+	paginationConcurrencyReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct{}
@@ -220,6 +265,15 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	ProfilesStub        func() []configv3.Profile
+	profilesMutex       sync.RWMutex
+	profilesArgsForCall []struct{}
+	profilesReturns     struct {
+		result1 []configv3.Profile
+	}
+	profilesReturnsOnCall map[int]struct {
+		result1 []configv3.Profile
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -234,6 +288,21 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RenameProfileStub        func(oldName string, newName string)
+	renameProfileMutex       sync.RWMutex
+	renameProfileArgsForCall []struct {
+		oldName string
+		newName string
+	}
+	RequestRetryBackoffStub        func() backoff.Backoff
+	requestRetryBackoffMutex       sync.RWMutex
+	requestRetryBackoffArgsForCall []struct{}
+	requestRetryBackoffReturns     struct {
+		result1 backoff.Backoff
+	}
+	requestRetryBackoffReturnsOnCall map[int]struct {
+		result1 backoff.Backoff
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct{}
@@ -243,11 +312,25 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ResponseCacheDirStub        func() string
+	responseCacheDirMutex       sync.RWMutex
+	responseCacheDirArgsForCall []struct{}
+	responseCacheDirReturns     struct {
+		result1 string
+	}
+	responseCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
 		token string
 	}
+	SetCurrentProfileStub        func(name string)
+	setCurrentProfileMutex       sync.RWMutex
+	setCurrentProfileArgsForCall []struct {
+		name string
+	}
 	SetOrganizationInformationStub        func(guid string, name string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
@@ -363,6 +446,24 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TraceFormatStub        func() configv3.TraceFormat
+	traceFormatMutex       sync.RWMutex
+	traceFormatArgsForCall []struct{}
+	traceFormatReturns     struct {
+		result1 configv3.TraceFormat
+	}
+	traceFormatReturnsOnCall map[int]struct {
+		result1 configv3.TraceFormat
+	}
+	TraceMaxSizeStub        func() int64
+	traceMaxSizeMutex       sync.RWMutex
+	traceMaxSizeArgsForCall []struct{}
+	traceMaxSizeReturns     struct {
+		result1 int64
+	}
+	traceMaxSizeReturnsOnCall map[int]struct {
+		result1 int64
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct{}
@@ -496,16 +597,17 @@ func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
 	return fake.addPluginArgsForCall[i].arg1
 }
 
-func (fake *FakeConfig) AddPluginRepository(name string, url string) {
+func (fake *FakeConfig) AddPluginRepository(name string, url string, publicKey string) {
 	fake.addPluginRepositoryMutex.Lock()
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
-		name string
-		url  string
-	}{name, url})
-	fake.recordInvocation("AddPluginRepository", []interface{}{name, url})
+		name      string
+		url       string
+		publicKey string
+	}{name, url, publicKey})
+	fake.recordInvocation("AddPluginRepository", []interface{}{name, url, publicKey})
 	fake.addPluginRepositoryMutex.Unlock()
 	if fake.AddPluginRepositoryStub != nil {
-		fake.AddPluginRepositoryStub(name, url)
+		fake.AddPluginRepositoryStub(name, url, publicKey)
 	}
 }
 
@@ -515,10 +617,10 @@ func (fake *FakeConfig) AddPluginRepositoryCallCount() int {
 	return len(fake.addPluginRepositoryArgsForCall)
 }
 
-func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
+func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string, string) {
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
-	return fake.addPluginRepositoryArgsForCall[i].name, fake.addPluginRepositoryArgsForCall[i].url
+	return fake.addPluginRepositoryArgsForCall[i].name, fake.addPluginRepositoryArgsForCall[i].url, fake.addPluginRepositoryArgsForCall[i].publicKey
 }
 
 func (fake *FakeConfig) APIVersion() string {
@@ -681,6 +783,46 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CurrentProfile() string {
+	fake.currentProfileMutex.Lock()
+	ret, specificReturn := fake.currentProfileReturnsOnCall[len(fake.currentProfileArgsForCall)]
+	fake.currentProfileArgsForCall = append(fake.currentProfileArgsForCall, struct{}{})
+	fake.recordInvocation("CurrentProfile", []interface{}{})
+	fake.currentProfileMutex.Unlock()
+	if fake.CurrentProfileStub != nil {
+		return fake.CurrentProfileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.currentProfileReturns.result1
+}
+
+func (fake *FakeConfig) CurrentProfileCallCount() int {
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
+	return len(fake.currentProfileArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentProfileReturns(result1 string) {
+	fake.CurrentProfileStub = nil
+	fake.currentProfileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentProfileReturnsOnCall(i int, result1 string) {
+	fake.CurrentProfileStub = nil
+	if fake.currentProfileReturnsOnCall == nil {
+		fake.currentProfileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentProfileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -724,6 +866,73 @@ func (fake *FakeConfig) CurrentUserReturnsOnCall(i int, result1 configv3.User, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) CurrentUserName() (string, error) {
+	fake.currentUserNameMutex.Lock()
+	ret, specificReturn := fake.currentUserNameReturnsOnCall[len(fake.currentUserNameArgsForCall)]
+	fake.currentUserNameArgsForCall = append(fake.currentUserNameArgsForCall, struct{}{})
+	fake.recordInvocation("CurrentUserName", []interface{}{})
+	fake.currentUserNameMutex.Unlock()
+	if fake.CurrentUserNameStub != nil {
+		return fake.CurrentUserNameStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.currentUserNameReturns.result1, fake.currentUserNameReturns.result2
+}
+
+func (fake *FakeConfig) CurrentUserNameCallCount() int {
+	fake.currentUserNameMutex.RLock()
+	defer fake.currentUserNameMutex.RUnlock()
+	return len(fake.currentUserNameArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentUserNameReturns(result1 string, result2 error) {
+	fake.CurrentUserNameStub = nil
+	fake.currentUserNameReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) CurrentUserNameReturnsOnCall(i int, result1 string, result2 error) {
+	fake.CurrentUserNameStub = nil
+	if fake.currentUserNameReturnsOnCall == nil {
+		fake.currentUserNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.currentUserNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) DeleteProfile(name string) {
+	fake.deleteProfileMutex.Lock()
+	fake.deleteProfileArgsForCall = append(fake.deleteProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("DeleteProfile", []interface{}{name})
+	fake.deleteProfileMutex.Unlock()
+	if fake.DeleteProfileStub != nil {
+		fake.DeleteProfileStub(name)
+	}
+}
+
+func (fake *FakeConfig) DeleteProfileCallCount() int {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return len(fake.deleteProfileArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteProfileArgsForCall(i int) string {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return fake.deleteProfileArgsForCall[i].name
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -1106,6 +1315,46 @@ func (fake *FakeConfig) MinCLIVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) NoCache() bool {
+	fake.noCacheMutex.Lock()
+	ret, specificReturn := fake.noCacheReturnsOnCall[len(fake.noCacheArgsForCall)]
+	fake.noCacheArgsForCall = append(fake.noCacheArgsForCall, struct{}{})
+	fake.recordInvocation("NoCache", []interface{}{})
+	fake.noCacheMutex.Unlock()
+	if fake.NoCacheStub != nil {
+		return fake.NoCacheStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.noCacheReturns.result1
+}
+
+func (fake *FakeConfig) NoCacheCallCount() int {
+	fake.noCacheMutex.RLock()
+	defer fake.noCacheMutex.RUnlock()
+	return len(fake.noCacheArgsForCall)
+}
+
+func (fake *FakeConfig) NoCacheReturns(result1 bool) {
+	fake.NoCacheStub = nil
+	fake.noCacheReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) NoCacheReturnsOnCall(i int, result1 bool) {
+	fake.NoCacheStub = nil
+	if fake.noCacheReturnsOnCall == nil {
+		fake.noCacheReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.noCacheReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) NOAARequestRetryCount() int {
 	fake.nOAARequestRetryCountMutex.Lock()
 	ret, specificReturn := fake.nOAARequestRetryCountReturnsOnCall[len(fake.nOAARequestRetryCountArgsForCall)]
@@ -1186,6 +1435,46 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) PaginationConcurrency() int {
+	fake.paginationConcurrencyMutex.Lock()
+	ret, specificReturn := fake.paginationConcurrencyReturnsOnCall[len(fake.paginationConcurrencyArgsForCall)]
+	fake.paginationConcurrencyArgsForCall = append(fake.paginationConcurrencyArgsForCall, struct{}{})
+	fake.recordInvocation("PaginationConcurrency", []interface{}{})
+	fake.paginationConcurrencyMutex.Unlock()
+	if fake.PaginationConcurrencyStub != nil {
+		return fake.PaginationConcurrencyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.paginationConcurrencyReturns.result1
+}
+
+func (fake *FakeConfig) PaginationConcurrencyCallCount() int {
+	fake.paginationConcurrencyMutex.RLock()
+	defer fake.paginationConcurrencyMutex.RUnlock()
+	return len(fake.paginationConcurrencyArgsForCall)
+}
+
+func (fake *FakeConfig) PaginationConcurrencyReturns(result1 int) {
+	fake.PaginationConcurrencyStub = nil
+	fake.paginationConcurrencyReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PaginationConcurrencyReturnsOnCall(i int, result1 int) {
+	fake.PaginationConcurrencyStub = nil
+	if fake.paginationConcurrencyReturnsOnCall == nil {
+		fake.paginationConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.paginationConcurrencyReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -1346,6 +1635,46 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) Profiles() []configv3.Profile {
+	fake.profilesMutex.Lock()
+	ret, specificReturn := fake.profilesReturnsOnCall[len(fake.profilesArgsForCall)]
+	fake.profilesArgsForCall = append(fake.profilesArgsForCall, struct{}{})
+	fake.recordInvocation("Profiles", []interface{}{})
+	fake.profilesMutex.Unlock()
+	if fake.ProfilesStub != nil {
+		return fake.ProfilesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.profilesReturns.result1
+}
+
+func (fake *FakeConfig) ProfilesCallCount() int {
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
+	return len(fake.profilesArgsForCall)
+}
+
+func (fake *FakeConfig) ProfilesReturns(result1 []configv3.Profile) {
+	fake.ProfilesStub = nil
+	fake.profilesReturns = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfilesReturnsOnCall(i int, result1 []configv3.Profile) {
+	fake.ProfilesStub = nil
+	if fake.profilesReturnsOnCall == nil {
+		fake.profilesReturnsOnCall = make(map[int]struct {
+			result1 []configv3.Profile
+		})
+	}
+	fake.profilesReturnsOnCall[i] = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -1410,6 +1739,71 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RenameProfile(oldName string, newName string) {
+	fake.renameProfileMutex.Lock()
+	fake.renameProfileArgsForCall = append(fake.renameProfileArgsForCall, struct {
+		oldName string
+		newName string
+	}{oldName, newName})
+	fake.recordInvocation("RenameProfile", []interface{}{oldName, newName})
+	fake.renameProfileMutex.Unlock()
+	if fake.RenameProfileStub != nil {
+		fake.RenameProfileStub(oldName, newName)
+	}
+}
+
+func (fake *FakeConfig) RenameProfileCallCount() int {
+	fake.renameProfileMutex.RLock()
+	defer fake.renameProfileMutex.RUnlock()
+	return len(fake.renameProfileArgsForCall)
+}
+
+func (fake *FakeConfig) RenameProfileArgsForCall(i int) (string, string) {
+	fake.renameProfileMutex.RLock()
+	defer fake.renameProfileMutex.RUnlock()
+	return fake.renameProfileArgsForCall[i].oldName, fake.renameProfileArgsForCall[i].newName
+}
+
+func (fake *FakeConfig) RequestRetryBackoff() backoff.Backoff {
+	fake.requestRetryBackoffMutex.Lock()
+	ret, specificReturn := fake.requestRetryBackoffReturnsOnCall[len(fake.requestRetryBackoffArgsForCall)]
+	fake.requestRetryBackoffArgsForCall = append(fake.requestRetryBackoffArgsForCall, struct{}{})
+	fake.recordInvocation("RequestRetryBackoff", []interface{}{})
+	fake.requestRetryBackoffMutex.Unlock()
+	if fake.RequestRetryBackoffStub != nil {
+		return fake.RequestRetryBackoffStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestRetryBackoffReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryBackoffCallCount() int {
+	fake.requestRetryBackoffMutex.RLock()
+	defer fake.requestRetryBackoffMutex.RUnlock()
+	return len(fake.requestRetryBackoffArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryBackoffReturns(result1 backoff.Backoff) {
+	fake.RequestRetryBackoffStub = nil
+	fake.requestRetryBackoffReturns = struct {
+		result1 backoff.Backoff
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryBackoffReturnsOnCall(i int, result1 backoff.Backoff) {
+	fake.RequestRetryBackoffStub = nil
+	if fake.requestRetryBackoffReturnsOnCall == nil {
+		fake.requestRetryBackoffReturnsOnCall = make(map[int]struct {
+			result1 backoff.Backoff
+		})
+	}
+	fake.requestRetryBackoffReturnsOnCall[i] = struct {
+		result1 backoff.Backoff
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -1450,6 +1844,46 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ResponseCacheDir() string {
+	fake.responseCacheDirMutex.Lock()
+	ret, specificReturn := fake.responseCacheDirReturnsOnCall[len(fake.responseCacheDirArgsForCall)]
+	fake.responseCacheDirArgsForCall = append(fake.responseCacheDirArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheDir", []interface{}{})
+	fake.responseCacheDirMutex.Unlock()
+	if fake.ResponseCacheDirStub != nil {
+		return fake.ResponseCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheDirReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheDirCallCount() int {
+	fake.responseCacheDirMutex.RLock()
+	defer fake.responseCacheDirMutex.RUnlock()
+	return len(fake.responseCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheDirReturns(result1 string) {
+	fake.ResponseCacheDirStub = nil
+	fake.responseCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheDirReturnsOnCall(i int, result1 string) {
+	fake.ResponseCacheDirStub = nil
+	if fake.responseCacheDirReturnsOnCall == nil {
+		fake.responseCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.responseCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1474,6 +1908,30 @@ func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
 	return fake.setAccessTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetCurrentProfile(name string) {
+	fake.setCurrentProfileMutex.Lock()
+	fake.setCurrentProfileArgsForCall = append(fake.setCurrentProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("SetCurrentProfile", []interface{}{name})
+	fake.setCurrentProfileMutex.Unlock()
+	if fake.SetCurrentProfileStub != nil {
+		fake.SetCurrentProfileStub(name)
+	}
+}
+
+func (fake *FakeConfig) SetCurrentProfileCallCount() int {
+	fake.setCurrentProfileMutex.RLock()
+	defer fake.setCurrentProfileMutex.RUnlock()
+	return len(fake.setCurrentProfileArgsForCall)
+}
+
+func (fake *FakeConfig) SetCurrentProfileArgsForCall(i int) string {
+	fake.setCurrentProfileMutex.RLock()
+	defer fake.setCurrentProfileMutex.RUnlock()
+	return fake.setCurrentProfileArgsForCall[i].name
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(guid string, name string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -1958,6 +2416,86 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TraceFormat() configv3.TraceFormat {
+	fake.traceFormatMutex.Lock()
+	ret, specificReturn := fake.traceFormatReturnsOnCall[len(fake.traceFormatArgsForCall)]
+	fake.traceFormatArgsForCall = append(fake.traceFormatArgsForCall, struct{}{})
+	fake.recordInvocation("TraceFormat", []interface{}{})
+	fake.traceFormatMutex.Unlock()
+	if fake.TraceFormatStub != nil {
+		return fake.TraceFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.traceFormatReturns.result1
+}
+
+func (fake *FakeConfig) TraceFormatCallCount() int {
+	fake.traceFormatMutex.RLock()
+	defer fake.traceFormatMutex.RUnlock()
+	return len(fake.traceFormatArgsForCall)
+}
+
+func (fake *FakeConfig) TraceFormatReturns(result1 configv3.TraceFormat) {
+	fake.TraceFormatStub = nil
+	fake.traceFormatReturns = struct {
+		result1 configv3.TraceFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceFormatReturnsOnCall(i int, result1 configv3.TraceFormat) {
+	fake.TraceFormatStub = nil
+	if fake.traceFormatReturnsOnCall == nil {
+		fake.traceFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.TraceFormat
+		})
+	}
+	fake.traceFormatReturnsOnCall[i] = struct {
+		result1 configv3.TraceFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceMaxSize() int64 {
+	fake.traceMaxSizeMutex.Lock()
+	ret, specificReturn := fake.traceMaxSizeReturnsOnCall[len(fake.traceMaxSizeArgsForCall)]
+	fake.traceMaxSizeArgsForCall = append(fake.traceMaxSizeArgsForCall, struct{}{})
+	fake.recordInvocation("TraceMaxSize", []interface{}{})
+	fake.traceMaxSizeMutex.Unlock()
+	if fake.TraceMaxSizeStub != nil {
+		return fake.TraceMaxSizeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.traceMaxSizeReturns.result1
+}
+
+func (fake *FakeConfig) TraceMaxSizeCallCount() int {
+	fake.traceMaxSizeMutex.RLock()
+	defer fake.traceMaxSizeMutex.RUnlock()
+	return len(fake.traceMaxSizeArgsForCall)
+}
+
+func (fake *FakeConfig) TraceMaxSizeReturns(result1 int64) {
+	fake.TraceMaxSizeStub = nil
+	fake.traceMaxSizeReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceMaxSizeReturnsOnCall(i int, result1 int64) {
+	fake.TraceMaxSizeStub = nil
+	if fake.traceMaxSizeReturnsOnCall == nil {
+		fake.traceMaxSizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.traceMaxSizeReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -2266,8 +2804,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryVersionMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
+	fake.currentUserNameMutex.RLock()
+	defer fake.currentUserNameMutex.RUnlock()
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
@@ -2286,10 +2830,14 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.localeMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.noCacheMutex.RLock()
+	defer fake.noCacheMutex.RUnlock()
 	fake.nOAARequestRetryCountMutex.RLock()
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.paginationConcurrencyMutex.RLock()
+	defer fake.paginationConcurrencyMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
@@ -2298,14 +2846,24 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.renameProfileMutex.RLock()
+	defer fake.renameProfileMutex.RUnlock()
+	fake.requestRetryBackoffMutex.RLock()
+	defer fake.requestRetryBackoffMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.responseCacheDirMutex.RLock()
+	defer fake.responseCacheDirMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setCurrentProfileMutex.RLock()
+	defer fake.setCurrentProfileMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -2336,6 +2894,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.traceFormatMutex.RLock()
+	defer fake.traceFormatMutex.RUnlock()
+	fake.traceMaxSizeMutex.RLock()
+	defer fake.traceMaxSizeMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
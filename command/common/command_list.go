@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/plugin"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v3"
@@ -11,7 +12,9 @@ import (
 var Commands commandList
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool              `short:"v" long:"version" description:"verbose and version flag"`
+	NoCache          bool              `long:"no-cache" description:"do not use cached Cloud Controller responses"`
+	Output           flag.OutputFormat `long:"output" description:"display output in the given structured format (json or yaml)"`
 
 	V3App                v3.V3AppCommand                `command:"v3-app" description:"Display health and status for an app"`
 	V3Apps               v3.V3AppsCommand               `command:"v3-apps" description:"List all apps in the target space"`
@@ -106,6 +109,7 @@ type commandList struct {
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
+	Labels                             v3.LabelsCommand                             `command:"labels" description:"List all labels (key-value pairs) for an API resource"`
 	NetworkPolicies                    v3.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
 	Login                              v2.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
@@ -120,6 +124,9 @@ type commandList struct {
 	Org                                v2.OrgCommand                                `command:"org" description:"Show org info"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	ProfileDelete                      v2.ProfileDeleteCommand                      `command:"profile-delete" description:"Delete a profile"`
+	ProfileRename                      v2.ProfileRenameCommand                      `command:"profile-rename" description:"Rename a profile"`
+	Profiles                           v2.ProfilesCommand                           `command:"profiles" description:"List profiles and the endpoint, user, org and space each one targets"`
 	PurgeServiceInstance               v2.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v2.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v2.V2PushCommand                             `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
@@ -156,6 +163,7 @@ type commandList struct {
 	Service                            v2.ServiceCommand                            `command:"service" description:"Show service instance info"`
 	SetEnv                             v2.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
 	SetHealthCheck                     v2.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app"`
+	SetLabel                           v3.SetLabelCommand                           `command:"set-label" description:"Set a label (key-value pairs) for an API resource"`
 	SetOrgDefaultIsolationSegment      v3.SetOrgDefaultIsolationSegmentCommand      `command:"set-org-default-isolation-segment" description:"Set the default isolation segment used for apps in spaces in an org"`
 	SetOrgRole                         v2.SetOrgRoleCommand                         `command:"set-org-role" description:"Assign an org role to a user"`
 	SetQuota                           v2.SetQuotaCommand                           `command:"set-quota" description:"Assign a quota to an org"`
@@ -173,6 +181,7 @@ type commandList struct {
 	SpaceUsers                         v2.SpaceUsersCommand                         `command:"space-users" description:"Show space users by role"`
 	Space                              v2.SpaceCommand                              `command:"space" description:"Show space info"`
 	SSHCode                            v2.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
+	SSHCopy                            v3.SSHCopyCommand                            `command:"ssh-copy" description:"Copy files to or from an application container instance"`
 	SSHEnabled                         v2.SSHEnabledCommand                         `command:"ssh-enabled" description:"Reports whether SSH is enabled on an application container instance"`
 	SSH                                v2.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	Stacks                             v2.StacksCommand                             `command:"stacks" description:"List all stacks (a stack is a pre-built file system, including an operating system, that can run apps)"`
@@ -192,12 +201,14 @@ type commandList struct {
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v2.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a url route from an app"`
 	UnsetEnv                           v2.UnsetEnvCommand                           `command:"unset-env" description:"Remove an env variable"`
+	UnsetLabel                         v3.UnsetLabelCommand                         `command:"unset-label" description:"Unset a label (key-value pairs) for an API resource"`
 	UnsetOrgRole                       v2.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
 	UnsetSpaceQuota                    v2.UnsetSpaceQuotaCommand                    `command:"unset-space-quota" description:"Unassign a quota from a space"`
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
 	UnsharePrivateDomain               v2.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
 	UnshareService                     v3.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
 	UpdateBuildpack                    v2.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
+	UpdatePlugins                      UpdatePluginsCommand                         `command:"update-plugins" description:"Update installed CLI plugins to the latest versions in registered repositories"`
 	UpdateQuota                        v2.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
 	UpdateSecurityGroup                v2.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateServiceAuthToken             v2.UpdateServiceAuthTokenCommand             `command:"update-service-auth-token" description:"Update a service auth token"`
@@ -132,17 +132,18 @@ type FakeInstallPluginActor struct {
 	uninstallPluginReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ValidateFileChecksumStub        func(path string, checksum string) bool
-	validateFileChecksumMutex       sync.RWMutex
-	validateFileChecksumArgsForCall []struct {
-		path     string
-		checksum string
-	}
-	validateFileChecksumReturns struct {
-		result1 bool
+	VerifyPluginBinaryStub        func(path string, pluginInfo pluginaction.PluginInfo, requireSignature bool) error
+	verifyPluginBinaryMutex       sync.RWMutex
+	verifyPluginBinaryArgsForCall []struct {
+		path             string
+		pluginInfo       pluginaction.PluginInfo
+		requireSignature bool
+	}
+	verifyPluginBinaryReturns struct {
+		result1 error
 	}
-	validateFileChecksumReturnsOnCall map[int]struct {
-		result1 bool
+	verifyPluginBinaryReturnsOnCall map[int]struct {
+		result1 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -613,52 +614,53 @@ func (fake *FakeInstallPluginActor) UninstallPluginReturnsOnCall(i int, result1
 	}{result1}
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksum(path string, checksum string) bool {
-	fake.validateFileChecksumMutex.Lock()
-	ret, specificReturn := fake.validateFileChecksumReturnsOnCall[len(fake.validateFileChecksumArgsForCall)]
-	fake.validateFileChecksumArgsForCall = append(fake.validateFileChecksumArgsForCall, struct {
-		path     string
-		checksum string
-	}{path, checksum})
-	fake.recordInvocation("ValidateFileChecksum", []interface{}{path, checksum})
-	fake.validateFileChecksumMutex.Unlock()
-	if fake.ValidateFileChecksumStub != nil {
-		return fake.ValidateFileChecksumStub(path, checksum)
+func (fake *FakeInstallPluginActor) VerifyPluginBinary(path string, pluginInfo pluginaction.PluginInfo, requireSignature bool) error {
+	fake.verifyPluginBinaryMutex.Lock()
+	ret, specificReturn := fake.verifyPluginBinaryReturnsOnCall[len(fake.verifyPluginBinaryArgsForCall)]
+	fake.verifyPluginBinaryArgsForCall = append(fake.verifyPluginBinaryArgsForCall, struct {
+		path             string
+		pluginInfo       pluginaction.PluginInfo
+		requireSignature bool
+	}{path, pluginInfo, requireSignature})
+	fake.recordInvocation("VerifyPluginBinary", []interface{}{path, pluginInfo, requireSignature})
+	fake.verifyPluginBinaryMutex.Unlock()
+	if fake.VerifyPluginBinaryStub != nil {
+		return fake.VerifyPluginBinaryStub(path, pluginInfo, requireSignature)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	return fake.validateFileChecksumReturns.result1
+	return fake.verifyPluginBinaryReturns.result1
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksumCallCount() int {
-	fake.validateFileChecksumMutex.RLock()
-	defer fake.validateFileChecksumMutex.RUnlock()
-	return len(fake.validateFileChecksumArgsForCall)
+func (fake *FakeInstallPluginActor) VerifyPluginBinaryCallCount() int {
+	fake.verifyPluginBinaryMutex.RLock()
+	defer fake.verifyPluginBinaryMutex.RUnlock()
+	return len(fake.verifyPluginBinaryArgsForCall)
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksumArgsForCall(i int) (string, string) {
-	fake.validateFileChecksumMutex.RLock()
-	defer fake.validateFileChecksumMutex.RUnlock()
-	return fake.validateFileChecksumArgsForCall[i].path, fake.validateFileChecksumArgsForCall[i].checksum
+func (fake *FakeInstallPluginActor) VerifyPluginBinaryArgsForCall(i int) (string, pluginaction.PluginInfo, bool) {
+	fake.verifyPluginBinaryMutex.RLock()
+	defer fake.verifyPluginBinaryMutex.RUnlock()
+	return fake.verifyPluginBinaryArgsForCall[i].path, fake.verifyPluginBinaryArgsForCall[i].pluginInfo, fake.verifyPluginBinaryArgsForCall[i].requireSignature
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksumReturns(result1 bool) {
-	fake.ValidateFileChecksumStub = nil
-	fake.validateFileChecksumReturns = struct {
-		result1 bool
+func (fake *FakeInstallPluginActor) VerifyPluginBinaryReturns(result1 error) {
+	fake.VerifyPluginBinaryStub = nil
+	fake.verifyPluginBinaryReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, result1 bool) {
-	fake.ValidateFileChecksumStub = nil
-	if fake.validateFileChecksumReturnsOnCall == nil {
-		fake.validateFileChecksumReturnsOnCall = make(map[int]struct {
-			result1 bool
+func (fake *FakeInstallPluginActor) VerifyPluginBinaryReturnsOnCall(i int, result1 error) {
+	fake.VerifyPluginBinaryStub = nil
+	if fake.verifyPluginBinaryReturnsOnCall == nil {
+		fake.verifyPluginBinaryReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.validateFileChecksumReturnsOnCall[i] = struct {
-		result1 bool
+	fake.verifyPluginBinaryReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
@@ -683,8 +685,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.installPluginFromPathMutex.RUnlock()
 	fake.uninstallPluginMutex.RLock()
 	defer fake.uninstallPluginMutex.RUnlock()
-	fake.validateFileChecksumMutex.RLock()
-	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.verifyPluginBinaryMutex.RLock()
+	defer fake.verifyPluginBinaryMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,483 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	v2shared "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/util/completion"
+	"code.cloudfoundry.org/cli/util/configv3"
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	// completionCacheTTL is how long looked up names are reused for.
+	completionCacheTTL = time.Minute
+
+	// completionLookupTimeout is how long to wait on the API, for example when
+	// offline, before completing without names.
+	completionLookupTimeout = 3 * time.Second
+)
+
+//go:generate counterfeiter . CompleteActor
+
+// CompleteActor looks up the names that are completed from the current
+// target.
+type CompleteActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . NameCache
+
+// NameCache stores the looked up names between completions.
+type NameCache interface {
+	Get(key string) ([]string, bool)
+	Set(key string, names []string) error
+}
+
+//go:generate counterfeiter . PluginCompleter
+
+// PluginCompleter runs a plugin's Completer.
+type PluginCompleter interface {
+	GetCompletions(path string, args []string) ([]string, error)
+}
+
+// CompleteCommand prints the shell completions for a partially typed command
+// line, one per line. The completion scripts run it as `cf __complete
+// WORD...`, where the last word is the one being completed. It is run from
+// main instead of being listed in Commands so that the words are not parsed
+// as its own flags.
+//
+// Errors are not displayed; anything that cannot be completed is left out.
+type CompleteCommand struct {
+	UI              command.UI
+	Config          command.Config
+	Actor           CompleteActor
+	PluginCompleter PluginCompleter
+	Cache           NameCache
+	LookupTimeout   time.Duration
+}
+
+// Setup does not create the actor or the plugin completer. They are only
+// created when they are needed, so that completing command and flag names
+// does not reach the API.
+func (cmd *CompleteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Cache = completion.NewCache(filepath.Join(filepath.Dir(configv3.ConfigFilePath()), "completion"), completionCacheTTL)
+	cmd.LookupTimeout = completionLookupTimeout
+
+	return nil
+}
+
+func (cmd CompleteCommand) Execute(args []string) error {
+	if len(args) == 0 {
+		args = []string{""}
+	}
+
+	var completions []string
+	if len(args) == 1 {
+		completions = cmd.completeCommandName(args[0])
+	} else if pluginInfo, pluginCommand, found := cmd.findPluginCommand(args[0]); found {
+		completions = cmd.completePluginCommand(pluginInfo, pluginCommand, args[1:])
+	} else {
+		completions = cmd.completeCoreCommand(args)
+	}
+
+	for _, completion := range completions {
+		fmt.Fprintln(cmd.UI.Writer(), completion)
+	}
+
+	return nil
+}
+
+func (cmd CompleteCommand) completeCommandName(prefix string) []string {
+	var names []string
+	for _, info := range (sharedaction.Actor{}).CommandInfos(Commands) {
+		names = append(names, info.Name, info.Alias)
+	}
+	for _, installedPlugin := range cmd.Config.Plugins() {
+		for _, pluginCommand := range installedPlugin.Commands {
+			names = append(names, pluginCommand.Name, pluginCommand.Alias)
+		}
+	}
+
+	return filterCompletions(names, prefix)
+}
+
+// completeCoreCommand uses the go-flags completion of the core commands.
+func (cmd CompleteCommand) completeCoreCommand(args []string) []string {
+	var completions []string
+
+	parser := flags.NewParser(&Commands, flags.HelpFlag)
+	parser.CompletionHandler = func(items []flags.Completion) {
+		for _, item := range items {
+			completions = append(completions, item.Item)
+		}
+	}
+
+	os.Setenv("GO_FLAGS_COMPLETION", "1")
+	defer os.Unsetenv("GO_FLAGS_COMPLETION")
+	_, _ = parser.ParseArgs(args)
+
+	if len(completions) == 0 {
+		completions = cmd.completeCorePositional(parser, args)
+	}
+	return completions
+}
+
+// completeCorePositional completes the first positional argument of a core
+// command whose positional arguments type is a flag.PositionalNameCompleter,
+// such as flag.AppName, with the names in the current target.
+func (cmd CompleteCommand) completeCorePositional(parser *flags.Parser, args []string) []string {
+	prefix := args[len(args)-1]
+	if strings.HasPrefix(prefix, "-") {
+		return nil
+	}
+
+	active := parser.Find(args[0])
+	if active == nil {
+		return nil
+	}
+
+	var (
+		position  int
+		skipValue bool
+	)
+	for _, word := range args[1 : len(args)-1] {
+		switch {
+		case skipValue:
+			skipValue = false
+		case len(word) > 1 && strings.HasPrefix(word, "-"):
+			skipValue = optionTakesValue(active, word)
+		default:
+			position++
+		}
+	}
+	if position != 0 {
+		return nil
+	}
+
+	completer, ok := positionalCompleter(active.Name)
+	if !ok {
+		return nil
+	}
+
+	return completer.Complete(prefix, cmd.completeNames)
+}
+
+// optionTakesValue returns true if the word is an option that is followed by
+// its value.
+func optionTakesValue(active *flags.Command, word string) bool {
+	if strings.Contains(word, "=") {
+		return false
+	}
+
+	var option *flags.Option
+	if strings.HasPrefix(word, "--") {
+		option = active.FindOptionByLongName(strings.TrimPrefix(word, "--"))
+	} else if len(word) == 2 {
+		option = active.FindOptionByShortName(rune(word[1]))
+	}
+	if option == nil {
+		return false
+	}
+
+	valueType := option.Field().Type
+	if valueType.Kind() == reflect.Slice {
+		valueType = valueType.Elem()
+	}
+	return valueType.Kind() != reflect.Bool
+}
+
+// positionalCompleter returns the positional arguments type of the command
+// if it is a flag.PositionalNameCompleter.
+func positionalCompleter(commandName string) (flag.PositionalNameCompleter, bool) {
+	commandList := reflect.TypeOf(Commands)
+	for i := 0; i < commandList.NumField(); i++ {
+		if commandList.Field(i).Tag.Get("command") != commandName {
+			continue
+		}
+
+		commandType := commandList.Field(i).Type
+		for j := 0; j < commandType.NumField(); j++ {
+			field := commandType.Field(j)
+			if field.Tag.Get("positional-args") != "" {
+				completer, ok := reflect.Zero(field.Type).Interface().(flag.PositionalNameCompleter)
+				return completer, ok
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (cmd CompleteCommand) findPluginCommand(name string) (configv3.Plugin, configv3.PluginCommand, bool) {
+	for _, installedPlugin := range cmd.Config.Plugins() {
+		for _, pluginCommand := range installedPlugin.Commands {
+			if pluginCommand.Name == name || (pluginCommand.Alias != "" && pluginCommand.Alias == name) {
+				return installedPlugin, pluginCommand, true
+			}
+		}
+	}
+
+	return configv3.Plugin{}, configv3.PluginCommand{}, false
+}
+
+// completePluginCommand completes the last word using the flags and
+// arguments declared by the plugin command.
+func (cmd CompleteCommand) completePluginCommand(pluginInfo configv3.Plugin, pluginCommand configv3.PluginCommand, words []string) []string {
+	prefix := words[len(words)-1]
+
+	var (
+		valueFlag *configv3.PluginFlag
+		position  int
+	)
+	for _, word := range words[:len(words)-1] {
+		if valueFlag != nil {
+			valueFlag = nil
+			continue
+		}
+
+		if len(word) > 1 && strings.HasPrefix(word, "-") {
+			if pluginFlag, found := findPluginFlag(pluginCommand.Flags, word); found && pluginFlag.TakesValue() && !strings.Contains(word, "=") {
+				valueFlag = &pluginFlag
+			}
+			continue
+		}
+
+		position++
+	}
+
+	switch {
+	case valueFlag != nil:
+		return cmd.completeValue(pluginInfo, pluginCommand, words, valueFlag.Completion, valueFlag.Choices)
+	case strings.HasPrefix(prefix, "-"):
+		return completePluginFlagNames(pluginCommand, prefix)
+	case position < len(pluginCommand.Arguments):
+		argument := pluginCommand.Arguments[position]
+		return cmd.completeValue(pluginInfo, pluginCommand, words, argument.Completion, argument.Choices)
+	}
+
+	return nil
+}
+
+func (cmd CompleteCommand) completeValue(pluginInfo configv3.Plugin, pluginCommand configv3.PluginCommand, words []string, completion string, choices []string) []string {
+	prefix := words[len(words)-1]
+
+	switch plugin.CompletionType(completion) {
+	case plugin.CompletionNone:
+		return filterCompletions(choices, prefix)
+	case plugin.CompletionFile:
+		paths, _ := filepath.Glob(prefix + "*")
+		return paths
+	case plugin.CompletionPlugin:
+		completer, err := cmd.pluginCompleter()
+		if err != nil {
+			return nil
+		}
+		completions, err := completer.GetCompletions(pluginInfo.Location, append([]string{pluginCommand.Name}, words...))
+		if err != nil {
+			return nil
+		}
+		return filterCompletions(completions, prefix)
+	default:
+		return cmd.completeNames(flag.NameType(completion), prefix)
+	}
+}
+
+// completeNames returns the names of the resources of the given type in the
+// current target that start with prefix.
+func (cmd CompleteCommand) completeNames(nameType flag.NameType, prefix string) []string {
+	return filterCompletions(cmd.targetNames(nameType), prefix)
+}
+
+// targetNames returns the names of the apps, service instances, orgs or
+// spaces in the current target. The names are cached per target, user and
+// space or org, so that completing the same argument again does not wait on
+// the API.
+func (cmd CompleteCommand) targetNames(nameType flag.NameType) []string {
+	var scopeGUID string
+	switch nameType {
+	case flag.AppNameType, flag.ServiceInstanceNameType:
+		if !cmd.Config.HasTargetedSpace() {
+			return nil
+		}
+		scopeGUID = cmd.Config.TargetedSpace().GUID
+	case flag.SpaceNameType:
+		if !cmd.Config.HasTargetedOrganization() {
+			return nil
+		}
+		scopeGUID = cmd.Config.TargetedOrganization().GUID
+	case flag.OrgNameType:
+	default:
+		return nil
+	}
+
+	user, _ := cmd.Config.CurrentUser()
+	key := strings.Join([]string{cmd.Config.Target(), user.Name, string(nameType), scopeGUID}, " ")
+	if names, found := cmd.Cache.Get(key); found {
+		return names
+	}
+
+	names, ok := cmd.lookupNames(nameType, scopeGUID)
+	if !ok {
+		return nil
+	}
+
+	_ = cmd.Cache.Set(key, names)
+	return names
+}
+
+// lookupNames gets the names through the actor. It gives up after
+// LookupTimeout so that completion does not hang when the API cannot be
+// reached.
+func (cmd CompleteCommand) lookupNames(nameType flag.NameType, scopeGUID string) ([]string, bool) {
+	type lookupResult struct {
+		names []string
+		err   error
+	}
+
+	results := make(chan lookupResult, 1)
+	go func() {
+		names, err := cmd.getNames(nameType, scopeGUID)
+		results <- lookupResult{names: names, err: err}
+	}()
+
+	select {
+	case result := <-results:
+		return result.names, result.err == nil
+	case <-time.After(cmd.LookupTimeout):
+		return nil, false
+	}
+}
+
+func (cmd CompleteCommand) getNames(nameType flag.NameType, scopeGUID string) ([]string, error) {
+	actor, err := cmd.actor()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	switch nameType {
+	case flag.AppNameType:
+		apps, _, err := actor.GetApplicationsBySpace(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+	case flag.ServiceInstanceNameType:
+		serviceInstances, _, err := actor.GetServiceInstancesBySpace(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, serviceInstance := range serviceInstances {
+			names = append(names, serviceInstance.Name)
+		}
+	case flag.OrgNameType:
+		orgs, _, err := actor.GetOrganizations()
+		if err != nil {
+			return nil, err
+		}
+		for _, org := range orgs {
+			names = append(names, org.Name)
+		}
+	case flag.SpaceNameType:
+		spaces, _, err := actor.GetOrganizationSpaces(scopeGUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, space := range spaces {
+			names = append(names, space.Name)
+		}
+	}
+
+	return names, nil
+}
+
+func (cmd CompleteCommand) actor() (CompleteActor, error) {
+	if cmd.Actor != nil {
+		return cmd.Actor, nil
+	}
+
+	ccClient, uaaClient, err := v2shared.NewClients(cmd.Config, cmd.UI, true)
+	if err != nil {
+		return nil, err
+	}
+	return v2action.NewActor(ccClient, uaaClient, cmd.Config), nil
+}
+
+func (cmd CompleteCommand) pluginCompleter() (PluginCompleter, error) {
+	if cmd.PluginCompleter != nil {
+		return cmd.PluginCompleter, nil
+	}
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return nil, err
+	}
+	return rpcService, nil
+}
+
+func findPluginFlag(pluginFlags []configv3.PluginFlag, word string) (configv3.PluginFlag, bool) {
+	name := strings.SplitN(word, "=", 2)[0]
+	for _, pluginFlag := range pluginFlags {
+		if name == "--"+pluginFlag.Name || (pluginFlag.ShortName != "" && name == "-"+pluginFlag.ShortName) {
+			return pluginFlag, true
+		}
+	}
+
+	return configv3.PluginFlag{}, false
+}
+
+func completePluginFlagNames(pluginCommand configv3.PluginCommand, prefix string) []string {
+	var names []string
+	for _, pluginFlag := range pluginCommand.Flags {
+		names = append(names, "--"+pluginFlag.Name)
+		if pluginFlag.ShortName != "" {
+			names = append(names, "-"+pluginFlag.ShortName)
+		}
+	}
+	for option := range pluginCommand.UsageDetails.Options {
+		option = strings.TrimLeft(option, "-")
+		if len(option) == 1 {
+			names = append(names, "-"+option)
+		} else {
+			names = append(names, "--"+option)
+		}
+	}
+
+	return filterCompletions(names, prefix)
+}
+
+// filterCompletions returns the sorted, unique, non-empty items that start
+// with prefix.
+func filterCompletions(items []string, prefix string) []string {
+	var matches []string
+	seen := map[string]bool{}
+	for _, item := range items {
+		if item != "" && !seen[item] && strings.HasPrefix(item, prefix) {
+			seen[item] = true
+			matches = append(matches, item)
+		}
+	}
+	sort.Strings(matches)
+
+	return matches
+}
@@ -0,0 +1,274 @@
+package common_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("__complete command", func() {
+	var (
+		cmd                 CompleteCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeActor           *commonfakes.FakeCompleteActor
+		fakePluginCompleter *commonfakes.FakePluginCompleter
+		fakeCache           *commonfakes.FakeNameCache
+		args                []string
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeCompleteActor)
+		fakePluginCompleter = new(commonfakes.FakePluginCompleter)
+		fakeCache = new(commonfakes.FakeNameCache)
+
+		cmd = CompleteCommand{
+			UI:              testUI,
+			Config:          fakeConfig,
+			Actor:           fakeActor,
+			PluginCompleter: fakePluginCompleter,
+			Cache:           fakeCache,
+			LookupTimeout:   time.Second,
+		}
+
+		fakeConfig.PluginsReturns([]configv3.Plugin{
+			{
+				Name:     "some-plugin",
+				Location: "/plugins/some-plugin",
+				Commands: []configv3.PluginCommand{
+					{
+						Name:  "update-some-thing",
+						Alias: "ust",
+						UsageDetails: configv3.PluginUsageDetails{
+							Options: map[string]string{"v": "verbose", "--quiet": "quiet"},
+						},
+						Flags: []configv3.PluginFlag{
+							{Name: "format", ShortName: "f", Type: "string", Choices: []string{"json", "table"}},
+							{Name: "org", ShortName: "o", Type: "string", Completion: "org"},
+							{Name: "force", Type: "bool"},
+						},
+						Arguments: []configv3.PluginArgument{
+							{Name: "APP_NAME", Completion: "app"},
+							{Name: "THING", Completion: "plugin"},
+						},
+					},
+				},
+			},
+		})
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.HasTargetedSpaceReturns(true)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeConfig.HasTargetedOrganizationReturns(true)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(args)
+	})
+
+	completions := func() []string {
+		Expect(executeErr).ToNot(HaveOccurred())
+		return strings.Split(strings.TrimSuffix(string(testUI.Out.(*Buffer).Contents()), "\n"), "\n")
+	}
+
+	Context("when completing a command name", func() {
+		BeforeEach(func() {
+			args = []string{"update-"}
+		})
+
+		It("displays the core and plugin commands", func() {
+			Expect(completions()).To(ContainElement("update-plugins"))
+			Expect(completions()).To(ContainElement("update-some-thing"))
+			Expect(completions()).ToNot(ContainElement("apps"))
+		})
+	})
+
+	Context("when completing a core command", func() {
+		BeforeEach(func() {
+			args = []string{"update-plugins", "--a"}
+		})
+
+		It("displays the go-flags completions", func() {
+			Expect(completions()).To(Equal([]string{"--all"}))
+		})
+	})
+
+	Context("when completing a core command's positional argument", func() {
+		Context("when the argument is an app name", func() {
+			BeforeEach(func() {
+				args = []string{"delete", "-f", "some-"}
+				fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{
+					{Name: "some-app"},
+					{Name: "other-app"},
+				}, nil, nil)
+			})
+
+			It("displays the apps in the targeted space and caches them", func() {
+				Expect(completions()).To(Equal([]string{"some-app"}))
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+				Expect(fakeCache.GetCallCount()).To(Equal(1))
+				Expect(fakeCache.SetCallCount()).To(Equal(1))
+				key, names := fakeCache.SetArgsForCall(0)
+				Expect(key).To(Equal(fakeCache.GetArgsForCall(0)))
+				Expect(key).To(Equal("https://api.example.com some-user app some-space-guid"))
+				Expect(names).To(Equal([]string{"some-app", "other-app"}))
+			})
+
+			Context("when the names are cached", func() {
+				BeforeEach(func() {
+					fakeCache.GetReturns([]string{"some-cached-app"}, true)
+				})
+
+				It("displays the cached names without looking them up", func() {
+					Expect(completions()).To(Equal([]string{"some-cached-app"}))
+					Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when looking up the names times out", func() {
+				BeforeEach(func() {
+					cmd.LookupTimeout = 10 * time.Millisecond
+					fakeActor.GetApplicationsBySpaceStub = func(string) ([]v2action.Application, v2action.Warnings, error) {
+						time.Sleep(time.Second)
+						return []v2action.Application{{Name: "some-app"}}, nil, nil
+					}
+				})
+
+				It("does not display or cache any completions", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+					Expect(fakeCache.SetCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the argument is a space name", func() {
+			BeforeEach(func() {
+				args = []string{"space", ""}
+				fakeActor.GetOrganizationSpacesReturns([]v2action.Space{{Name: "space-1"}}, nil, nil)
+			})
+
+			It("displays the spaces in the targeted org", func() {
+				Expect(completions()).To(Equal([]string{"space-1"}))
+				Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the argument is not the first positional argument", func() {
+			BeforeEach(func() {
+				args = []string{"delete", "some-app", ""}
+			})
+
+			It("does not look up any names", func() {
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+				Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when completing a plugin command's flags", func() {
+		BeforeEach(func() {
+			args = []string{"ust", "--"}
+		})
+
+		It("displays the declared flags and usage options", func() {
+			Expect(completions()).To(Equal([]string{"--force", "--format", "--org", "--quiet"}))
+		})
+	})
+
+	Context("when completing a plugin flag's value", func() {
+		Context("when the flag has choices", func() {
+			BeforeEach(func() {
+				args = []string{"update-some-thing", "--force", "-f", "j"}
+			})
+
+			It("displays the matching choices", func() {
+				Expect(completions()).To(Equal([]string{"json"}))
+			})
+		})
+
+		Context("when the flag completes org names", func() {
+			BeforeEach(func() {
+				args = []string{"update-some-thing", "--org", ""}
+				fakeActor.GetOrganizationsReturns([]v2action.Organization{{Name: "org-2"}, {Name: "org-1"}}, nil, nil)
+			})
+
+			It("displays the org names", func() {
+				Expect(completions()).To(Equal([]string{"org-1", "org-2"}))
+			})
+		})
+	})
+
+	Context("when completing a plugin command's arguments", func() {
+		Context("when the argument completes app names", func() {
+			BeforeEach(func() {
+				args = []string{"update-some-thing", "-f", "json", "some-"}
+				fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{
+					{Name: "some-app"},
+					{Name: "other-app"},
+				}, v2action.Warnings{"some-warning"}, nil)
+			})
+
+			It("displays the apps in the targeted space", func() {
+				Expect(completions()).To(Equal([]string{"some-app"}))
+				Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(testUI.Err).ToNot(Say("some-warning"))
+			})
+
+			Context("when no space is targeted", func() {
+				BeforeEach(func() {
+					fakeConfig.HasTargetedSpaceReturns(false)
+				})
+
+				It("does not display any completions", func() {
+					Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+					Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the apps errors", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsBySpaceReturns(nil, nil, errors.New("some-error"))
+				})
+
+				It("does not display any completions or the error", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+					Expect(testUI.Err).ToNot(Say("some-error"))
+				})
+			})
+		})
+
+		Context("when the argument is completed by the plugin", func() {
+			BeforeEach(func() {
+				args = []string{"update-some-thing", "some-app", "th"}
+				fakePluginCompleter.GetCompletionsReturns([]string{"thing-1", "thing-2", "other"}, nil)
+			})
+
+			It("displays the plugin's completions", func() {
+				Expect(completions()).To(Equal([]string{"thing-1", "thing-2"}))
+
+				Expect(fakePluginCompleter.GetCompletionsCallCount()).To(Equal(1))
+				path, pluginArgs := fakePluginCompleter.GetCompletionsArgsForCall(0)
+				Expect(path).To(Equal("/plugins/some-plugin"))
+				Expect(pluginArgs).To(Equal([]string{"update-some-thing", "some-app", "th"}))
+			})
+		})
+	})
+})
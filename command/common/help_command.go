@@ -139,7 +139,7 @@ func (cmd HelpCommand) displayHelpFooter(cmdInfo map[string]sharedaction.Command
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("GLOBAL OPTIONS:")
-	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 17)
 
 	cmd.UI.DisplayNewline()
 
@@ -211,7 +211,7 @@ func (cmd HelpCommand) displayCommonCommands() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("Global options:")
-	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 17)
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayText("Use 'cf help -a' to see all commands.")
@@ -309,11 +309,18 @@ func (cmd HelpCommand) displayCommand() error {
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
+		{"CF_CREDENTIAL_HELPER=name", cmd.UI.TranslateText("Store tokens with the cf-credential-name helper, or set to 'file' to keep the credentials key out of the OS keychain")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_PAGINATION_CONCURRENCY=4", cmd.UI.TranslateText("Max number of pages of a list request fetched in parallel")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_PROFILE=name", cmd.UI.TranslateText("Target the named profile for this command only")},
+		{"CF_RETRY_BACKOFF=500ms", cmd.UI.TranslateText("Initial wait time between retries of failed API requests")},
+		{"CF_RETRY_MAX_BACKOFF=10s", cmd.UI.TranslateText("Max wait time between retries of failed API requests")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_FORMAT=jsonl", cmd.UI.TranslateText("Write each request to the log file as a line of JSON")},
+		{"CF_TRACE_MAX_SIZE=10M", cmd.UI.TranslateText("Rotate the log file when it would exceed this size")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
 	}
 }
@@ -321,6 +328,8 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--no-cache", cmd.UI.TranslateText("Do not use cached Cloud Controller responses")},
+		{"--output json|yaml", cmd.UI.TranslateText("Display output of supported commands as JSON or YAML")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
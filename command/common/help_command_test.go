@@ -305,6 +305,10 @@ var _ = Describe("help Command", func() {
 										"--second-third": "baz",
 									},
 								},
+								Flags: []configv3.PluginFlag{
+									{Name: "first", Description: "already listed"},
+									{Name: "fourth", ShortName: "f", Description: "qux", Type: "string"},
+								},
 							},
 						},
 					},
@@ -324,6 +328,8 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("ed"))
 				Expect(testUI.Out).To(Say("--first\\s+foobar"))
 				Expect(testUI.Out).To(Say("--second-third\\s+baz"))
+				Expect(testUI.Out).To(Say("--fourth, -f\\s+qux"))
+				Expect(testUI.Out).ToNot(Say("already listed"))
 			})
 		})
 
@@ -413,6 +419,8 @@ var _ = Describe("help Command", func() {
 
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
+			Expect(testUI.Out).To(Say("  --no-cache                         Do not use cached Cloud Controller responses"))
+			Expect(testUI.Out).To(Say("  --output json\\|yaml                 Display output of supported commands as JSON or YAML"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
 
 			Expect(testUI.Out).To(Say("Use 'cf help -a' to see all commands\\."))
@@ -597,15 +605,24 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
+				Expect(testUI.Out).To(Say("   CF_CREDENTIAL_HELPER=name          Store tokens with the cf-credential-name helper, or set to 'file' to keep the credentials key out of the OS keychain"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
+				Expect(testUI.Out).To(Say("   CF_PAGINATION_CONCURRENCY=4        Max number of pages of a list request fetched in parallel"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_PROFILE=name                    Target the named profile for this command only"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_BACKOFF=500ms             Initial wait time between retries of failed API requests"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_MAX_BACKOFF=10s           Max wait time between retries of failed API requests"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say("   CF_TRACE_FORMAT=jsonl              Write each request to the log file as a line of JSON"))
+				Expect(testUI.Out).To(Say("   CF_TRACE_MAX_SIZE=10M              Rotate the log file when it would exceed this size"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
+				Expect(testUI.Out).To(Say("   --no-cache                         Do not use cached Cloud Controller responses"))
+				Expect(testUI.Out).To(Say("   --output json\\|yaml                 Display output of supported commands as JSON or YAML"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("APPS \\(experimental\\):"))
@@ -31,7 +31,7 @@ type InstallPluginActor interface {
 	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
-	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginBinary(path string, pluginInfo pluginaction.PluginInfo, requireSignature bool) error
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -56,7 +56,8 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
+	RequireSignature     bool                   `long:"require-signature" description:"Only install a plugin whose signature is verified with its repository's public key"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--require-signature]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
 	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
@@ -211,10 +212,16 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 
 	case cmd.Actor.FileExists(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified file")
+		if cmd.RequireSignature {
+			return "", 0, translatableerror.PluginSignatureRequiredError{Path: pluginNameOrLocation}
+		}
 		return cmd.getPluginFromLocalFile(pluginNameOrLocation)
 
 	case util.IsHTTPScheme(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified URL")
+		if cmd.RequireSignature {
+			return "", 0, translatableerror.PluginSignatureRequiredError{Path: pluginNameOrLocation}
+		}
 		return cmd.getPluginFromURL(pluginNameOrLocation, tempPluginDir)
 
 	case util.IsUnsupportedURLScheme(pluginNameOrLocation):
@@ -354,8 +361,9 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, err
 	}
 
-	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
-		return "", 0, translatableerror.InvalidChecksumError{}
+	err = cmd.Actor.VerifyPluginBinary(tempPath, pluginInfo, cmd.RequireSignature)
+	if err != nil {
+		return "", 0, err
 	}
 
 	return tempPath, PluginFromRepository, err
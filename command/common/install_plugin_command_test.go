@@ -86,6 +86,19 @@ var _ = Describe("install-plugin command", func() {
 				fakeActor.FileExistsReturns(true)
 			})
 
+			Context("when --require-signature is given", func() {
+				BeforeEach(func() {
+					cmd.RequireSignature = true
+				})
+
+				It("returns a PluginSignatureRequiredError without installing", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PluginSignatureRequiredError{Path: "some-path"}))
+
+					Expect(testUI.Out).ToNot(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
+					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the -f argument is given", func() {
 				BeforeEach(func() {
 					cmd.Force = true
@@ -435,6 +448,17 @@ var _ = Describe("install-plugin command", func() {
 			Expect(testUI.Out).To(Say("Install and use plugins at your own risk\\."))
 		})
 
+		Context("when --require-signature is given", func() {
+			BeforeEach(func() {
+				cmd.RequireSignature = true
+			})
+
+			It("returns a PluginSignatureRequiredError without downloading", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginSignatureRequiredError{Path: "http://some-url"}))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when the -f argument is given", func() {
 			BeforeEach(func() {
 				cmd.Force = true
@@ -262,13 +262,29 @@ var _ = Describe("install-plugin command", func() {
 								fakeActor.DownloadExecutableBinaryFromURLReturns(execPath, nil)
 							})
 
+							Context("when --require-signature is given", func() {
+								BeforeEach(func() {
+									cmd.RequireSignature = true
+									fakeActor.VerifyPluginBinaryReturns(actionerror.PluginSignatureMissingError{PluginName: pluginName, RepositoryName: repoName})
+								})
+
+								It("requires a signature and returns the verification error", func() {
+									Expect(executeErr).To(MatchError(actionerror.PluginSignatureMissingError{PluginName: pluginName, RepositoryName: repoName}))
+									Expect(testUI.Out).ToNot(Say("Installing plugin"))
+
+									Expect(fakeActor.VerifyPluginBinaryCallCount()).To(Equal(1))
+									_, _, requireSignatureArg := fakeActor.VerifyPluginBinaryArgsForCall(0)
+									Expect(requireSignatureArg).To(BeTrue())
+								})
+							})
+
 							Context("when the checksum fails", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(false)
+									fakeActor.VerifyPluginBinaryReturns(actionerror.PluginChecksumMismatchError{Algorithm: "sha1"})
 								})
 
 								It("returns the checksum error", func() {
-									Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+									Expect(executeErr).To(MatchError(actionerror.PluginChecksumMismatchError{Algorithm: "sha1"}))
 
 									Expect(testUI.Out).To(Say("Searching %s for plugin %s\\.\\.\\.", repoName, pluginName))
 									Expect(testUI.Out).To(Say("Plugin %s %s found in: %s", pluginName, downloadedVersionString, repoName))
@@ -278,16 +294,17 @@ var _ = Describe("install-plugin command", func() {
 									Expect(testUI.Out).To(Say("Starting download of plugin binary from repository %s\\.\\.\\.", repoName))
 									Expect(testUI.Out).ToNot(Say("Installing plugin"))
 
-									Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(1))
-									pathArg, checksumArg := fakeActor.ValidateFileChecksumArgsForCall(0)
+									Expect(fakeActor.VerifyPluginBinaryCallCount()).To(Equal(1))
+									pathArg, pluginInfoArg, requireSignatureArg := fakeActor.VerifyPluginBinaryArgsForCall(0)
 									Expect(pathArg).To(Equal(execPath))
-									Expect(checksumArg).To(Equal(checksum))
+									Expect(pluginInfoArg.Checksum).To(Equal(checksum))
+									Expect(requireSignatureArg).To(BeFalse())
 								})
 							})
 
 							Context("when the checksum succeeds", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(true)
+									fakeActor.VerifyPluginBinaryReturns(nil)
 								})
 
 								Context("when creating an executable copy errors", func() {
@@ -441,11 +458,11 @@ var _ = Describe("install-plugin command", func() {
 
 							Context("when the checksum fails", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(false)
+									fakeActor.VerifyPluginBinaryReturns(actionerror.PluginChecksumMismatchError{Algorithm: "sha1"})
 								})
 
 								It("returns the checksum error", func() {
-									Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+									Expect(executeErr).To(MatchError(actionerror.PluginChecksumMismatchError{Algorithm: "sha1"}))
 
 									Expect(testUI.Out).To(Say("Searching %s for plugin %s\\.\\.\\.", repoName, pluginName))
 									Expect(testUI.Out).To(Say("Plugin %s %s found in: %s", pluginName, downloadedVersionString, repoName))
@@ -458,7 +475,7 @@ var _ = Describe("install-plugin command", func() {
 
 							Context("when the checksum succeeds", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(true)
+									fakeActor.VerifyPluginBinaryReturns(nil)
 								})
 
 								Context("when creating an executable copy errors", func() {
@@ -533,7 +550,7 @@ var _ = Describe("install-plugin command", func() {
 
 				Context("when the -f argument is not given (user is prompted for confirmation)", func() {
 					BeforeEach(func() {
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.VerifyPluginBinaryReturns(nil)
 					})
 
 					Context("when the plugin is already installed", func() {
@@ -792,7 +809,7 @@ var _ = Describe("install-plugin command", func() {
 					BeforeEach(func() {
 						cmd.Force = true
 
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.VerifyPluginBinaryReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("copy-path", nil)
@@ -835,12 +852,12 @@ var _ = Describe("install-plugin command", func() {
 					Context("when the checksum fails", func() {
 						BeforeEach(func() {
 							cmd.Force = false
-							fakeActor.ValidateFileChecksumReturns(false)
+							fakeActor.VerifyPluginBinaryReturns(actionerror.PluginChecksumMismatchError{Algorithm: "sha1"})
 							input.Write([]byte("y\n"))
 						})
 
 						It("returns the checksum error", func() {
-							Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+							Expect(executeErr).To(MatchError(actionerror.PluginChecksumMismatchError{Algorithm: "sha1"}))
 
 							Expect(testUI.Out).To(Say("Searching %s for plugin %s\\.\\.\\.", repoName, pluginName))
 							Expect(testUI.Out).To(Say("Plugin %s %s found in: %s", pluginName, downloadedVersionString, repoName))
@@ -946,7 +963,7 @@ var _ = Describe("install-plugin command", func() {
 
 						fakeActor.DownloadExecutableBinaryFromURLReturns(execPath, nil)
 
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.VerifyPluginBinaryReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("copy-path", nil)
@@ -1025,7 +1042,7 @@ var _ = Describe("install-plugin command", func() {
 					BeforeEach(func() {
 						cmd.Force = true
 
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.VerifyPluginBinaryReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("copy-path", nil)
@@ -1064,7 +1081,7 @@ var _ = Describe("install-plugin command", func() {
 
 					BeforeEach(func() {
 						cmd.Force = false
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.VerifyPluginBinaryReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("", errors.New("some-error"))
@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"profiles", "profile-rename", "profile-delete"},
 		},
 	},
 	{
@@ -19,7 +20,7 @@ var HelpCategoryList = []HelpCategory{
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "ssh-copy"},
 		},
 	},
 	{
@@ -147,7 +148,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "update-plugins", "uninstall-plugin"},
 		},
 	},
 }
@@ -171,4 +172,10 @@ var ExperimentalHelpCategoryList = []HelpCategory{
 			{"share-service", "unshare-service"},
 		},
 	},
+	{
+		CategoryName: "METADATA (experimental):",
+		CommandList: [][]string{
+			{"labels", "set-label", "unset-label"},
+		},
+	},
 }
@@ -49,9 +49,31 @@ func ConvertPluginToCommandInfo(plugin configv3.PluginCommand) sharedaction.Comm
 		}
 	}
 
+	for _, flag := range plugin.Flags {
+		if pluginOptionListed(plugin.UsageDetails.Options, flag) {
+			continue
+		}
+		commandInfo.Flags = append(commandInfo.Flags,
+			sharedaction.CommandFlag{
+				Short:       flag.ShortName,
+				Long:        flag.Name,
+				Description: flag.Description,
+			})
+	}
+
 	return commandInfo
 }
 
+func pluginOptionListed(options map[string]string, flag configv3.PluginFlag) bool {
+	for option := range options {
+		name := strings.Trim(option, "-")
+		if name == flag.Name || (flag.ShortName != "" && name == flag.ShortName) {
+			return true
+		}
+	}
+	return false
+}
+
 func LongestCommandName(cmds map[string]sharedaction.CommandInfo, pluginCmds []configv3.PluginCommand) int {
 	longest := 0
 	for name, _ := range cmds {
@@ -0,0 +1,222 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate counterfeiter . UpdatePluginsActor
+
+type UpdatePluginsActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	VerifyPluginBinary(path string, pluginInfo pluginaction.PluginInfo, requireSignature bool) error
+}
+
+type UpdatePluginsCommand struct {
+	OptionalArgs      flag.UpdatePluginsArgs `positional-args:"yes"`
+	All               bool                   `long:"all" description:"Update every installed plugin that has a newer version in a registered repository"`
+	RequireSignature  bool                   `long:"require-signature" description:"Only update to a plugin binary whose signature is verified with its repository's public key"`
+	SkipSSLValidation bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	usage             interface{}            `usage:"CF_NAME update-plugins PLUGIN_NAME... [--require-signature]\n   CF_NAME update-plugins --all [--require-signature]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME update-plugins --all\n   CF_NAME update-plugins plugin-echo plugin-foobar"`
+	relatedCommands   interface{}            `related_commands:"install-plugin, plugins"`
+	UI                command.UI
+	Config            command.Config
+	Actor             UpdatePluginsActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *UpdatePluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) Execute([]string) error {
+	pluginNames := cmd.OptionalArgs.PluginNames
+	switch {
+	case cmd.All && len(pluginNames) > 0:
+		return translatableerror.ArgumentCombinationError{Args: []string{"PLUGIN_NAME", "--all"}}
+	case !cmd.All && len(pluginNames) == 0:
+		return translatableerror.RequiredArgumentError{ArgumentName: "PLUGIN_NAME"}
+	}
+
+	for _, pluginName := range pluginNames {
+		if _, installed := cmd.Config.GetPluginCaseInsensitive(pluginName); !installed {
+			return actionerror.PluginNotFoundError{PluginName: pluginName}
+		}
+	}
+
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...",
+		map[string]interface{}{
+			"RepoNames": strings.Join(repoNames, ", "),
+		})
+
+	outdatedPlugins, err := cmd.Actor.GetOutdatedPlugins()
+	if err != nil {
+		return err
+	}
+
+	pluginsToUpdate := cmd.selectPlugins(outdatedPlugins)
+	if len(pluginsToUpdate) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("All plugins are up to date.")
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
+
+	log.WithField("PluginHome", cmd.Config.PluginHome()).Info("making plugin dir")
+	err = os.MkdirAll(cmd.Config.PluginHome(), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	summary := [][]string{{"plugin", "previous version", "version", "status"}}
+	for _, outdatedPlugin := range pluginsToUpdate {
+		err = cmd.updatePlugin(outdatedPlugin, repos, tempPluginDir, rpcService)
+		if err != nil {
+			summary = append(summary, []string{outdatedPlugin.Name, outdatedPlugin.CurrentVersion, outdatedPlugin.CurrentVersion, cmd.UI.TranslateText("failed")})
+			cmd.displaySummary(summary)
+			return err
+		}
+		summary = append(summary, []string{outdatedPlugin.Name, outdatedPlugin.CurrentVersion, outdatedPlugin.LatestVersion, cmd.UI.TranslateText("updated")})
+	}
+
+	cmd.displaySummary(summary)
+	return nil
+}
+
+// selectPlugins returns the outdated plugins that were requested, or every
+// outdated plugin when --all is provided.
+func (cmd UpdatePluginsCommand) selectPlugins(outdatedPlugins []pluginaction.OutdatedPlugin) []pluginaction.OutdatedPlugin {
+	if cmd.All {
+		return outdatedPlugins
+	}
+
+	var selected []pluginaction.OutdatedPlugin
+	for _, outdatedPlugin := range outdatedPlugins {
+		for _, pluginName := range cmd.OptionalArgs.PluginNames {
+			if strings.EqualFold(outdatedPlugin.Name, pluginName) {
+				selected = append(selected, outdatedPlugin)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// updatePlugin downloads, verifies and validates the latest version of the
+// plugin before it replaces the installed binary. The installed binary is
+// backed up first and restored if the new version cannot be installed, so a
+// failed update leaves the previous version in place. Unlike install-plugin,
+// the previous version is not sent the uninstall message.
+func (cmd UpdatePluginsCommand) updatePlugin(outdatedPlugin pluginaction.OutdatedPlugin, repos []configv3.PluginRepository, tempPluginDir string, rpcService *shared.RPCService) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Updating plugin {{.Name}} from {{.CurrentVersion}} to {{.LatestVersion}}...", map[string]interface{}{
+		"Name":           outdatedPlugin.Name,
+		"CurrentVersion": outdatedPlugin.CurrentVersion,
+		"LatestVersion":  outdatedPlugin.LatestVersion,
+	})
+
+	platform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(outdatedPlugin.Name, repos, platform)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": repoList[0],
+	})
+
+	downloadPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Actor.VerifyPluginBinary(downloadPath, pluginInfo, cmd.RequireSignature)
+	if err != nil {
+		return err
+	}
+
+	executablePath, err := cmd.Actor.CreateExecutableCopy(downloadPath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	newPlugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	if err != nil {
+		return err
+	}
+
+	installedPlugin, _ := cmd.Config.GetPluginCaseInsensitive(outdatedPlugin.Name)
+	backupPath, err := cmd.Actor.CreateExecutableCopy(installedPlugin.Location, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Actor.InstallPluginFromPath(executablePath, newPlugin)
+	if err != nil {
+		log.WithError(err).Error("installing new plugin version, rolling back")
+		rollbackErr := cmd.Actor.InstallPluginFromPath(backupPath, installedPlugin)
+		if rollbackErr != nil {
+			cmd.UI.DisplayWarning("Could not restore plugin {{.Name}} {{.Version}}: {{.Error}}", map[string]interface{}{
+				"Name":    installedPlugin.Name,
+				"Version": installedPlugin.Version.String(),
+				"Error":   rollbackErr.Error(),
+			})
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) displaySummary(summary [][]string) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", summary, ui.DefaultTableSpacePadding)
+}
@@ -0,0 +1,279 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugins command", func() {
+	var (
+		cmd             UpdatePluginsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpdatePluginsActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpdatePluginsActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpdatePluginsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.com"},
+			{Name: "repo-2", URL: "https://repo-2.com"},
+		})
+		fakeConfig.GetPluginCaseInsensitiveStub = func(name string) (configv3.Plugin, bool) {
+			return configv3.Plugin{Name: name, Location: "/plugins/" + name}, true
+		}
+
+		fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+			{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "1.1.0"},
+			{Name: "plugin-2", CurrentVersion: "2.0.0", LatestVersion: "3.0.0"},
+		}, nil)
+		fakeActor.GetPlatformStringReturns("some-platform")
+		fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = func(name string, _ []configv3.PluginRepository, _ string) (pluginaction.PluginInfo, []string, error) {
+			return pluginaction.PluginInfo{Name: name, URL: "https://" + name}, []string{"repo-1"}, nil
+		}
+		fakeActor.DownloadExecutableBinaryFromURLStub = func(url string, _ string, _ plugin.ProxyReader) (string, error) {
+			return "downloaded-" + url, nil
+		}
+		fakeActor.CreateExecutableCopyStub = func(path string, _ string) (string, error) {
+			return "copy-of-" + path, nil
+		}
+		fakeActor.GetAndValidatePluginStub = func(_ pluginaction.PluginMetadata, _ pluginaction.CommandList, path string) (configv3.Plugin, error) {
+			return configv3.Plugin{Name: "validated", Location: path}, nil
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when neither plugin names nor --all are provided", func() {
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "PLUGIN_NAME"}))
+		})
+	})
+
+	Context("when both plugin names and --all are provided", func() {
+		BeforeEach(func() {
+			cmd.All = true
+			cmd.OptionalArgs.PluginNames = []string{"plugin-1"}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"PLUGIN_NAME", "--all"}}))
+		})
+	})
+
+	Context("when a named plugin is not installed", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNames = []string{"plugin-1"}
+			fakeConfig.GetPluginCaseInsensitiveStub = nil
+			fakeConfig.GetPluginCaseInsensitiveReturns(configv3.Plugin{}, false)
+		})
+
+		It("returns a PluginNotFoundError", func() {
+			Expect(executeErr).To(MatchError(actionerror.PluginNotFoundError{PluginName: "plugin-1"}))
+			Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --all is provided", func() {
+		BeforeEach(func() {
+			cmd.All = true
+		})
+
+		Context("when there are no plugin repositories", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns(nil)
+			})
+
+			It("returns a NoPluginRepositoriesError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+			})
+		})
+
+		Context("when getting the outdated plugins fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsReturns(nil, actionerror.GettingPluginRepositoryError{Name: "repo-1", Message: "404"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "repo-1", Message: "404"}))
+			})
+		})
+
+		Context("when every plugin is up to date", func() {
+			BeforeEach(func() {
+				fakeActor.GetOutdatedPluginsReturns(nil, nil)
+			})
+
+			It("says so and does not download anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Searching repo-1, repo-2 for newer versions of installed plugins\\.\\.\\."))
+				Expect(testUI.Out).To(Say("All plugins are up to date\\."))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the updates succeed", func() {
+			It("updates every outdated plugin and displays a summary", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Searching repo-1, repo-2 for newer versions of installed plugins\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Updating plugin plugin-1 from 1\\.0\\.0 to 1\\.1\\.0\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Starting download of plugin binary from repository repo-1\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Updating plugin plugin-2 from 2\\.0\\.0 to 3\\.0\\.0\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("plugin\\s+previous version\\s+version\\s+status"))
+				Expect(testUI.Out).To(Say("plugin-1\\s+1\\.0\\.0\\s+1\\.1\\.0\\s+updated"))
+				Expect(testUI.Out).To(Say("plugin-2\\s+2\\.0\\.0\\s+3\\.0\\.0\\s+updated"))
+
+				Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(2))
+				pluginName, repos, platform := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+				Expect(pluginName).To(Equal("plugin-1"))
+				Expect(repos).To(HaveLen(2))
+				Expect(platform).To(Equal("some-platform"))
+
+				url, _, proxyReader := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+				Expect(url).To(Equal("https://plugin-1"))
+				Expect(proxyReader).To(Equal(fakeProgressBar))
+
+				path, pluginInfo, requireSignature := fakeActor.VerifyPluginBinaryArgsForCall(0)
+				Expect(path).To(Equal("downloaded-https://plugin-1"))
+				Expect(pluginInfo.Name).To(Equal("plugin-1"))
+				Expect(requireSignature).To(BeFalse())
+
+				Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(2))
+				_, _, validatedPath := fakeActor.GetAndValidatePluginArgsForCall(0)
+				Expect(validatedPath).To(Equal("copy-of-downloaded-https://plugin-1"))
+
+				backedUpPath, _ := fakeActor.CreateExecutableCopyArgsForCall(1)
+				Expect(backedUpPath).To(Equal("/plugins/plugin-1"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+				installPath, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(installPath).To(Equal("copy-of-downloaded-https://plugin-1"))
+				Expect(installedPlugin.Location).To(Equal("copy-of-downloaded-https://plugin-1"))
+			})
+		})
+
+		Context("when verifying a download fails", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyPluginBinaryReturnsOnCall(1, actionerror.PluginChecksumMismatchError{Algorithm: "sha256"})
+			})
+
+			It("stops without replacing the plugin and displays a summary", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginChecksumMismatchError{Algorithm: "sha256"}))
+
+				Expect(testUI.Out).To(Say("plugin-1\\s+1\\.0\\.0\\s+1\\.1\\.0\\s+updated"))
+				Expect(testUI.Out).To(Say("plugin-2\\s+2\\.0\\.0\\s+2\\.0\\.0\\s+failed"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when --require-signature is given", func() {
+			BeforeEach(func() {
+				cmd.RequireSignature = true
+				fakeActor.VerifyPluginBinaryStub = func(_ string, pluginInfo pluginaction.PluginInfo, requireSignature bool) error {
+					if requireSignature && pluginInfo.Signature == "" {
+						return actionerror.PluginSignatureMissingError{PluginName: pluginInfo.Name, RepositoryName: "repo-1"}
+					}
+					return nil
+				}
+			})
+
+			It("refuses to install an unsigned upgrade", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureMissingError{PluginName: "plugin-1", RepositoryName: "repo-1"}))
+
+				_, _, requireSignature := fakeActor.VerifyPluginBinaryArgsForCall(0)
+				Expect(requireSignature).To(BeTrue())
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say("plugin-1\\s+1\\.0\\.0\\s+1\\.0\\.0\\s+failed"))
+			})
+		})
+
+		Context("when installing the new version fails", func() {
+			BeforeEach(func() {
+				fakeActor.InstallPluginFromPathReturnsOnCall(0, errors.New("some-install-error"))
+			})
+
+			It("restores the previous version", func() {
+				Expect(executeErr).To(MatchError("some-install-error"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+				restorePath, restoredPlugin := fakeActor.InstallPluginFromPathArgsForCall(1)
+				Expect(restorePath).To(Equal("copy-of-/plugins/plugin-1"))
+				Expect(restoredPlugin).To(Equal(configv3.Plugin{Name: "plugin-1", Location: "/plugins/plugin-1"}))
+
+				Expect(testUI.Out).To(Say("plugin-1\\s+1\\.0\\.0\\s+1\\.0\\.0\\s+failed"))
+				Expect(testUI.Out).ToNot(Say("plugin-2"))
+			})
+
+			Context("when restoring the previous version fails", func() {
+				BeforeEach(func() {
+					fakeActor.InstallPluginFromPathReturnsOnCall(1, errors.New("some-restore-error"))
+				})
+
+				It("warns that the plugin could not be restored", func() {
+					Expect(executeErr).To(MatchError("some-install-error"))
+					Expect(testUI.Err).To(Say("Could not restore plugin plugin-1 .*: some-restore-error"))
+				})
+			})
+		})
+	})
+
+	Context("when plugin names are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNames = []string{"PLUGIN-2", "plugin-3"}
+		})
+
+		It("only updates the named plugins that are outdated", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			pluginName, _, _ := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+			Expect(pluginName).To(Equal("plugin-2"))
+			Expect(testUI.Out).ToNot(Say("plugin-1"))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package command
 import (
 	"time"
 
+	"code.cloudfoundry.org/cli/util/backoff"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -12,12 +13,15 @@ import (
 type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
-	AddPluginRepository(name string, url string)
+	AddPluginRepository(name string, url string, publicKey string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
 	ColorEnabled() configv3.ColorSetting
+	CurrentProfile() string
 	CurrentUser() (configv3.User, error)
+	CurrentUserName() (string, error)
+	DeleteProfile(name string)
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
@@ -27,16 +31,23 @@ type Config interface {
 	HasTargetedSpace() bool
 	Locale() string
 	MinCLIVersion() string
+	NoCache() bool
 	NOAARequestRetryCount() int
 	OverallPollingTimeout() time.Duration
+	PaginationConcurrency() int
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	Profiles() []configv3.Profile
 	RefreshToken() string
 	RemovePlugin(string)
+	RenameProfile(oldName string, newName string)
+	RequestRetryBackoff() backoff.Backoff
 	RequestRetryCount() int
+	ResponseCacheDir() string
 	SetAccessToken(token string)
+	SetCurrentProfile(name string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
@@ -52,6 +63,8 @@ type Config interface {
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TraceFormat() configv3.TraceFormat
+	TraceMaxSize() int64
 	UAADisableKeepAlives() bool
 	UAAGrantType() string
 	UAAOAuthClient() string
@@ -9,3 +9,11 @@ type ExtendedCommander interface {
 	flags.Commander
 	Setup(Config, UI) error
 }
+
+// StructuredOutputCommander is implemented by commands that can display their
+// results as a JSON or YAML document when the global --output flag is
+// provided. The flag is rejected for all other commands.
+type StructuredOutputCommander interface {
+	ExtendedCommander
+	SupportsStructuredOutput()
+}
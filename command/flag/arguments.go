@@ -8,6 +8,10 @@ type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
 
+type LogsArgs struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type BuildpackName struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -52,6 +56,10 @@ type PluginName struct {
 	PluginName string `positional-arg-name:"PLUGIN_NAME" required:"true" description:"The plugin name"`
 }
 
+type ProfileName struct {
+	ProfileName string `positional-arg-name:"PROFILE" required:"true" description:"The profile name"`
+}
+
 type Quota struct {
 	Quota string `positional-arg-name:"QUOTA" required:"true" description:"The organization quota"`
 }
@@ -146,6 +154,11 @@ type FilesArgs struct {
 	Path    string `positional-arg-name:"PATH" description:"The file path"`
 }
 
+type SSHCopyArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The path to copy from, either local or APP_NAME[:INDEX]:PATH in an app instance"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The path to copy to, either local or APP_NAME[:INDEX]:PATH in an app instance"`
+}
+
 type EnvironmentArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
@@ -197,6 +210,11 @@ type RenameOrgArgs struct {
 	NewOrgName string `positional-arg-name:"NEW_ORG" required:"true" description:"The new organization name"`
 }
 
+type RenameProfileArgs struct {
+	OldProfileName string `positional-arg-name:"PROFILE" required:"true" description:"The old profile name"`
+	NewProfileName string `positional-arg-name:"NEW_PROFILE" required:"true" description:"The new profile name"`
+}
+
 type RenameSpaceArgs struct {
 	OldSpaceName string `positional-arg-name:"SPACE_NAME" required:"true" description:"The old space name"`
 	NewSpaceName string `positional-arg-name:"NEW_SPACE_NAME" required:"true" description:"The new space name"`
@@ -286,6 +304,10 @@ type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
 
+type UpdatePluginsArgs struct {
+	PluginNames []string `positional-arg-name:"PLUGIN_NAME" description:"The names of the installed plugins to update"`
+}
+
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
@@ -325,3 +347,20 @@ type AddNetworkPolicyArgs struct {
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type LabelsArgs struct {
+	ResourceType string `positional-arg-name:"RESOURCE" required:"true" description:"The type of the resource (app, org or space)"`
+	ResourceName string `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
+
+type SetLabelArgs struct {
+	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of the resource (app, org or space)"`
+	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	Labels       []string `positional-arg-name:"KEY=VALUE" required:"1" description:"A space-separated list of labels to set on the resource"`
+}
+
+type UnsetLabelArgs struct {
+	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of the resource (app, org or space)"`
+	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	LabelKeys    []string `positional-arg-name:"KEY" required:"1" description:"A space-separated list of label keys to remove from the resource"`
+}
@@ -0,0 +1,38 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	// DeploymentStrategyRolling replaces the app's instances one by one
+	// using the Cloud Controller deployments endpoint.
+	DeploymentStrategyRolling = "rolling"
+	// DeploymentStrategyBlueGreen pushes to a temporary app and switches the
+	// routes over once it is running.
+	DeploymentStrategyBlueGreen = "blue-green"
+)
+
+type DeploymentStrategy struct {
+	Name string
+}
+
+func (DeploymentStrategy) Complete(prefix string) []flags.Completion {
+	return completions([]string{DeploymentStrategyBlueGreen, DeploymentStrategyRolling}, prefix, false)
+}
+
+func (d *DeploymentStrategy) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case DeploymentStrategyRolling, DeploymentStrategyBlueGreen:
+		d.Name = strings.ToLower(val)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STRATEGY must be "rolling" or "blue-green"`,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentStrategy", func() {
+	var strategy DeploymentStrategy
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := strategy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'rolling' when passed 'r'", "r",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to 'blue-green' when passed 'B'", "B",
+				[]flags.Completion{{Item: "blue-green"}}),
+			Entry("returns 'blue-green' and 'rolling' when passed nothing", "",
+				[]flags.Completion{{Item: "blue-green"}, {Item: "rolling"}}),
+			Entry("completes to nothing when passed 'canary'", "canary",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			strategy = DeploymentStrategy{}
+		})
+
+		It("accepts rolling", func() {
+			err := strategy.UnmarshalFlag("Rolling")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strategy.Name).To(Equal(DeploymentStrategyRolling))
+		})
+
+		It("accepts blue-green", func() {
+			err := strategy.UnmarshalFlag("blue-green")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strategy.Name).To(Equal(DeploymentStrategyBlueGreen))
+		})
+
+		It("errors on anything else", func() {
+			err := strategy.UnmarshalFlag("canary")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `STRATEGY must be "rolling" or "blue-green"`,
+			}))
+			Expect(strategy.Name).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogSourceTypes are the log source types that logs can be filtered on.
+var LogSourceTypes = []string{"API", "APP", "CELL", "RTR", "STG"}
+
+type LogSourceType struct {
+	Type string
+}
+
+func (LogSourceType) Complete(prefix string) []flags.Completion {
+	return completions(LogSourceTypes, prefix, false)
+}
+
+func (l *LogSourceType) UnmarshalFlag(val string) error {
+	upperVal := strings.ToUpper(val)
+	for _, sourceType := range LogSourceTypes {
+		if upperVal == sourceType {
+			l.Type = sourceType
+			return nil
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `SOURCE must be "API", "APP", "CELL", "RTR" or "STG"`,
+	}
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSourceType", func() {
+	var sourceType LogSourceType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := sourceType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'RTR' when passed 'r'", "r",
+				[]flags.Completion{{Item: "RTR"}}),
+			Entry("completes to 'API' and 'APP' when passed 'AP'", "AP",
+				[]flags.Completion{{Item: "API"}, {Item: "APP"}}),
+			Entry("completes to nothing when passed 'LGR'", "LGR",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			sourceType = LogSourceType{}
+		})
+
+		DescribeTable("accepts the known source types in any case",
+			func(input string, expected string) {
+				err := sourceType.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sourceType.Type).To(Equal(expected))
+			},
+
+			Entry("API", "api", "API"),
+			Entry("APP", "APP", "APP"),
+			Entry("CELL", "Cell", "CELL"),
+			Entry("RTR", "rtr", "RTR"),
+			Entry("STG", "stg", "STG"),
+		)
+
+		It("errors on anything else", func() {
+			err := sourceType.UnmarshalFlag("LGR")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `SOURCE must be "API", "APP", "CELL", "RTR" or "STG"`,
+			}))
+			Expect(sourceType.Type).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogStream struct {
+	Type string
+}
+
+func (LogStream) Complete(prefix string) []flags.Completion {
+	return completions([]string{"ERR", "OUT"}, prefix, false)
+}
+
+func (l *LogStream) UnmarshalFlag(val string) error {
+	switch strings.ToUpper(val) {
+	case "OUT", "ERR":
+		l.Type = strings.ToUpper(val)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STREAM must be "OUT" or "ERR"`,
+		}
+	}
+
+	return nil
+}
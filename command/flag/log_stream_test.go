@@ -0,0 +1,39 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogStream", func() {
+	var stream LogStream
+
+	BeforeEach(func() {
+		stream = LogStream{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("accepts OUT", func() {
+			err := stream.UnmarshalFlag("out")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.Type).To(Equal("OUT"))
+		})
+
+		It("accepts ERR", func() {
+			err := stream.UnmarshalFlag("ERR")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.Type).To(Equal("ERR"))
+		})
+
+		It("errors on anything else", func() {
+			err := stream.UnmarshalFlag("both")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `STREAM must be "OUT" or "ERR"`,
+			}))
+			Expect(stream.Type).To(BeEmpty())
+		})
+	})
+})
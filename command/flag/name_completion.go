@@ -0,0 +1,51 @@
+package flag
+
+// NameType is the type of resource that a positional argument names.
+type NameType string
+
+const (
+	AppNameType             NameType = "app"
+	ServiceInstanceNameType NameType = "service"
+	OrgNameType             NameType = "org"
+	SpaceNameType           NameType = "space"
+)
+
+// NameCompleter returns the names of the resources of the given type in the
+// current target that start with prefix.
+type NameCompleter func(nameType NameType, prefix string) []string
+
+// PositionalNameCompleter is implemented by the positional arguments whose
+// first argument names a resource in the current target.
+type PositionalNameCompleter interface {
+	Complete(prefix string, completeNames NameCompleter) []string
+}
+
+// Complete completes the app name.
+func (AppName) Complete(prefix string, completeNames NameCompleter) []string {
+	return completeNames(AppNameType, prefix)
+}
+
+// Complete completes the app name, when one is given.
+func (OptionalAppName) Complete(prefix string, completeNames NameCompleter) []string {
+	return completeNames(AppNameType, prefix)
+}
+
+// Complete completes the first of the app names to show logs for.
+func (LogsArgs) Complete(prefix string, completeNames NameCompleter) []string {
+	return completeNames(AppNameType, prefix)
+}
+
+// Complete completes the service instance name from the targeted space.
+func (ServiceInstance) Complete(prefix string, completeNames NameCompleter) []string {
+	return completeNames(ServiceInstanceNameType, prefix)
+}
+
+// Complete completes the org name from the orgs the user can see.
+func (Organization) Complete(prefix string, completeNames NameCompleter) []string {
+	return completeNames(OrgNameType, prefix)
+}
+
+// Complete completes the space name from the targeted org.
+func (Space) Complete(prefix string, completeNames NameCompleter) []string {
+	return completeNames(SpaceNameType, prefix)
+}
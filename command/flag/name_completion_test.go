@@ -0,0 +1,38 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("name completion", func() {
+	var (
+		passedType    NameType
+		passedPrefix  string
+		completeNames NameCompleter
+	)
+
+	BeforeEach(func() {
+		completeNames = func(nameType NameType, prefix string) []string {
+			passedType = nameType
+			passedPrefix = prefix
+			return []string{"some-name", "some-other-name"}
+		}
+	})
+
+	DescribeTable("completes the names of the argument's type",
+		func(completer PositionalNameCompleter, expectedType NameType) {
+			Expect(completer.Complete("some-", completeNames)).To(Equal([]string{"some-name", "some-other-name"}))
+			Expect(passedType).To(Equal(expectedType))
+			Expect(passedPrefix).To(Equal("some-"))
+		},
+		Entry("AppName", AppName{}, AppNameType),
+		Entry("OptionalAppName", OptionalAppName{}, AppNameType),
+		Entry("LogsArgs", LogsArgs{}, AppNameType),
+		Entry("ServiceInstance", ServiceInstance{}, ServiceInstanceNameType),
+		Entry("Organization", Organization{}, OrgNameType),
+		Entry("Space", Space{}, SpaceNameType),
+	)
+})
@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var outputFormat OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := outputFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("completes to 'yaml' when passed 'Y'", "Y",
+				[]flags.Completion{{Item: "yaml"}}),
+			Entry("returns 'json' and 'yaml' when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "yaml"}}),
+			Entry("completes to nothing when passed 'xml'", "xml",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			outputFormat = OutputFormat{}
+		})
+
+		It("accepts json", func() {
+			err := outputFormat.UnmarshalFlag("JSON")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputFormat.Format).To(Equal("json"))
+		})
+
+		It("accepts yaml", func() {
+			err := outputFormat.UnmarshalFlag("yaml")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputFormat.Format).To(Equal("yaml"))
+		})
+
+		It("errors on anything else", func() {
+			err := outputFormat.UnmarshalFlag("xml")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `OUTPUT must be "json" or "yaml"`,
+			}))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"fmt"
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalFlag(val string) error {
+	compiled, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("invalid regular expression: %s", err),
+		}
+	}
+
+	r.Regexp = compiled
+	return nil
+}
@@ -0,0 +1,34 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regexp", func() {
+	var pattern Regexp
+
+	BeforeEach(func() {
+		pattern = Regexp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("compiles the regular expression", func() {
+			err := pattern.UnmarshalFlag("^some-(error|warning)$")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pattern.MatchString("some-error")).To(BeTrue())
+			Expect(pattern.MatchString("some-info")).To(BeFalse())
+		})
+
+		It("errors on an invalid regular expression", func() {
+			err := pattern.UnmarshalFlag("some-(error")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "invalid regular expression: error parsing regexp: missing closing ): `some-(error`",
+			}))
+			Expect(pattern.Regexp).To(BeNil())
+		})
+	})
+})
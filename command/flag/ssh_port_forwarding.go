@@ -10,36 +10,88 @@ import (
 
 const DefaultLocalAddress = "localhost"
 
+var portRegexp = regexp.MustCompile("^\\d+$")
+
 type SSHPortForwarding struct {
 	LocalAddress  string
 	RemoteAddress string
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
-	splitHosts := strings.Split(val, ":")
-	for _, piece := range splitHosts {
-		if len(piece) == 0 {
-			return &flags.Error{
-				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-			}
-		}
+	bindAddress, targetAddress, err := parseForwardingSpec(val, "local")
+	if err != nil {
+		return err
 	}
 
-	re := regexp.MustCompile("^\\d+$")
+	s.LocalAddress = bindAddress
+	s.RemoteAddress = targetAddress
+	return nil
+}
+
+// SSHRemotePortForwarding is a [BIND_ADDRESS:]PORT:HOST:HOST_PORT
+// specification where the listening address is in the app instance and the
+// target address is reached from the local machine.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	bindAddress, targetAddress, err := parseForwardingSpec(val, "remote")
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddress = bindAddress
+	s.LocalAddress = targetAddress
+	return nil
+}
+
+// SSHDynamicPortForwarding is a [BIND_ADDRESS:]PORT specification for a local
+// SOCKS proxy.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	splitHosts := strings.Split(val, ":")
+
 	switch {
-	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+	case len(splitHosts) == 1 && portRegexp.MatchString(splitHosts[0]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
-	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
+	case len(splitHosts) == 2 && len(splitHosts[0]) > 0 && portRegexp.MatchString(splitHosts[1]):
+		s.LocalAddress = val
 	default:
 		return &flags.Error{
 			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
 		}
 	}
 
 	return nil
 }
+
+// parseForwardingSpec splits a [BIND_ADDRESS:]PORT:HOST:HOST_PORT
+// specification into the address to listen on and the address to connect to.
+func parseForwardingSpec(val string, kind string) (string, string, error) {
+	badSpecErr := &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Bad %s forwarding specification '%s'", kind, val),
+	}
+
+	splitHosts := strings.Split(val, ":")
+	for _, piece := range splitHosts {
+		if len(piece) == 0 {
+			return "", "", badSpecErr
+		}
+	}
+
+	switch {
+	case len(splitHosts) == 3 && portRegexp.MatchString(splitHosts[0]) && portRegexp.MatchString(splitHosts[2]):
+		return fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0]), fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2]), nil
+	case len(splitHosts) == 4 && portRegexp.MatchString(splitHosts[1]) && portRegexp.MatchString(splitHosts[3]):
+		return fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1]), fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3]), nil
+	default:
+		return "", "", badSpecErr
+	}
+}
@@ -60,3 +60,91 @@ var _ = Describe("SpaceRole", func() {
 		)
 	})
 })
+
+var _ = Describe("SSHRemotePortForwarding", func() {
+	var forward SSHRemotePortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHRemotePortForwarding{}
+		})
+
+		Context("when passed remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("5005:local:8000")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "localhost:5005",
+					LocalAddress:  "local:8000",
+				}))
+			})
+		})
+
+		Context("when passed remote:remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:5005:local:8000")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "0.0.0.0:5005",
+					LocalAddress:  "local:8000",
+				}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad remote forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("1 colon", "IAMABANANA:909009009"),
+			Entry("empty values in between colons", "I:AM:A:"),
+			Entry("[implicit localhost] incorrect port numbers for first value", "I:AM:8888"),
+			Entry("[explicit localhost] incorrect port numbers for fourth value", "localhost:8080:AM:bar"),
+		)
+	})
+})
+
+var _ = Describe("SSHDynamicPortForwarding", func() {
+	var forward SSHDynamicPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHDynamicPortForwarding{}
+		})
+
+		Context("when passed local_port", func() {
+			It("listens on localhost", func() {
+				err := forward.UnmarshalFlag("1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "localhost:1080"}))
+			})
+		})
+
+		Context("when passed local:local_port", func() {
+			It("listens on the local address", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "0.0.0.0:1080"}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("not a port", "IAMABANANA"),
+			Entry("empty bind address", ":1080"),
+			Entry("too many colons", "local:1080:remote"),
+			Entry("incorrect port number", "local:potato"),
+		)
+	})
+})
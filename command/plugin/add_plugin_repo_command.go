@@ -11,12 +11,13 @@ import (
 //go:generate counterfeiter . AddPluginRepoActor
 
 type AddPluginRepoActor interface {
-	AddPluginRepository(repoName string, repoURL string) error
+	AddPluginRepository(repoName string, repoURL string, publicKey string) error
 }
 
 type AddPluginRepoCommand struct {
 	RequiredArgs      flag.AddPluginRepoArgs `positional-args:"yes"`
-	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo"`
+	PublicKey         string                 `long:"public-key" description:"Minisign or Ed25519 public key used to verify the signatures of the repository's plugins"`
+	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL [--public-key KEY]\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo --public-key RWQf6LRCGA9i53mlYecO4IzT51TGPpvWucNSCh1CBM0QTaLn73Y7GFO3"`
 	relatedCommands   interface{}            `related_commands:"install-plugin, list-plugin-repos"`
 	SkipSSLValidation bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	UI                command.UI
@@ -32,7 +33,7 @@ func (cmd *AddPluginRepoCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd AddPluginRepoCommand) Execute(args []string) error {
-	err := cmd.Actor.AddPluginRepository(cmd.RequiredArgs.PluginRepoName, cmd.RequiredArgs.PluginRepoURL)
+	err := cmd.Actor.AddPluginRepository(cmd.RequiredArgs.PluginRepoName, cmd.RequiredArgs.PluginRepoURL, cmd.PublicKey)
 	switch e := err.(type) {
 	case actionerror.RepositoryAlreadyExistsError:
 		cmd.UI.DisplayTextWithFlavor("{{.RepositoryURL}} already registered as {{.RepositoryName}}",
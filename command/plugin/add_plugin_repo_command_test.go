@@ -56,7 +56,7 @@ var _ = Describe("add-plugin-repo command", func() {
 			Expect(testUI.Out).To(Say("https://some-repo-URL already registered as some-repo"))
 
 			Expect(fakeActor.AddPluginRepositoryCallCount()).To(Equal(1))
-			repoName, repoURL := fakeActor.AddPluginRepositoryArgsForCall(0)
+			repoName, repoURL, _ := fakeActor.AddPluginRepositoryArgsForCall(0)
 			Expect(repoName).To(Equal("some-repo"))
 			Expect(repoURL).To(Equal("some-repo-URL"))
 		})
@@ -87,9 +87,24 @@ var _ = Describe("add-plugin-repo command", func() {
 			Expect(testUI.Out).To(Say("https://some-repo-URL added as some-repo"))
 
 			Expect(fakeActor.AddPluginRepositoryCallCount()).To(Equal(1))
-			repoName, repoURL := fakeActor.AddPluginRepositoryArgsForCall(0)
+			repoName, repoURL, publicKey := fakeActor.AddPluginRepositoryArgsForCall(0)
 			Expect(repoName).To(Equal("some-repo"))
 			Expect(repoURL).To(Equal("https://some-repo-URL"))
+			Expect(publicKey).To(BeEmpty())
+		})
+
+		Context("when a public key is provided", func() {
+			BeforeEach(func() {
+				cmd.PublicKey = "some-public-key"
+			})
+
+			It("adds the plugin repo with the public key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.AddPluginRepositoryCallCount()).To(Equal(1))
+				_, _, publicKey := fakeActor.AddPluginRepositoryArgsForCall(0)
+				Expect(publicKey).To(Equal("some-public-key"))
+			})
 		})
 	})
 })
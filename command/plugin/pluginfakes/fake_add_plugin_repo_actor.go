@@ -8,11 +8,12 @@ import (
 )
 
 type FakeAddPluginRepoActor struct {
-	AddPluginRepositoryStub        func(repoName string, repoURL string) error
+	AddPluginRepositoryStub        func(repoName string, repoURL string, publicKey string) error
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
-		repoName string
-		repoURL  string
+		repoName  string
+		repoURL   string
+		publicKey string
 	}
 	addPluginRepositoryReturns struct {
 		result1 error
@@ -24,17 +25,18 @@ type FakeAddPluginRepoActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAddPluginRepoActor) AddPluginRepository(repoName string, repoURL string) error {
+func (fake *FakeAddPluginRepoActor) AddPluginRepository(repoName string, repoURL string, publicKey string) error {
 	fake.addPluginRepositoryMutex.Lock()
 	ret, specificReturn := fake.addPluginRepositoryReturnsOnCall[len(fake.addPluginRepositoryArgsForCall)]
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
-		repoName string
-		repoURL  string
-	}{repoName, repoURL})
-	fake.recordInvocation("AddPluginRepository", []interface{}{repoName, repoURL})
+		repoName  string
+		repoURL   string
+		publicKey string
+	}{repoName, repoURL, publicKey})
+	fake.recordInvocation("AddPluginRepository", []interface{}{repoName, repoURL, publicKey})
 	fake.addPluginRepositoryMutex.Unlock()
 	if fake.AddPluginRepositoryStub != nil {
-		return fake.AddPluginRepositoryStub(repoName, repoURL)
+		return fake.AddPluginRepositoryStub(repoName, repoURL, publicKey)
 	}
 	if specificReturn {
 		return ret.result1
@@ -48,10 +50,10 @@ func (fake *FakeAddPluginRepoActor) AddPluginRepositoryCallCount() int {
 	return len(fake.addPluginRepositoryArgsForCall)
 }
 
-func (fake *FakeAddPluginRepoActor) AddPluginRepositoryArgsForCall(i int) (string, string) {
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryArgsForCall(i int) (string, string, string) {
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
-	return fake.addPluginRepositoryArgsForCall[i].repoName, fake.addPluginRepositoryArgsForCall[i].repoURL
+	return fake.addPluginRepositoryArgsForCall[i].repoName, fake.addPluginRepositoryArgsForCall[i].repoURL, fake.addPluginRepositoryArgsForCall[i].publicKey
 }
 
 func (fake *FakeAddPluginRepoActor) AddPluginRepositoryReturns(result1 error) {
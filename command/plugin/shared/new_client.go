@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/api/plugin/wrapper"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
@@ -23,7 +24,11 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
 	if location != nil {
-		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		if config.TraceFormat() == configv3.TraceFormatJSONL {
+			pluginClient.WrapConnection(wrapper.NewJSONRequestLogger(ui.RequestLoggerJSONLWriter(location, config.TraceMaxSize())))
+		} else {
+			pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		}
 	}
 
 	pluginClient.WrapConnection(wrapper.NewRetryRequest(config.RequestRetryCount()))
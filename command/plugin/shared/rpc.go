@@ -3,6 +3,7 @@ package shared
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"time"
@@ -91,7 +92,48 @@ func (r RPCService) GetMetadata(path string) (configv3.Plugin, error) {
 				Options: command.UsageDetails.Options,
 			},
 		}
+
+		for _, flag := range command.Flags {
+			plugin.Commands[i].Flags = append(plugin.Commands[i].Flags, configv3.PluginFlag{
+				Name:        flag.Name,
+				ShortName:   flag.ShortName,
+				Description: flag.Description,
+				Type:        string(flag.Type),
+				Completion:  string(flag.Completion),
+				Choices:     flag.Choices,
+			})
+		}
+
+		for _, argument := range command.Arguments {
+			plugin.Commands[i].Arguments = append(plugin.Commands[i].Arguments, configv3.PluginArgument{
+				Name:        argument.Name,
+				Description: argument.Description,
+				Completion:  string(argument.Completion),
+				Choices:     argument.Choices,
+			})
+		}
 	}
 
 	return plugin, nil
 }
+
+// GetCompletions runs the plugin's Completer for the given command name and
+// words. The plugin's output is discarded.
+func (r RPCService) GetCompletions(path string, args []string) ([]string, error) {
+	err := r.rpcService.Start()
+	if err != nil {
+		return nil, err
+	}
+	defer r.rpcService.Stop()
+
+	cmd := exec.Command(path, append([]string{r.rpcService.Port(), "SendCompletions"}, args...)...)
+	cmd.Stdout = ioutil.Discard
+	cmd.Stderr = ioutil.Discard
+
+	err = cmd.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.rpcService.RpcCmd.PluginCompletions, nil
+}
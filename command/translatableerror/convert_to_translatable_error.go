@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -31,6 +32,8 @@ func ConvertToTranslatableError(err error) error {
 		return AssignDropletError(e)
 	case actionerror.CommandLineOptionsWithMultipleAppsError:
 		return CommandLineArgsWithMultipleAppsError{}
+	case actionerror.DeploymentCanceledError:
+		return DeploymentCanceledError{}
 	case actionerror.DockerPasswordNotSetError:
 		return DockerPasswordNotSetError{}
 	case actionerror.DomainNotFoundError:
@@ -45,6 +48,8 @@ func ConvertToTranslatableError(err error) error {
 		return HostnameWithTCPDomainError(e)
 	case actionerror.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case actionerror.HTTPStatusError:
+		return HTTPStatusError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
 	case actionerror.InvalidRouteError:
@@ -53,6 +58,10 @@ func ConvertToTranslatableError(err error) error {
 		return HostAndPathNotAllowedWithTCPDomainError(e)
 	case actionerror.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError(e)
+	case actionerror.LoginDeniedError:
+		return LoginDeniedError{}
+	case actionerror.LoginTimeoutError:
+		return LoginTimeoutError{}
 	case actionerror.MissingNameError:
 		return RequiredNameForPushError{}
 	case actionerror.NoCompatibleBinaryError:
@@ -75,18 +84,34 @@ func ConvertToTranslatableError(err error) error {
 		return OrganizationNotFoundError(e)
 	case actionerror.PasswordGrantTypeLogoutRequiredError:
 		return PasswordGrantTypeLogoutRequiredError(e)
+	case actionerror.PluginChecksumMismatchError:
+		return InvalidChecksumError{}
 	case actionerror.PluginCommandsConflictError:
 		return PluginCommandsConflictError(e)
 	case actionerror.PluginInvalidError:
 		return PluginInvalidError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
+	case actionerror.PluginRepositoryPublicKeyMissingError:
+		return PluginRepositoryPublicKeyMissingError(e)
+	case actionerror.PluginSignatureInvalidError:
+		return PluginSignatureInvalidError(e)
+	case actionerror.PluginSignatureMissingError:
+		return PluginSignatureMissingError(e)
 	case actionerror.ProcessInstanceNotFoundError:
 		return ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessInstanceNotRunningError:
 		return ProcessInstanceNotRunningError(e)
+	case actionerror.ProcessInstancesNotRunningError:
+		return ProcessInstancesNotRunningError(e)
 	case actionerror.ProcessNotFoundError:
 		return ProcessNotFoundError(e)
+	case actionerror.ProfileAlreadyExistsError:
+		return ProfileAlreadyExistsError(e)
+	case actionerror.ProfileNotFoundError:
+		return ProfileNotFoundError(e)
+	case actionerror.ProfileTargetedError:
+		return ProfileTargetedError(e)
 	case actionerror.PropertyCombinationError:
 		return PropertyCombinationError(e)
 	case actionerror.RepositoryNameTakenError:
@@ -139,6 +164,8 @@ func ConvertToTranslatableError(err error) error {
 		return SSLCertError(e)
 	case ccerror.UnverifiedServerError:
 		return InvalidSSLCertError(e)
+	case ccerror.UntargetedURLError:
+		return UntargetedURLError(e)
 
 	// Specific CC Errors
 	case ccerror.JobFailedError:
@@ -163,6 +190,12 @@ func ConvertToTranslatableError(err error) error {
 		return TriggerLegacyPushError{GlobalRelated: e.Fields}
 	case manifest.InterpolationError:
 		return InterpolationError(e)
+	case manifestparser.ValidationError:
+		var messages []string
+		for _, schemaErr := range e.Errors {
+			messages = append(messages, schemaErr.Error())
+		}
+		return ManifestValidationError{Errors: messages}
 
 	// Plugin Execution Errors
 	case pluginerror.RawHTTPStatusError:
@@ -173,6 +206,12 @@ func ConvertToTranslatableError(err error) error {
 		return DownloadPluginHTTPError{Message: e.Error()}
 
 	// SSH Errors
+	case ssherror.ChecksumCommandNotFoundError:
+		return SSHChecksumCommandNotFoundError{Command: e.Command}
+	case ssherror.ChecksumMismatchError:
+		return SSHChecksumMismatchError{Path: e.Path}
+	case ssherror.RecursiveCopyRequiredError:
+		return SSHRecursiveCopyRequiredError{Path: e.Path}
 	case ssherror.UnableToAuthenticateError:
 		return SSHUnableToAuthenticateError{}
 
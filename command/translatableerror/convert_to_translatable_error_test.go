@@ -12,6 +12,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -54,6 +55,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.CommandLineOptionsWithMultipleAppsError{},
 			CommandLineArgsWithMultipleAppsError{}),
 
+		Entry("actionerror.DeploymentCanceledError -> DeploymentCanceledError",
+			actionerror.DeploymentCanceledError{},
+			DeploymentCanceledError{}),
+
 		Entry("actionerror.DockerPasswordNotSetError -> DockerPasswordNotSetError",
 			actionerror.DockerPasswordNotSetError{},
 			DockerPasswordNotSetError{}),
@@ -86,6 +91,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.HTTPHealthCheckInvalidError{},
 			HTTPHealthCheckInvalidError{}),
 
+		Entry("actionerror.HTTPStatusError -> HTTPStatusError",
+			actionerror.HTTPStatusError{StatusCode: 404},
+			HTTPStatusError{StatusCode: 404}),
+
 		Entry("actionerror.InvalidHTTPRouteSettings -> PortNotAllowedWithHTTPDomainError",
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
@@ -98,6 +107,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidTCPRouteSettings{Domain: "some-domain"},
 			HostAndPathNotAllowedWithTCPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.LoginDeniedError -> LoginDeniedError",
+			actionerror.LoginDeniedError{},
+			LoginDeniedError{}),
+
+		Entry("actionerror.LoginTimeoutError -> LoginTimeoutError",
+			actionerror.LoginTimeoutError{},
+			LoginTimeoutError{}),
+
 		Entry("actionerror.MissingNameError -> RequiredNameForPushError",
 			actionerror.MissingNameError{},
 			RequiredNameForPushError{}),
@@ -142,6 +159,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PasswordGrantTypeLogoutRequiredError{},
 			PasswordGrantTypeLogoutRequiredError{}),
 
+		Entry("actionerror.PluginChecksumMismatchError -> InvalidChecksumError",
+			actionerror.PluginChecksumMismatchError{Algorithm: "sha256"},
+			InvalidChecksumError{}),
+
 		Entry("actionerror.PluginCommandConflictError -> PluginCommandConflictError",
 			actionerror.PluginCommandsConflictError{
 				PluginName:     "some-plugin",
@@ -168,6 +189,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
 
+		Entry("actionerror.PluginRepositoryPublicKeyMissingError -> PluginRepositoryPublicKeyMissingError",
+			actionerror.PluginRepositoryPublicKeyMissingError{RepositoryName: "some-repo"},
+			PluginRepositoryPublicKeyMissingError{RepositoryName: "some-repo"}),
+
+		Entry("actionerror.PluginSignatureInvalidError -> PluginSignatureInvalidError",
+			actionerror.PluginSignatureInvalidError{PluginName: "some-plugin", RepositoryName: "some-repo", Reason: "some-reason"},
+			PluginSignatureInvalidError{PluginName: "some-plugin", RepositoryName: "some-repo", Reason: "some-reason"}),
+
+		Entry("actionerror.PluginSignatureMissingError -> PluginSignatureMissingError",
+			actionerror.PluginSignatureMissingError{PluginName: "some-plugin", RepositoryName: "some-repo"},
+			PluginSignatureMissingError{PluginName: "some-plugin", RepositoryName: "some-repo"}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -176,10 +209,26 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 42}),
 
+		Entry("actionerror.ProcessInstancesNotRunningError -> ProcessInstancesNotRunningError",
+			actionerror.ProcessInstancesNotRunningError{ProcessType: "some-process-type"},
+			ProcessInstancesNotRunningError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.ProcessNotFoundError -> ProcessNotFoundError",
 			actionerror.ProcessNotFoundError{ProcessType: "some-process-type"},
 			ProcessNotFoundError{ProcessType: "some-process-type"}),
 
+		Entry("actionerror.ProfileAlreadyExistsError -> ProfileAlreadyExistsError",
+			actionerror.ProfileAlreadyExistsError{Name: "some-profile"},
+			ProfileAlreadyExistsError{Name: "some-profile"}),
+
+		Entry("actionerror.ProfileNotFoundError -> ProfileNotFoundError",
+			actionerror.ProfileNotFoundError{Name: "some-profile"},
+			ProfileNotFoundError{Name: "some-profile"}),
+
+		Entry("actionerror.ProfileTargetedError -> ProfileTargetedError",
+			actionerror.ProfileTargetedError{Name: "some-profile"},
+			ProfileTargetedError{Name: "some-profile"}),
+
 		Entry("actionerror.PropertyCombinationError -> PropertyCombinationError",
 			actionerror.PropertyCombinationError{Properties: []string{"property-1", "property-2"}},
 			PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
@@ -287,6 +336,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			InvalidSSLCertError{URL: "some-url"}),
 
+		Entry("ccerror.UntargetedURLError -> UntargetedURLError",
+			ccerror.UntargetedURLError{URL: "some-url", API: "some-api"},
+			UntargetedURLError{URL: "some-url", API: "some-api"}),
+
 		Entry("ccerror.UnprocessableEntityError with droplet message -> RunTaskError",
 			ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: Task must have a droplet. Specify droplet or assign current droplet to app."},
 			RunTaskError{Message: "App is not staged."}),
@@ -322,6 +375,16 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			manifest.InterpolationError{Err: errors.New("an-error")},
 			InterpolationError{Err: errors.New("an-error")}),
 
+		Entry("manifestparser.ValidationError -> ManifestValidationError",
+			manifestparser.ValidationError{Errors: []manifestparser.SchemaError{
+				{Path: "applications[0].memroy", Line: 4, Column: 3, Message: "is not a valid key"},
+				{Path: "applications[0].instances", Message: "must be an integer"},
+			}},
+			ManifestValidationError{Errors: []string{
+				"line 4, column 3: applications[0].memroy is not a valid key",
+				"applications[0].instances must be an integer",
+			}}),
+
 		// Plugin Errors
 		Entry("pluginerror.RawHTTPStatusError -> DownloadPluginHTTPError",
 			pluginerror.RawHTTPStatusError{Status: "some status"},
@@ -337,6 +400,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 		),
 
 		// SSH Error
+		Entry("ssherror.ChecksumCommandNotFoundError -> SSHChecksumCommandNotFoundError",
+			ssherror.ChecksumCommandNotFoundError{Command: "sha256sum"},
+			SSHChecksumCommandNotFoundError{Command: "sha256sum"}),
+
+		Entry("ssherror.ChecksumMismatchError -> SSHChecksumMismatchError",
+			ssherror.ChecksumMismatchError{Path: "some-path"},
+			SSHChecksumMismatchError{Path: "some-path"}),
+
+		Entry("ssherror.RecursiveCopyRequiredError -> SSHRecursiveCopyRequiredError",
+			ssherror.RecursiveCopyRequiredError{Path: "some-path"},
+			SSHRecursiveCopyRequiredError{Path: "some-path"}),
+
 		Entry("ssherror.UnableToAuthenticateError -> UnableToAuthenticateError",
 			ssherror.UnableToAuthenticateError{},
 			SSHUnableToAuthenticateError{}),
@@ -0,0 +1,11 @@
+package translatableerror
+
+type DeploymentCanceledError struct{}
+
+func (DeploymentCanceledError) Error() string {
+	return "Deployment canceled. The app continues to run its previous version."
+}
+
+func (e DeploymentCanceledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
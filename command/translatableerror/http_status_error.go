@@ -0,0 +1,17 @@
+package translatableerror
+
+// HTTPStatusError is returned when a request made with --fail receives a 4xx
+// or 5xx response.
+type HTTPStatusError struct {
+	StatusCode int
+}
+
+func (HTTPStatusError) Error() string {
+	return "The requested URL returned error: {{.StatusCode}}"
+}
+
+func (e HTTPStatusError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"StatusCode": e.StatusCode,
+	})
+}
@@ -0,0 +1,11 @@
+package translatableerror
+
+type LoginTimeoutError struct{}
+
+func (LoginTimeoutError) Error() string {
+	return "Timed out waiting for the login to complete. Run the command again to start a new login."
+}
+
+func (e LoginTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
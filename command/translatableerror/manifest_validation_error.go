@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ManifestValidationError is returned when the manifest does not match the
+// manifest schema.
+type ManifestValidationError struct {
+	Errors []string
+}
+
+func (ManifestValidationError) Error() string {
+	return "The manifest is invalid:\n{{.Errors}}"
+}
+
+func (e ManifestValidationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Errors": strings.Join(e.Errors, "\n"),
+	})
+}
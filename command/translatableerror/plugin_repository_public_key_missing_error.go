@@ -0,0 +1,15 @@
+package translatableerror
+
+type PluginRepositoryPublicKeyMissingError struct {
+	RepositoryName string
+}
+
+func (PluginRepositoryPublicKeyMissingError) Error() string {
+	return "Repository {{.RepositoryName}} has no public key to verify plugin signatures with.\nRe-add the repository with add-plugin-repo --public-key."
+}
+
+func (e PluginRepositoryPublicKeyMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"RepositoryName": e.RepositoryName,
+	})
+}
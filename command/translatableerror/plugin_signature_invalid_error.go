@@ -0,0 +1,19 @@
+package translatableerror
+
+type PluginSignatureInvalidError struct {
+	PluginName     string
+	RepositoryName string
+	Reason         string
+}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "Signature of plugin {{.PluginName}} from repository {{.RepositoryName}} could not be verified: {{.Reason}}\nThe plugin binary may have been tampered with. Please contact the plugin author."
+}
+
+func (e PluginSignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":     e.PluginName,
+		"RepositoryName": e.RepositoryName,
+		"Reason":         e.Reason,
+	})
+}
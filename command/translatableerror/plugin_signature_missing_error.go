@@ -0,0 +1,17 @@
+package translatableerror
+
+type PluginSignatureMissingError struct {
+	PluginName     string
+	RepositoryName string
+}
+
+func (PluginSignatureMissingError) Error() string {
+	return "Plugin {{.PluginName}} from repository {{.RepositoryName}} is not signed."
+}
+
+func (e PluginSignatureMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":     e.PluginName,
+		"RepositoryName": e.RepositoryName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginSignatureRequiredError is returned when --require-signature is used to
+// install a plugin from a local file or a URL, which cannot be verified.
+type PluginSignatureRequiredError struct {
+	Path string
+}
+
+func (PluginSignatureRequiredError) Error() string {
+	return "Plugin {{.Path}} cannot be verified because only plugins installed from a repository are signed."
+}
+
+func (e PluginSignatureRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
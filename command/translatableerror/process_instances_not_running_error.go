@@ -0,0 +1,17 @@
+package translatableerror
+
+// ProcessInstancesNotRunningError is returned when trying to perform an action
+// on every running instance of a process that has none.
+type ProcessInstancesNotRunningError struct {
+	ProcessType string
+}
+
+func (ProcessInstancesNotRunningError) Error() string {
+	return "No instances of process {{.ProcessType}} running"
+}
+
+func (e ProcessInstancesNotRunningError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProcessType": e.ProcessType,
+	})
+}
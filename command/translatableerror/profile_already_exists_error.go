@@ -0,0 +1,15 @@
+package translatableerror
+
+type ProfileAlreadyExistsError struct {
+	Name string
+}
+
+func (ProfileAlreadyExistsError) Error() string {
+	return "Profile '{{.Name}}' already exists."
+}
+
+func (e ProfileAlreadyExistsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type ProfileTargetedError struct {
+	Name string
+}
+
+func (ProfileTargetedError) Error() string {
+	return "Profile '{{.Name}}' is currently targeted. Target another profile before deleting it."
+}
+
+func (e ProfileTargetedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// PushDryRunChangesError is returned when a push with --dry-run finds that
+// pushing would change one or more applications.
+type PushDryRunChangesError struct {
+	AppNames []string
+}
+
+func (PushDryRunChangesError) Error() string {
+	return "Dry run found changes to apply to: {{.AppNames}}"
+}
+
+func (e PushDryRunChangesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// RecentLogsForMultipleAppsError is returned when recent logs are requested
+// for more than one app.
+type RecentLogsForMultipleAppsError struct{}
+
+func (RecentLogsForMultipleAppsError) DisplayUsage() {}
+
+func (RecentLogsForMultipleAppsError) Error() string {
+	return "Incorrect Usage: '--recent' can only be used with a single APP_NAME."
+}
+
+func (e RecentLogsForMultipleAppsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type SSHChecksumCommandNotFoundError struct {
+	Command string
+}
+
+func (SSHChecksumCommandNotFoundError) Error() string {
+	return "Unable to verify copied files: {{.Command}} is not available in the app container."
+}
+
+func (e SSHChecksumCommandNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+	})
+}
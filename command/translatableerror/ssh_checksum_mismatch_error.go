@@ -0,0 +1,15 @@
+package translatableerror
+
+type SSHChecksumMismatchError struct {
+	Path string
+}
+
+func (SSHChecksumMismatchError) Error() string {
+	return "Copied file {{.Path}} does not match the checksum of the original file.\nPlease try again."
+}
+
+func (e SSHChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// SSHCommandFailedOnInstancesError is returned when a command run on every
+// instance of a process fails or exits non-zero on any of them.
+type SSHCommandFailedOnInstancesError struct {
+	InstanceIndexes string
+}
+
+func (SSHCommandFailedOnInstancesError) Error() string {
+	return "Command failed on instances: {{.InstanceIndexes}}"
+}
+
+func (e SSHCommandFailedOnInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"InstanceIndexes": e.InstanceIndexes,
+	})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+type SSHCopyRemotePathError struct{}
+
+func (SSHCopyRemotePathError) DisplayUsage() {}
+
+func (SSHCopyRemotePathError) Error() string {
+	return "Incorrect Usage: exactly one of SOURCE and DESTINATION must be an APP_NAME[:INDEX]:PATH in an app instance"
+}
+
+func (e SSHCopyRemotePathError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type SSHRecursiveCopyRequiredError struct {
+	Path string
+}
+
+func (SSHRecursiveCopyRequiredError) Error() string {
+	return "{{.Path}} is a directory. Use --recursive to copy directories."
+}
+
+func (e SSHRecursiveCopyRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
@@ -47,6 +47,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
+		Entry("DeploymentCanceledError", DeploymentCanceledError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
@@ -66,8 +67,11 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("LoginDeniedError", LoginDeniedError{}),
+		Entry("LoginTimeoutError", LoginTimeoutError{}),
 		Entry("ManifestCreationError", ManifestCreationError{}),
 		Entry("ManifestFileNotFoundInDirectoryError", ManifestFileNotFoundInDirectoryError{}),
+		Entry("ManifestValidationError", ManifestValidationError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
 		Entry("MinimumCLIVersionNotMetError", MinimumCLIVersionNotMetError{}),
 		Entry("MissingCredentialsError", MissingCredentialsError{}),
@@ -92,10 +96,20 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PluginRepositoryPublicKeyMissingError", PluginRepositoryPublicKeyMissingError{}),
+		Entry("PluginSignatureInvalidError", PluginSignatureInvalidError{}),
+		Entry("PluginSignatureMissingError", PluginSignatureMissingError{}),
+		Entry("PluginSignatureRequiredError", PluginSignatureRequiredError{}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
 		Entry("ProcessInstanceNotFoundError", ProcessInstanceNotFoundError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("ProcessInstanceNotRunningError", ProcessInstanceNotRunningError{ProcessType: "some-process", InstanceIndex: 1}),
+		Entry("ProcessInstancesNotRunningError", ProcessInstancesNotRunningError{ProcessType: "some-process"}),
+		Entry("ProfileAlreadyExistsError", ProfileAlreadyExistsError{}),
+		Entry("ProfileNotFoundError", ProfileNotFoundError{}),
+		Entry("ProfileTargetedError", ProfileTargetedError{}),
 		Entry("PropertyCombinationError", PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
+		Entry("PushDryRunChangesError", PushDryRunChangesError{AppNames: []string{"app-1", "app-2"}}),
+		Entry("RecentLogsForMultipleAppsError", RecentLogsForMultipleAppsError{}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
@@ -108,6 +122,11 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
 		Entry("SharedServiceInstanceNotFoundError", SharedServiceInstanceNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SSHChecksumCommandNotFoundError", SSHChecksumCommandNotFoundError{}),
+		Entry("SSHChecksumMismatchError", SSHChecksumMismatchError{}),
+		Entry("SSHCommandFailedOnInstancesError", SSHCommandFailedOnInstancesError{}),
+		Entry("SSHCopyRemotePathError", SSHCopyRemotePathError{}),
+		Entry("SSHRecursiveCopyRequiredError", SSHRecursiveCopyRequiredError{}),
 		Entry("SSHUnableToAuthenticateError", SSHUnableToAuthenticateError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
@@ -119,7 +138,9 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
+		Entry("UnsupportedResourceTypeError", UnsupportedResourceTypeError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
+		Entry("UntargetedURLError", UntargetedURLError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
 	)
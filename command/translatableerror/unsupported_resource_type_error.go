@@ -0,0 +1,21 @@
+package translatableerror
+
+// UnsupportedResourceTypeError is returned when a command is given a resource
+// type that it does not support.
+type UnsupportedResourceTypeError struct {
+	ResourceType   string
+	SupportedTypes string
+}
+
+func (UnsupportedResourceTypeError) DisplayUsage() {}
+
+func (UnsupportedResourceTypeError) Error() string {
+	return "Incorrect Usage: Unsupported resource type '{{.ResourceType}}'. Supported resource types are: {{.SupportedTypes}}"
+}
+
+func (e UnsupportedResourceTypeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ResourceType":   e.ResourceType,
+		"SupportedTypes": e.SupportedTypes,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// UntargetedURLError is returned when a fully qualified URL does not point at
+// the targeted API, so the request would send credentials to another host.
+type UntargetedURLError struct {
+	URL string
+	API string
+}
+
+func (UntargetedURLError) Error() string {
+	return "The URL {{.URL}} is not on the targeted API {{.API}}.\nTIP: Provide a path relative to the API, such as '/v2/apps'."
+}
+
+func (e UntargetedURLError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL": e.URL,
+		"API": e.API,
+	})
+}
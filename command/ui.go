@@ -9,6 +9,7 @@ import (
 
 // UI is the interface to STDOUT, STDERR, and STDIN.
 type UI interface {
+	DisplayAppLogMessage(message ui.AppLogMessage)
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
@@ -19,9 +20,11 @@ type UI interface {
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayKeyValueTableForV3App(table [][]string, crashedProcesses []string)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
+	DisplayLogMessageJSON(message ui.LogMessage) error
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
+	DisplayStructuredOutput(document interface{}) error
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
@@ -31,7 +34,9 @@ type UI interface {
 	GetIn() io.Reader
 	GetOut() io.Writer
 	GetErr() io.Writer
+	IsStructuredOutput() bool
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
+	RequestLoggerJSONLWriter(filePaths []string, maxSize int64) *ui.RequestLoggerJSONLWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
@@ -41,6 +41,8 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (AppCommand) SupportsStructuredOutput() {}
+
 func (cmd AppCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -71,15 +73,17 @@ func (cmd AppCommand) displayAppSummary() error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor(
-		"Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor(
+			"Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   cmd.RequiredArgs.AppName,
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -87,6 +91,10 @@ func (cmd AppCommand) displayAppSummary() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(shared.NewAppSummaryDocument(appSummary))
+	}
+
 	shared.DisplayAppSummary(cmd.UI, appSummary, false)
 
 	return nil
@@ -325,6 +325,35 @@ var _ = Describe("App Command", func() {
 							})
 						})
 					})
+					Context("when structured output is requested", func() {
+						BeforeEach(func() {
+							testUI.OutputFormat = configv3.OutputFormatJSON
+							applicationSummary.RunningInstances = []v2action.ApplicationInstanceWithStats{}
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						It("displays the app summary as JSON and all warnings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).ToNot(Say("Showing health and status"))
+							Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+								"guid": "some-app-guid",
+								"name": "some-app",
+								"requested_state": "started",
+								"instances": 3,
+								"running_instances": 0,
+								"memory_in_mb": 128,
+								"disk_in_mb": 0,
+								"isolation_segment": "some-isolation-segment",
+								"routes": ["banana.fruit.com/hi", "foobar.com:13"],
+								"last_uploaded": "1970-01-01T00:00:00Z",
+								"stack": "potatos",
+								"buildpack": "some-buildpack",
+								"instance_details": []
+							}`))
+
+							Expect(testUI.Err).To(Say("app-summary-warning"))
+						})
+					})
 				})
 
 				Context("when an error is encountered getting app summary", func() {
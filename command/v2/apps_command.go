@@ -1,19 +1,104 @@
 package v2
 
 import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationSummariesBySpace(spaceGUID string) ([]v2action.ApplicationSummary, v2action.Warnings, error)
+}
+
+type appsDocument struct {
+	Apps []appDocument `json:"apps" yaml:"apps"`
+}
+
+type appDocument struct {
+	GUID           string   `json:"guid" yaml:"guid"`
+	Name           string   `json:"name" yaml:"name"`
+	RequestedState string   `json:"requested_state" yaml:"requested_state"`
+	Instances      int      `json:"instances" yaml:"instances"`
+	MemoryInMB     uint64   `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB       uint64   `json:"disk_in_mb" yaml:"disk_in_mb"`
+	Routes         []string `json:"routes" yaml:"routes"`
+}
+
 type AppsCommand struct {
 	usage           interface{} `usage:"CF_NAME apps"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (AppsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	// Only structured output has been refactored; the table is still displayed
+	// by the legacy command.
+	if !ui.IsStructuredOutput() {
+		return nil
+	}
+
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil, config)
+
 	return nil
 }
 
-func (AppsCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (AppsCommand) SupportsStructuredOutput() {}
+
+func (cmd AppsCommand) Execute(args []string) error {
+	if !cmd.UI.IsStructuredOutput() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	appSummaries, warnings, err := cmd.Actor.GetApplicationSummariesBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	document := appsDocument{Apps: []appDocument{}}
+	for _, appSummary := range appSummaries {
+		document.Apps = append(document.Apps, newAppDocument(appSummary))
+	}
+
+	return cmd.UI.DisplayStructuredOutput(document)
+}
+
+func newAppDocument(appSummary v2action.ApplicationSummary) appDocument {
+	document := appDocument{
+		GUID:           appSummary.GUID,
+		Name:           appSummary.Name,
+		RequestedState: strings.ToLower(string(appSummary.State)),
+		Instances:      appSummary.Instances.Value,
+		MemoryInMB:     appSummary.Memory.Value,
+		DiskInMB:       appSummary.DiskQuota.Value,
+		Routes:         []string{},
+	}
+	for _, route := range appSummary.Routes {
+		document.Routes = append(document.Routes, route.String())
+	}
+	return document
 }
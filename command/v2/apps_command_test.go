@@ -0,0 +1,161 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when structured output is not requested", func() {
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when structured output is requested", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputFormatJSON
+		})
+
+		Context("when checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationSummariesBySpaceReturns(nil, v2action.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and displays the warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+			})
+		})
+
+		Context("when there are no apps", func() {
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{"apps": []}`))
+			})
+		})
+
+		Context("when there are apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationSummariesBySpaceReturns(
+					[]v2action.ApplicationSummary{
+						{
+							Application: v2action.Application{
+								GUID:      "app-guid-1",
+								Name:      "app-1",
+								State:     constant.ApplicationStarted,
+								Instances: types.NullInt{IsSet: true, Value: 2},
+								Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+								DiskQuota: types.NullByteSizeInMb{IsSet: true, Value: 1024},
+							},
+							Routes: []v2action.Route{
+								{Host: "app-1", Domain: v2action.Domain{Name: "example.com"}},
+							},
+						},
+						{
+							Application: v2action.Application{
+								GUID:  "app-guid-2",
+								Name:  "app-2",
+								State: constant.ApplicationStopped,
+							},
+						},
+					},
+					v2action.Warnings{"get-apps-warning"},
+					nil)
+			})
+
+			It("displays the apps as a JSON document and the warnings to stderr", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationSummariesBySpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+					"apps": [
+						{
+							"guid": "app-guid-1",
+							"name": "app-1",
+							"requested_state": "started",
+							"instances": 2,
+							"memory_in_mb": 256,
+							"disk_in_mb": 1024,
+							"routes": ["app-1.example.com"]
+						},
+						{
+							"guid": "app-guid-2",
+							"name": "app-2",
+							"requested_state": "stopped",
+							"instances": 0,
+							"memory_in_mb": 0,
+							"disk_in_mb": 0,
+							"routes": []
+						}
+					]
+				}`))
+
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+			})
+		})
+	})
+})
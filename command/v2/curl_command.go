@@ -1,25 +1,151 @@
 package v2
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . CurlActor
+
+type CurlActor interface {
+	MakeCurlRequest(httpMethod string, path string, customHeaders []string, httpData string, failOnHTTPError bool) ([]byte, *http.Response, v2action.Warnings, error)
+	MakePaginatedCurlRequest(path string, customHeaders []string, failOnHTTPError bool) ([]byte, *http.Response, v2action.Warnings, error)
+}
+
 type CurlCommand struct {
 	RequiredArgs          flag.APIPath    `positional-args:"yes"`
 	CustomHeaders         []string        `short:"H" description:"Custom headers to include in the request, flag can be specified multiple times"`
 	HTTPMethod            string          `short:"X" description:"HTTP method (GET,POST,PUT,DELETE,etc)"`
 	HTTPData              flag.PathWithAt `short:"d" description:"HTTP data to include in the request body, or '@' followed by a file name to read the data from"`
+	Fail                  bool            `long:"fail" short:"f" description:"Exit with a non-zero exit code when the response has an HTTP status code of 400 or above"`
 	IncludeReponseHeaders bool            `short:"i" description:"Include response headers in the output"`
 	OutputFile            flag.Path       `long:"output" description:"Write curl body to FILE instead of stdout"`
-	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file"`
+	Paginate              bool            `long:"paginate" description:"Follow the pagination links of a GET request and combine the resources of all pages into a single JSON array"`
+	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--paginate] [--fail]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file\n   CF_NAME curl \"/v3/apps\" --paginate"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  CurlActor
 }
 
-func (CurlCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CurlCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	ccClient, _, err := shared.NewClients(curlConfig{Config: config}, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil, config)
+
 	return nil
 }
 
-func (CurlCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+// curlConfig bypasses the response cache, so that curl always shows the
+// current response of the Cloud Controller. Changes made with curl still
+// remove cached responses.
+type curlConfig struct {
+	command.Config
+}
+
+func (curlConfig) NoCache() bool {
+	return true
+}
+
+func (cmd CurlCommand) Execute(args []string) error {
+	if cmd.Paginate {
+		if cmd.HTTPData != "" {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"--paginate", "-d"},
+			}
+		}
+		if cmd.HTTPMethod != "" && !strings.EqualFold(cmd.HTTPMethod, http.MethodGet) {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"--paginate", fmt.Sprintf("-X %s", cmd.HTTPMethod)},
+			}
+		}
+	}
+
+	httpData, err := cmd.httpData()
+	if err != nil {
+		return err
+	}
+
+	var (
+		responseBody []byte
+		response     *http.Response
+		warnings     v2action.Warnings
+	)
+	if cmd.Paginate {
+		responseBody, response, warnings, err = cmd.Actor.MakePaginatedCurlRequest(cmd.RequiredArgs.Path, cmd.CustomHeaders, cmd.Fail)
+	} else {
+		responseBody, response, warnings, err = cmd.Actor.MakeCurlRequest(cmd.HTTPMethod, cmd.RequiredArgs.Path, cmd.CustomHeaders, httpData, cmd.Fail)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	// The request logger has already displayed the response.
+	if verbose, _ := cmd.Config.Verbose(); verbose {
+		return nil
+	}
+
+	if cmd.IncludeReponseHeaders {
+		responseHeaders, dumpErr := httputil.DumpResponse(response, false)
+		if dumpErr != nil {
+			return dumpErr
+		}
+		fmt.Fprint(cmd.UI.GetOut(), string(responseHeaders))
+	}
+
+	if cmd.OutputFile != "" {
+		return cmd.writeToFile(responseBody)
+	}
+
+	if strings.Contains(response.Header.Get("Content-Type"), "application/json") || cmd.Paginate {
+		var buffer bytes.Buffer
+		if json.Indent(&buffer, responseBody, "", "   ") == nil {
+			responseBody = buffer.Bytes()
+		}
+	}
+
+	fmt.Fprintln(cmd.UI.GetOut(), string(responseBody))
+	return nil
+}
+
+func (cmd CurlCommand) httpData() (string, error) {
+	if !strings.HasPrefix(string(cmd.HTTPData), "@") {
+		return string(cmd.HTTPData), nil
+	}
+
+	path := strings.TrimPrefix(string(cmd.HTTPData), "@")
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", translatableerror.FileNotFoundError{Path: path}
+	}
+	return string(data), err
+}
+
+func (cmd CurlCommand) writeToFile(responseBody []byte) error {
+	path := string(cmd.OutputFile)
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, responseBody, 0644)
 }
@@ -0,0 +1,247 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("curl Command", func() {
+	var (
+		cmd        CurlCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeCurlActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeCurlActor)
+
+		cmd = CurlCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		cmd.RequiredArgs.Path = "/v2/some-path"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the request succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.MakeCurlRequestReturns(
+				[]byte(`{"some":"response"}`),
+				&http.Response{
+					Status:     "200 OK",
+					StatusCode: http.StatusOK,
+					ProtoMajor: 1,
+					ProtoMinor: 1,
+					Header:     http.Header{"Content-Type": {"application/json;charset=utf-8"}},
+				},
+				v2action.Warnings{"curl-warning"},
+				nil)
+		})
+
+		It("displays the indented response body and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("{\n   \"some\": \"response\"\n}\n"))
+			Expect(testUI.Err).To(Say("curl-warning"))
+
+			Expect(fakeActor.MakeCurlRequestCallCount()).To(Equal(1))
+			method, path, headers, data, failOnHTTPError := fakeActor.MakeCurlRequestArgsForCall(0)
+			Expect(method).To(BeEmpty())
+			Expect(path).To(Equal("/v2/some-path"))
+			Expect(headers).To(BeEmpty())
+			Expect(data).To(BeEmpty())
+			Expect(failOnHTTPError).To(BeFalse())
+		})
+
+		Context("when the method, headers, data and --fail are provided", func() {
+			BeforeEach(func() {
+				cmd.HTTPMethod = "PUT"
+				cmd.CustomHeaders = []string{"X-Custom: some-value"}
+				cmd.HTTPData = `{"some":"data"}`
+				cmd.Fail = true
+			})
+
+			It("passes them to the actor", func() {
+				Expect(fakeActor.MakeCurlRequestCallCount()).To(Equal(1))
+				method, _, headers, data, failOnHTTPError := fakeActor.MakeCurlRequestArgsForCall(0)
+				Expect(method).To(Equal("PUT"))
+				Expect(headers).To(ConsistOf("X-Custom: some-value"))
+				Expect(data).To(Equal(`{"some":"data"}`))
+				Expect(failOnHTTPError).To(BeTrue())
+			})
+		})
+
+		Context("when the data is read from a file", func() {
+			var tempFile *os.File
+
+			BeforeEach(func() {
+				var err error
+				tempFile, err = ioutil.TempFile("", "curl-data")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tempFile.WriteString(`{"file":"data"}`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFile.Close()).To(Succeed())
+
+				cmd.HTTPData = flag.PathWithAt("@" + tempFile.Name())
+			})
+
+			AfterEach(func() {
+				Expect(os.Remove(tempFile.Name())).To(Succeed())
+			})
+
+			It("passes the contents of the file to the actor", func() {
+				_, _, _, data, _ := fakeActor.MakeCurlRequestArgsForCall(0)
+				Expect(data).To(Equal(`{"file":"data"}`))
+			})
+		})
+
+		Context("when the data file does not exist", func() {
+			BeforeEach(func() {
+				cmd.HTTPData = "@/some/missing/file"
+			})
+
+			It("returns a FileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.FileNotFoundError{Path: "/some/missing/file"}))
+				Expect(fakeActor.MakeCurlRequestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when -i is provided", func() {
+			BeforeEach(func() {
+				cmd.IncludeReponseHeaders = true
+			})
+
+			It("displays the response headers before the body", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("HTTP/1.1 200 OK"))
+				Expect(testUI.Out).To(Say("Content-Type: application/json;charset=utf-8"))
+				Expect(testUI.Out).To(Say("\"some\": \"response\""))
+			})
+		})
+
+		Context("when --output is provided", func() {
+			var outputDir string
+
+			BeforeEach(func() {
+				var err error
+				outputDir, err = ioutil.TempDir("", "curl-output")
+				Expect(err).ToNot(HaveOccurred())
+
+				cmd.OutputFile = flag.Path(filepath.Join(outputDir, "some-dir", "output.json"))
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(outputDir)).To(Succeed())
+			})
+
+			It("writes the response body to the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				contents, err := ioutil.ReadFile(string(cmd.OutputFile))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal(`{"some":"response"}`))
+				Expect(testUI.Out).ToNot(Say("response"))
+			})
+		})
+
+		Context("when the request logger displays to the terminal", func() {
+			BeforeEach(func() {
+				fakeConfig.VerboseReturns(true, nil)
+			})
+
+			It("does not display the response body again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("response"))
+			})
+		})
+	})
+
+	Context("when --paginate is provided", func() {
+		BeforeEach(func() {
+			cmd.Paginate = true
+			cmd.Fail = true
+			cmd.CustomHeaders = []string{"X-Custom: some-value"}
+			fakeActor.MakePaginatedCurlRequestReturns(
+				[]byte(`[{"name":"app-1"},{"name":"app-2"}]`),
+				&http.Response{StatusCode: http.StatusOK, Header: http.Header{}},
+				v2action.Warnings{"page-warning-1", "page-warning-2"},
+				nil)
+		})
+
+		It("displays the merged resources and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("\\[\n   {\n      \"name\": \"app-1\"\n   },\n   {\n      \"name\": \"app-2\"\n   }\n\\]\n"))
+			Expect(testUI.Err).To(Say("page-warning-1"))
+			Expect(testUI.Err).To(Say("page-warning-2"))
+
+			Expect(fakeActor.MakeCurlRequestCallCount()).To(Equal(0))
+			Expect(fakeActor.MakePaginatedCurlRequestCallCount()).To(Equal(1))
+			path, headers, failOnHTTPError := fakeActor.MakePaginatedCurlRequestArgsForCall(0)
+			Expect(path).To(Equal("/v2/some-path"))
+			Expect(headers).To(ConsistOf("X-Custom: some-value"))
+			Expect(failOnHTTPError).To(BeTrue())
+		})
+
+		Context("when data is provided", func() {
+			BeforeEach(func() {
+				cmd.HTTPData = "some-data"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--paginate", "-d"},
+				}))
+			})
+		})
+
+		Context("when a method other than GET is provided", func() {
+			BeforeEach(func() {
+				cmd.HTTPMethod = "POST"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--paginate", "-X POST"},
+				}))
+			})
+		})
+	})
+
+	Context("when the actor returns an error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.MakeCurlRequestReturns(nil, nil, v2action.Warnings{"curl-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("curl-warning"))
+		})
+	})
+})
@@ -1,12 +1,36 @@
 package v2
 
 import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/browser"
 )
 
+//go:generate counterfeiter . LoginActor
+
+type LoginActor interface {
+	CompleteBrowserAuthentication(auth v2action.BrowserAuthentication) error
+	CompleteDeviceAuthentication(authorization v2action.DeviceAuthorization) error
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	StartBrowserAuthentication() (v2action.BrowserAuthentication, error)
+	StartDeviceAuthentication() (v2action.DeviceAuthorization, error)
+}
+
+//go:generate counterfeiter . BrowserLauncher
+
+type BrowserLauncher interface {
+	Open(url string) error
+}
+
 type LoginCommand struct {
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Log in with a web browser"`
+	Device            bool        `long:"device" description:"Log in by entering a code on another device"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -14,14 +38,177 @@ type LoginCommand struct {
 	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n   CF_NAME login (--browser | --device) [-o ORG] [-s SPACE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --browser (log in with a web browser on this machine)\n   CF_NAME login --device (log in from a web browser on another device, e.g. over SSH)"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
+
+	UI       command.UI
+	Config   command.Config
+	Actor    LoginActor
+	Launcher BrowserLauncher
 }
 
-func (LoginCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
+	// Only browser and device logins are refactored; the other logins run
+	// the legacy command, which creates its own clients.
+	if !cmd.Browser && !cmd.Device {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Launcher = browser.Launcher{}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (LoginCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd LoginCommand) Execute(args []string) error {
+	if !cmd.Browser && !cmd.Device {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = command.WarnCLIVersionCheck(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"API endpoint: {{.Endpoint}}",
+		map[string]interface{}{
+			"Endpoint": cmd.Config.Target(),
+		})
+
+	if cmd.Browser {
+		err = cmd.browserLogin()
+	} else {
+		err = cmd.deviceLogin()
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if cmd.Organization == "" {
+		cmd.UI.DisplayTextWithFlavor(
+			"Use '{{.Command}}' to view or set your target org and space.",
+			map[string]interface{}{
+				"Command": fmt.Sprintf("%s target", cmd.Config.BinaryName()),
+			})
+		return nil
+	}
+
+	return cmd.targetOrgAndSpace()
+}
+
+func (cmd LoginCommand) browserLogin() error {
+	auth, err := cmd.Actor.StartBrowserAuthentication()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Opening a browser to log in. If it does not open, visit the following URL:")
+	cmd.UI.DisplayText("{{.URL}}", map[string]interface{}{"URL": auth.URL})
+
+	err = cmd.Launcher.Open(auth.URL)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to open a browser: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	cmd.UI.DisplayText("Waiting for the login to complete...")
+	return cmd.Actor.CompleteBrowserAuthentication(auth)
+}
+
+func (cmd LoginCommand) deviceLogin() error {
+	authorization, err := cmd.Actor.StartDeviceAuthentication()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Visit {{.URL}} on any device and enter the code {{.UserCode}} to log in.",
+		map[string]interface{}{
+			"URL":      authorization.VerificationURI,
+			"UserCode": authorization.UserCode,
+		})
+
+	cmd.UI.DisplayText("Waiting for the login to complete...")
+	return cmd.Actor.CompleteDeviceAuthentication(authorization)
+}
+
+func (cmd LoginCommand) targetOrgAndSpace() error {
+	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+
+	if cmd.Space != "" {
+		space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		cmd.Config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Targeted org {{.Organization}}.",
+		map[string]interface{}{
+			"Organization": org.Name,
+		})
+	if cmd.Space != "" {
+		cmd.UI.DisplayTextWithFlavor(
+			"Targeted space {{.Space}}.",
+			map[string]interface{}{
+				"Space": cmd.Space,
+			})
+	}
+
+	return nil
+}
+
+func (cmd LoginCommand) validateArgs() error {
+	switch {
+	case cmd.Browser && cmd.Device:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--browser", "--device"}}
+	case cmd.Space != "" && cmd.Organization == "":
+		return translatableerror.RequiredFlagsError{Arg1: "-s", Arg2: "-o"}
+	}
+
+	flagName := "--browser"
+	if cmd.Device {
+		flagName = "--device"
+	}
+
+	conflicts := []struct {
+		isSet bool
+		name  string
+	}{
+		{cmd.APIEndpoint != "", "-a"},
+		{cmd.Username != "", "-u"},
+		{cmd.Password != "", "-p"},
+		{cmd.SkipSSLValidation, "--skip-ssl-validation"},
+		{cmd.SSO, "--sso"},
+		{cmd.SSOPasscode != "", "--sso-passcode"},
+	}
+	for _, conflict := range conflicts {
+		if conflict.isSet {
+			return translatableerror.ArgumentCombinationError{Args: []string{flagName, conflict.name}}
+		}
+	}
+
+	return nil
 }
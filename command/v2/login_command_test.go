@@ -0,0 +1,206 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("login Command", func() {
+	var (
+		cmd          LoginCommand
+		testUI       *ui.UI
+		fakeActor    *v2fakes.FakeLoginActor
+		fakeLauncher *v2fakes.FakeBrowserLauncher
+		fakeConfig   *commandfakes.FakeConfig
+		executeErr   error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v2fakes.FakeLoginActor)
+		fakeLauncher = new(v2fakes.FakeBrowserLauncher)
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = LoginCommand{
+			UI:       testUI,
+			Config:   fakeConfig,
+			Actor:    fakeActor,
+			Launcher: fakeLauncher,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("some-api-target")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when neither --browser nor --device is provided", func() {
+		It("runs the legacy login", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+		})
+	})
+
+	Context("when --browser and --device are both provided", func() {
+		BeforeEach(func() {
+			cmd.Browser = true
+			cmd.Device = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--browser", "--device"},
+			}))
+		})
+	})
+
+	Context("when --device is provided with credentials", func() {
+		BeforeEach(func() {
+			cmd.Device = true
+			cmd.Username = "some-user"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--device", "-u"},
+			}))
+		})
+	})
+
+	Context("when -s is provided without -o", func() {
+		BeforeEach(func() {
+			cmd.Browser = true
+			cmd.Space = "some-space"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-s", Arg2: "-o"}))
+		})
+	})
+
+	Context("when --browser is provided", func() {
+		var auth v2action.BrowserAuthentication
+
+		BeforeEach(func() {
+			cmd.Browser = true
+			auth = v2action.BrowserAuthentication{URL: "https://uaa.example.com/oauth/authorize"}
+			fakeActor.StartBrowserAuthenticationReturns(auth, nil)
+		})
+
+		It("opens the browser and waits for the login to complete", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("API endpoint: some-api-target"))
+			Expect(testUI.Out).To(Say("Opening a browser to log in"))
+			Expect(testUI.Out).To(Say("https://uaa.example.com/oauth/authorize"))
+			Expect(testUI.Out).To(Say("Waiting for the login to complete..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Use 'faceman target' to view or set your target org and space."))
+
+			Expect(fakeLauncher.OpenCallCount()).To(Equal(1))
+			Expect(fakeLauncher.OpenArgsForCall(0)).To(Equal("https://uaa.example.com/oauth/authorize"))
+			Expect(fakeActor.CompleteBrowserAuthenticationCallCount()).To(Equal(1))
+			Expect(fakeActor.CompleteBrowserAuthenticationArgsForCall(0)).To(Equal(auth))
+		})
+
+		Context("when the browser cannot be opened", func() {
+			BeforeEach(func() {
+				fakeLauncher.OpenReturns(errors.New("no browser"))
+			})
+
+			It("warns and keeps waiting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open a browser: no browser"))
+				Expect(fakeActor.CompleteBrowserAuthenticationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the login is denied", func() {
+			BeforeEach(func() {
+				fakeActor.CompleteBrowserAuthenticationReturns(actionerror.LoginDeniedError{})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginDeniedError{}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		Context("when -o and -s are provided", func() {
+			BeforeEach(func() {
+				cmd.Organization = "some-org"
+				cmd.Space = "some-space"
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org"}, v2action.Warnings{"org-warning"}, nil)
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid", Name: "some-space", AllowSSH: true}, v2action.Warnings{"space-warning"}, nil)
+			})
+
+			It("targets the org and space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Targeted org some-org."))
+				Expect(testUI.Out).To(Say("Targeted space some-space."))
+				Expect(testUI.Err).To(Say("org-warning"))
+				Expect(testUI.Err).To(Say("space-warning"))
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+				orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceName).To(Equal("some-space"))
+
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+				guid, name := fakeConfig.SetOrganizationInformationArgsForCall(0)
+				Expect(guid).To(Equal("some-org-guid"))
+				Expect(name).To(Equal("some-org"))
+				Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+				guid, name, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+				Expect(guid).To(Equal("some-space-guid"))
+				Expect(name).To(Equal("some-space"))
+				Expect(allowSSH).To(BeTrue())
+			})
+		})
+	})
+
+	Context("when --device is provided", func() {
+		BeforeEach(func() {
+			cmd.Device = true
+			fakeActor.StartDeviceAuthenticationReturns(v2action.DeviceAuthorization{
+				DeviceCode:      "some-device-code",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://uaa.example.com/device",
+			}, nil)
+		})
+
+		It("displays the user code and waits for the login to complete", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Visit https://uaa.example.com/device on any device and enter the code ABCD-EFGH to log in."))
+			Expect(testUI.Out).To(Say("Waiting for the login to complete..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeLauncher.OpenCallCount()).To(Equal(0))
+			Expect(fakeActor.CompleteDeviceAuthenticationCallCount()).To(Equal(1))
+			Expect(fakeActor.CompleteDeviceAuthenticationArgsForCall(0).DeviceCode).To(Equal("some-device-code"))
+		})
+
+		Context("when starting the authorization fails", func() {
+			BeforeEach(func() {
+				fakeActor.StartDeviceAuthenticationReturns(v2action.DeviceAuthorization{}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeActor.CompleteDeviceAuthenticationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,13 +1,20 @@
 package v2
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/cloudfoundry/noaa/consumer"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . LogsActor
@@ -15,13 +22,22 @@ import (
 type LogsActor interface {
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForSpace(spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.LogsArgs        `positional-args:"yes"`
+	Space           bool                 `long:"space" description:"Tail logs from every app in the targeted space"`
+	Recent          bool                 `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           time.Duration        `long:"since" description:"Only dump recent logs newer than the duration, such as 30m or 2h. Requires --recent"`
+	SourceTypes     []flag.LogSourceType `long:"source" description:"Only show logs from the source type: API, APP, CELL, RTR or STG. This flag can be defined more than once."`
+	InstanceIndexes []int                `long:"instance" short:"i" description:"Only show logs from the app instance index. This flag can be defined more than once."`
+	Stream          flag.LogStream       `long:"stream" description:"Only show logs from the stream: OUT or ERR"`
+	Pattern         flag.Regexp          `long:"grep" description:"Only show logs with a message matching the regular expression"`
+	JSON            bool                 `long:"json" description:"Output each log message as a JSON object on its own line"`
+	usage           interface{}          `usage:"CF_NAME logs APP_NAME [--recent [--since DURATION]] [--source SOURCE]... [-i INDEX]... [--stream OUT|ERR] [--grep REGEX] [--json]\n   CF_NAME logs (APP_NAME... | --space) [--source SOURCE]... [-i INDEX]... [--stream OUT|ERR] [--grep REGEX] [--json]\n\nEXAMPLES:\n   CF_NAME logs my-app --recent --since 30m --source APP --stream ERR\n   CF_NAME logs my-app --json --grep timeout | jq .message\n   CF_NAME logs frontend orders payments"`
+	relatedCommands interface{}          `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
@@ -47,7 +63,12 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -57,14 +78,9 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if !cmd.JSON {
+		cmd.displayFlavorText(user.Name)
+	}
 
 	if cmd.Recent {
 		return cmd.displayRecentLogs()
@@ -73,15 +89,73 @@ func (cmd LogsCommand) Execute(args []string) error {
 	return cmd.streamLogs()
 }
 
+func (cmd LogsCommand) validateArgs() error {
+	appNames := cmd.RequiredArgs.AppNames
+
+	switch {
+	case cmd.Space && len(appNames) > 0:
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}
+	case !cmd.Space && len(appNames) == 0:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case cmd.Recent && (cmd.Space || len(appNames) > 1):
+		return translatableerror.RecentLogsForMultipleAppsError{}
+	case cmd.Since != 0 && !cmd.Recent:
+		return translatableerror.RequiredFlagsError{Arg1: "--since", Arg2: "--recent"}
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) displayFlavorText(username string) {
+	switch {
+	case cmd.Space:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppNames":  strings.Join(cmd.RequiredArgs.AppNames, ", "),
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   cmd.RequiredArgs.AppNames[0],
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+	}
+	cmd.UI.DisplayNewline()
+}
+
 func (cmd LogsCommand) displayRecentLogs() error {
 	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		cmd.RequiredArgs.AppNames[0],
 		cmd.Config.TargetedSpace().GUID,
 		cmd.NOAAClient,
 	)
 
+	filter := cmd.logFilter()
+	if cmd.Since != 0 {
+		filter.Since = time.Now().Add(-cmd.Since)
+	}
+
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		if !filter.Matches(message) {
+			continue
+		}
+
+		displayErr := cmd.displayLogMessage(message)
+		if displayErr != nil {
+			return displayErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -89,17 +163,30 @@ func (cmd LogsCommand) displayRecentLogs() error {
 }
 
 func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.NOAAClient,
+	var (
+		messages <-chan *v2action.LogMessage
+		logErrs  <-chan error
+		warnings v2action.Warnings
+		err      error
 	)
 
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	switch {
+	case cmd.Space:
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForSpace(spaceGUID, cmd.NOAAClient)
+	case len(cmd.RequiredArgs.AppNames) > 1:
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForApplicationsByNameAndSpace(cmd.RequiredArgs.AppNames, spaceGUID, cmd.NOAAClient)
+	default:
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppNames[0], spaceGUID, cmd.NOAAClient)
+	}
+
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	filter := cmd.logFilter()
+
 	var messagesClosed, errLogsClosed bool
 	for {
 		select {
@@ -109,13 +196,30 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			if !filter.Matches(message) {
+				break
+			}
+
+			err = cmd.displayLogMessage(message)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
 				break
 			}
 
+			// The other apps' logs keep streaming when one app's stream fails.
+			if streamErr, ok := logErr.(actionerror.AppLogStreamError); ok {
+				cmd.UI.DisplayWarning("Failed to stream logs for app {{.AppName}}: {{.Error}}", map[string]interface{}{
+					"AppName": streamErr.AppName,
+					"Error":   streamErr.Err.Error(),
+				})
+				break
+			}
+
 			cmd.NOAAClient.Close()
 			return logErr
 		}
@@ -127,3 +231,36 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+// logFilter returns a filter for the source type, instance, stream and
+// pattern flags.
+func (cmd LogsCommand) logFilter() sharedaction.LogFilter {
+	filter := sharedaction.LogFilter{
+		Type:    cmd.Stream.Type,
+		Pattern: cmd.Pattern.Regexp,
+	}
+
+	for _, sourceType := range cmd.SourceTypes {
+		filter.SourceTypes = append(filter.SourceTypes, sourceType.Type)
+	}
+
+	for _, index := range cmd.InstanceIndexes {
+		filter.SourceInstances = append(filter.SourceInstances, strconv.Itoa(index))
+	}
+
+	return filter
+}
+
+func (cmd LogsCommand) displayLogMessage(message ui.LogMessage) error {
+	if cmd.JSON {
+		return cmd.UI.DisplayLogMessageJSON(message)
+	}
+
+	if appMessage, ok := message.(ui.AppLogMessage); ok && appMessage.AppName() != "" {
+		cmd.UI.DisplayAppLogMessage(appMessage)
+		return nil
+	}
+
+	cmd.UI.DisplayLogMessage(message, true)
+	return nil
+}
@@ -2,11 +2,14 @@ package v2_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -46,7 +49,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.AppNames = []string{"some-app"}
 		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -144,6 +147,99 @@ var _ = Describe("logs command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(noaaClient))
 				})
+
+				Context("when filters are provided", func() {
+					BeforeEach(func() {
+						cmd.SourceTypes = []flag.LogSourceType{{Type: "APP"}}
+						cmd.InstanceIndexes = []int{1}
+						cmd.Stream = flag.LogStream{Type: "OUT"}
+						cmd.Pattern = flag.Regexp{Regexp: regexp.MustCompile("message")}
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say("i am message 1"))
+						Expect(testUI.Out).NotTo(Say("i am message 2"))
+					})
+				})
+
+				Context("when --since is provided", func() {
+					BeforeEach(func() {
+						cmd.Since = time.Hour
+
+						fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+							[]v2action.LogMessage{
+								*v2action.NewLogMessage("i am an old message", 1, time.Now().Add(-2*time.Hour), "APP", "0"),
+								*v2action.NewLogMessage("i am a new message", 1, time.Now().Add(-time.Minute), "APP", "0"),
+							},
+							nil,
+							nil)
+					})
+
+					It("only displays the log messages newer than the duration", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("i am an old message"))
+						Expect(testUI.Out).To(Say("i am a new message"))
+					})
+				})
+
+				Context("when --json is provided", func() {
+					BeforeEach(func() {
+						cmd.JSON = true
+						testUI.TimezoneLocation = time.UTC
+					})
+
+					It("displays one JSON object per log message without flavor text", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+							`{"timestamp":"1970-01-01T00:00:00Z","source_type":"app","source_instance":"1","type":"OUT","message":"i am message 1"}` + "\n" +
+								`{"timestamp":"1970-01-01T00:00:01Z","source_type":"another-app","source_instance":"2","type":"OUT","message":"i am message 2"}` + "\n",
+						))
+					})
+				})
+			})
+		})
+
+		Context("when no app name is provided without --space", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppNames = nil
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			})
+		})
+
+		Context("when app names are provided with --space", func() {
+			BeforeEach(func() {
+				cmd.Space = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}))
+			})
+		})
+
+		Context("when --recent is provided for several apps", func() {
+			BeforeEach(func() {
+				cmd.Recent = true
+				cmd.RequiredArgs.AppNames = []string{"some-app", "some-other-app"}
+			})
+
+			It("returns a RecentLogsForMultipleAppsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RecentLogsForMultipleAppsError{}))
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --since is provided without --recent", func() {
+			BeforeEach(func() {
+				cmd.Since = time.Hour
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--since", Arg2: "--recent"}))
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 			})
 		})
 
@@ -244,6 +340,84 @@ var _ = Describe("logs command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(noaaClient))
 				})
+
+				Context("when filters are provided", func() {
+					BeforeEach(func() {
+						cmd.InstanceIndexes = []int{2}
+					})
+
+					It("only displays the matching streaming log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("i am message 1"))
+						Expect(testUI.Out).To(Say("i am message 2"))
+					})
+				})
+			})
+
+			Context("when several app names are provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.AppNames = []string{"some-app", "some-other-app"}
+
+					fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceStub = func(_ []string, _ string, _ v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							logErrs <- actionerror.AppLogStreamError{AppName: "some-other-app", Err: errors.New("some-stream-error")}
+							messages <- v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP", "0")
+							close(messages)
+							close(logErrs)
+						}()
+
+						return messages, logErrs, v2action.Warnings{"some-warning-1"}, nil
+					}
+				})
+
+				It("tails the logs of every app", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for apps some-app, some-other-app in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Err).To(Say("some-warning-1"))
+					Expect(testUI.Out).To(Say("i am message 1"))
+
+					Expect(fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceCallCount()).To(Equal(1))
+					appNames, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(0)
+					Expect(appNames).To(Equal([]string{"some-app", "some-other-app"}))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(client).To(Equal(noaaClient))
+					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+
+				It("displays a failing stream as a warning and keeps streaming", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("Failed to stream logs for app some-other-app: some-stream-error"))
+					Expect(testUI.Out).To(Say("i am message 1"))
+				})
+			})
+
+			Context("when --space is provided", func() {
+				BeforeEach(func() {
+					cmd.Space = true
+					cmd.RequiredArgs.AppNames = nil
+
+					fakeActor.GetStreamingLogsForSpaceStub = func(_ string, _ v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+						close(messages)
+						close(logErrs)
+						return messages, logErrs, v2action.Warnings{"some-warning-1"}, nil
+					}
+				})
+
+				It("tails the logs of every app in the targeted space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("Retrieving logs for all apps in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Err).To(Say("some-warning-1"))
+
+					Expect(fakeActor.GetStreamingLogsForSpaceCallCount()).To(Equal(1))
+					spaceGUID, client := fakeActor.GetStreamingLogsForSpaceArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(client).To(Equal(noaaClient))
+				})
 			})
 		})
 	})
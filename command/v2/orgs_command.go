@@ -14,6 +14,15 @@ type OrgsActor interface {
 	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
 }
 
+type orgsDocument struct {
+	Orgs []orgDocument `json:"orgs" yaml:"orgs"`
+}
+
+type orgDocument struct {
+	GUID string `json:"guid" yaml:"guid"`
+	Name string `json:"name" yaml:"name"`
+}
+
 type OrgsCommand struct {
 	usage interface{} `usage:"CF_NAME orgs"`
 
@@ -37,6 +46,8 @@ func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (OrgsCommand) SupportsStructuredOutput() {}
+
 func (cmd OrgsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -48,10 +59,12 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgs, warnings, err := cmd.Actor.GetOrganizations()
 	cmd.UI.DisplayWarnings(warnings)
@@ -59,6 +72,10 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayOrgsDocument(orgs)
+	}
+
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
 	} else {
@@ -75,3 +92,11 @@ func (cmd OrgsCommand) displayOrgs(orgs []v2action.Organization) {
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
+
+func (cmd OrgsCommand) displayOrgsDocument(orgs []v2action.Organization) error {
+	document := orgsDocument{Orgs: []orgDocument{}}
+	for _, org := range orgs {
+		document.Orgs = append(document.Orgs, orgDocument{GUID: org.GUID, Name: org.Name})
+	}
+	return cmd.UI.DisplayStructuredOutput(document)
+}
@@ -127,6 +127,33 @@ var _ = Describe("orgs Command", func() {
 				})
 			})
 
+			Context("when displaying structured output", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatJSON
+					fakeActor.GetOrganizationsReturns(
+						[]v2action.Organization{
+							{GUID: "org-guid-1", Name: "org-1"},
+							{GUID: "org-guid-2", Name: "org-2"},
+						},
+						v2action.Warnings{"get-orgs-warning"},
+						nil)
+				})
+
+				It("displays the orgs as a JSON document and the warnings to stderr", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Getting orgs as some-user"))
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+						"orgs": [
+							{"guid": "org-guid-1", "name": "org-1"},
+							{"guid": "org-guid-2", "name": "org-2"}
+						]
+					}`))
+
+					Expect(testUI.Err).To(Say("get-orgs-warning"))
+				})
+			})
+
 			Context("when a translatable error is encountered getting orgs", func() {
 				BeforeEach(func() {
 					fakeActor.GetOrganizationsReturns(
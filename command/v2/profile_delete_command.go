@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+//go:generate counterfeiter . ProfileDeleteActor
+
+type ProfileDeleteActor interface {
+	DeleteProfile(name string) error
+}
+
+type ProfileDeleteCommand struct {
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME profile-delete PROFILE [-f]"`
+	relatedCommands interface{}      `related_commands:"profiles, target"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  ProfileDeleteActor
+}
+
+func (cmd *ProfileDeleteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.Actor = sharedaction.NewActor(config)
+	return nil
+}
+
+func (cmd ProfileDeleteCommand) Execute(args []string) error {
+	if !cmd.Force {
+		deleteProfile, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the profile {{.ProfileName}}?", map[string]interface{}{
+			"ProfileName": cmd.RequiredArgs.ProfileName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteProfile {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting profile {{.ProfileName}}...", map[string]interface{}{
+		"ProfileName": cmd.RequiredArgs.ProfileName,
+	})
+
+	err := cmd.Actor.DeleteProfile(cmd.RequiredArgs.ProfileName)
+	if _, ok := err.(actionerror.ProfileNotFoundError); ok {
+		cmd.UI.DisplayWarning("Profile {{.ProfileName}} does not exist.", map[string]interface{}{
+			"ProfileName": cmd.RequiredArgs.ProfileName,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,111 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("profile-delete Command", func() {
+	var (
+		cmd        ProfileDeleteCommand
+		input      *Buffer
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeProfileDeleteActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeProfileDeleteActor)
+
+		cmd = ProfileDeleteCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+		cmd.RequiredArgs.ProfileName = "staging"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		Context("when the delete is successful", func() {
+			It("deletes the profile", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Deleting profile staging..."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.DeleteProfileCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteProfileArgsForCall(0)).To(Equal("staging"))
+			})
+		})
+
+		Context("when the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteProfileReturns(actionerror.ProfileNotFoundError{Name: "staging"})
+			})
+
+			It("displays a does not exist warning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Profile staging does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when the delete is unsuccessful", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteProfileReturns(errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	Context("when the -f flag is not provided", func() {
+		Context("when the user inputs yes", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("yes\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the profile", func() {
+				Expect(testUI.Out).To(Say("Really delete the profile staging\\?"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.DeleteProfileCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the user chooses the default", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("cancels the deletion", func() {
+				Expect(testUI.Out).To(Say("Really delete the profile staging\\?"))
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeleteProfileCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+//go:generate counterfeiter . ProfileRenameActor
+
+type ProfileRenameActor interface {
+	RenameProfile(oldName string, newName string) error
+}
+
+type ProfileRenameCommand struct {
+	RequiredArgs    flag.RenameProfileArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME profile-rename PROFILE NEW_PROFILE"`
+	relatedCommands interface{}            `related_commands:"profiles, target"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  ProfileRenameActor
+}
+
+func (cmd *ProfileRenameCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.Actor = sharedaction.NewActor(config)
+	return nil
+}
+
+func (cmd ProfileRenameCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Renaming profile {{.OldName}} to {{.NewName}}...", map[string]interface{}{
+		"OldName": cmd.RequiredArgs.OldProfileName,
+		"NewName": cmd.RequiredArgs.NewProfileName,
+	})
+
+	err := cmd.Actor.RenameProfile(cmd.RequiredArgs.OldProfileName, cmd.RequiredArgs.NewProfileName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,64 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("profile-rename Command", func() {
+	var (
+		cmd        ProfileRenameCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *v2fakes.FakeProfileRenameActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(v2fakes.FakeProfileRenameActor)
+
+		cmd = ProfileRenameCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+		cmd.RequiredArgs.OldProfileName = "staging"
+		cmd.RequiredArgs.NewProfileName = "stage"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the rename is successful", func() {
+		It("renames the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Renaming profile staging to stage..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.RenameProfileCallCount()).To(Equal(1))
+			oldName, newName := fakeActor.RenameProfileArgsForCall(0)
+			Expect(oldName).To(Equal("staging"))
+			Expect(newName).To(Equal("stage"))
+		})
+	})
+
+	Context("when the rename is unsuccessful", func() {
+		BeforeEach(func() {
+			fakeActor.RenameProfileReturns(actionerror.ProfileAlreadyExistsError{Name: "stage"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ProfileAlreadyExistsError{Name: "stage"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
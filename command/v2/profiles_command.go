@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ProfilesCommand struct {
+	usage           interface{} `usage:"CF_NAME profiles"`
+	relatedCommands interface{} `related_commands:"profile-delete, profile-rename, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ProfilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd ProfilesCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting profiles...")
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("user"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+
+	currentProfile := cmd.Config.CurrentProfile()
+	for _, profile := range cmd.Config.Profiles() {
+		var current string
+		if profile.Name == currentProfile {
+			current = "*"
+		}
+
+		// A token that cannot be decoded leaves the user blank rather than
+		// hiding the other profiles.
+		user, _ := profile.CurrentUser()
+
+		table = append(table, []string{
+			current,
+			profile.Name,
+			profile.Target,
+			user.Name,
+			profile.TargetedOrganization.Name,
+			profile.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
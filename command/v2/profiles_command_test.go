@@ -0,0 +1,57 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("profiles Command", func() {
+	var (
+		cmd        ProfilesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ProfilesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+
+		fakeConfig.CurrentProfileReturns("prod")
+		fakeConfig.ProfilesReturns([]configv3.Profile{
+			{
+				Name:                 "prod",
+				Target:               "https://api.prod.com",
+				TargetedOrganization: configv3.Organization{Name: "prod-org"},
+				TargetedSpace:        configv3.Space{Name: "prod-space"},
+			},
+			{
+				Name:   "staging",
+				Target: "https://api.staging.com",
+			},
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the profiles and marks the targeted one", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting profiles..."))
+		Expect(testUI.Out).To(Say(`\s+name\s+api endpoint\s+user\s+org\s+space`))
+		Expect(testUI.Out).To(Say(`\*\s+prod\s+https://api.prod.com\s+prod-org\s+prod-space`))
+		Expect(testUI.Out).To(Say(`\s+staging\s+https://api.staging.com`))
+	})
+})
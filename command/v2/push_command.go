@@ -38,6 +38,7 @@ type V2PushActor interface {
 	CloudControllerV3APIVersion() string
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	PlanApplication(config pushaction.ApplicationConfig) (pushaction.ApplicationPlan, pushaction.Warnings, error)
 	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
 }
 
@@ -49,6 +50,7 @@ type V2PushCommand struct {
 	DockerImage         flag.DockerImage              `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
 	DockerUsername      string                        `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath         flag.PathWithExistenceCheck   `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	DryRun              bool                          `long:"dry-run" description:"Display the changes that would be made without making them; exits with an error if there are changes"`
 	PathToManifest      flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest"`
 	HealthCheckType     flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
 	Hostname            string                        `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
@@ -70,7 +72,7 @@ type V2PushCommand struct {
 	envCFStartupTimeout interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	dockerPassword      interface{}                   `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
-	usage           interface{} `usage:"cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	usage           interface{} `usage:"cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--dry-run]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   cf push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--dry-run]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI          command.UI
@@ -188,6 +190,10 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	if cmd.DryRun {
+		return cmd.displayPlans(appConfigs)
+	}
+
 	for appNumber, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
@@ -231,6 +237,39 @@ func (cmd V2PushCommand) Execute(args []string) error {
 	return nil
 }
 
+// displayPlans displays the routes, service bindings and bits that pushing
+// each application would change. It returns an error if pushing would change
+// any of the applications.
+func (cmd V2PushCommand) displayPlans(appConfigs []pushaction.ApplicationConfig) error {
+	var changedApps []string
+	for _, appConfig := range appConfigs {
+		log.Infoln("planning:", appConfig.DesiredApplication.Name)
+		plan, warnings, err := cmd.Actor.PlanApplication(appConfig)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			log.Errorln("planning:", err)
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Plan for app {{.AppName}}:", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+		shared.DisplayApplicationPlan(cmd.UI, plan)
+		cmd.UI.DisplayNewline()
+
+		if appConfig.CreatingApplication() || shared.HasApplicationChanges(shared.GetApplicationChanges(appConfig)) || plan.HasChanges() {
+			changedApps = append(changedApps, appConfig.DesiredApplication.Name)
+		}
+	}
+
+	if len(changedApps) > 0 {
+		return translatableerror.PushDryRunChangesError{AppNames: changedApps}
+	}
+
+	cmd.UI.DisplayText("No changes to apply.")
+	return nil
+}
+
 // GetCommandLineSettings generates a push CommandLineSettings object from the
 // command's command line flags. It also validates those settings, preventing
 // contradictory flags.
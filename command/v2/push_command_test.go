@@ -893,6 +893,88 @@ var _ = Describe("push Command", func() {
 						})
 					})
 
+					Context("when --dry-run is set", func() {
+						BeforeEach(func() {
+							cmd.DryRun = true
+							appConfigs[0].CurrentApplication.GUID = "some-app-guid"
+							appConfigs[0].DesiredApplication.GUID = "some-app-guid"
+						})
+
+						Context("when there are changes to apply", func() {
+							BeforeEach(func() {
+								fakeActor.PlanApplicationReturns(pushaction.ApplicationPlan{
+									RoutesToCreate: []v2action.Route{{Host: "route3", Domain: v2action.Domain{Name: "example.com"}}},
+									RoutesToMap:    []v2action.Route{{Host: "route4", Domain: v2action.Domain{GUID: "some-domain-guid", Name: "example.com"}, GUID: "route4-guid"}},
+									RoutesToUnmap:  []v2action.Route{{Host: "route1", Domain: v2action.Domain{Name: "example.com"}}},
+									ServicesToBind: []string{"some-service"},
+									FilesToUpload:  2,
+									BytesToUpload:  2048,
+								}, pushaction.Warnings{"plan-warning"}, nil)
+							})
+
+							It("displays the plan and returns a PushDryRunChangesError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesError{AppNames: []string{appName}}))
+
+								Expect(testUI.Out).To(Say("Updating app with these attributes\\.\\.\\."))
+								Expect(testUI.Out).To(Say("Plan for app %s:", appName))
+								Expect(testUI.Out).To(Say("create route route3.example.com"))
+								Expect(testUI.Out).To(Say("map route route4.example.com"))
+								Expect(testUI.Out).To(Say("unmap route route1.example.com"))
+								Expect(testUI.Out).To(Say("bind service some-service"))
+								Expect(testUI.Out).To(Say("upload 2 file\\(s\\) \\(2K\\)"))
+								Expect(testUI.Err).To(Say("plan-warning"))
+
+								Expect(fakeActor.PlanApplicationCallCount()).To(Equal(1))
+								Expect(fakeActor.PlanApplicationArgsForCall(0)).To(Equal(appConfigs[0]))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when there are no changes to apply", func() {
+							BeforeEach(func() {
+								appConfigs[0].DesiredRoutes = appConfigs[0].CurrentRoutes
+								fakeActor.PlanApplicationReturns(pushaction.ApplicationPlan{}, nil, nil)
+							})
+
+							It("displays that there is nothing to change", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say("Plan for app %s:", appName))
+								Expect(testUI.Out).To(Say("no changes to routes, services or files"))
+								Expect(testUI.Out).To(Say("No changes to apply\\."))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the app would be created", func() {
+							BeforeEach(func() {
+								appConfigs[0].CurrentApplication = pushaction.Application{}
+								appConfigs[0].DesiredRoutes = appConfigs[0].CurrentRoutes
+								fakeActor.PlanApplicationReturns(pushaction.ApplicationPlan{}, nil, nil)
+							})
+
+							It("returns a PushDryRunChangesError", func() {
+								Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesError{AppNames: []string{appName}}))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when planning errors", func() {
+							var expectedErr error
+
+							BeforeEach(func() {
+								expectedErr = errors.New("plan error")
+								fakeActor.PlanApplicationReturns(pushaction.ApplicationPlan{}, pushaction.Warnings{"plan-warning"}, expectedErr)
+							})
+
+							It("displays the warnings and returns the error", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+								Expect(testUI.Err).To(Say("plan-warning"))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+					})
 				})
 
 				Context("when there is an error converting the app setting into a config", func() {
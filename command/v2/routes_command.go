@@ -1,20 +1,127 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetSpaceRoutes(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetRouteApplications(routeGUID string) ([]v2action.Application, v2action.Warnings, error)
+}
+
+type routesDocument struct {
+	Routes []routeDocument `json:"routes" yaml:"routes"`
+}
+
+type routeDocument struct {
+	GUID   string   `json:"guid" yaml:"guid"`
+	Space  string   `json:"space" yaml:"space"`
+	Host   string   `json:"host" yaml:"host"`
+	Domain string   `json:"domain" yaml:"domain"`
+	Port   *int     `json:"port,omitempty" yaml:"port,omitempty"`
+	Path   string   `json:"path" yaml:"path"`
+	URL    string   `json:"url" yaml:"url"`
+	Apps   []string `json:"apps" yaml:"apps"`
+}
+
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
 	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
 }
 
-func (RoutesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	// Only structured output has been refactored; the table is still displayed
+	// by the legacy command.
+	if !ui.IsStructuredOutput() {
+		return nil
+	}
+
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil, config)
+
 	return nil
 }
 
-func (RoutesCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (RoutesCommand) SupportsStructuredOutput() {}
+
+func (cmd RoutesCommand) Execute(args []string) error {
+	if !cmd.UI.IsStructuredOutput() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, !cmd.OrgLevel)
+	if err != nil {
+		return err
+	}
+
+	var spaces []v2action.Space
+	if cmd.OrgLevel {
+		var warnings v2action.Warnings
+		spaces, warnings, err = cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	} else {
+		space := cmd.Config.TargetedSpace()
+		spaces = []v2action.Space{{GUID: space.GUID, Name: space.Name}}
+	}
+
+	document := routesDocument{Routes: []routeDocument{}}
+	for _, space := range spaces {
+		routes, warnings, err := cmd.Actor.GetSpaceRoutes(space.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		for _, route := range routes {
+			apps, appWarnings, err := cmd.Actor.GetRouteApplications(route.GUID)
+			cmd.UI.DisplayWarnings(appWarnings)
+			if err != nil {
+				return err
+			}
+
+			routeDoc := routeDocument{
+				GUID:   route.GUID,
+				Space:  space.Name,
+				Host:   route.Host,
+				Domain: route.Domain.Name,
+				Path:   route.Path,
+				URL:    route.String(),
+				Apps:   []string{},
+			}
+			if route.Port.IsSet {
+				port := route.Port.Value
+				routeDoc.Port = &port
+			}
+			for _, app := range apps {
+				routeDoc.Apps = append(routeDoc.Apps, app.Name)
+			}
+			document.Routes = append(document.Routes, routeDoc)
+		}
+	}
+
+	return cmd.UI.DisplayStructuredOutput(document)
 }
@@ -0,0 +1,193 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRoutesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRoutesActor)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when structured output is not requested", func() {
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when structured output is requested", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputFormatJSON
+		})
+
+		Context("when checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		Context("when getting the space routes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRoutesReturns(nil, v2action.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and displays the warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+			})
+		})
+
+		Context("when getting the apps bound to a route fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRoutesReturns([]v2action.Route{{GUID: "route-guid-1"}}, nil, nil)
+				fakeActor.GetRouteApplicationsReturns(nil, v2action.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and displays the warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+			})
+		})
+
+		Context("when there are routes in the targeted space", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRoutesReturns(
+					[]v2action.Route{
+						{GUID: "route-guid-1", Host: "host-1", Domain: v2action.Domain{Name: "example.com"}, Path: "/path"},
+						{GUID: "route-guid-2", Domain: v2action.Domain{Name: "tcp.example.com"}, Port: types.NullInt{IsSet: true, Value: 1024}},
+					},
+					v2action.Warnings{"get-routes-warning"},
+					nil)
+				fakeActor.GetRouteApplicationsReturnsOnCall(0,
+					[]v2action.Application{{Name: "app-1"}, {Name: "app-2"}},
+					v2action.Warnings{"get-apps-warning"},
+					nil)
+			})
+
+			It("displays the routes as a JSON document and the warnings to stderr", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+				Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(1))
+				Expect(fakeActor.GetSpaceRoutesArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeActor.GetRouteApplicationsArgsForCall(0)).To(Equal("route-guid-1"))
+				Expect(fakeActor.GetRouteApplicationsArgsForCall(1)).To(Equal("route-guid-2"))
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+					"routes": [
+						{
+							"guid": "route-guid-1",
+							"space": "some-space",
+							"host": "host-1",
+							"domain": "example.com",
+							"path": "/path",
+							"url": "host-1.example.com/path",
+							"apps": ["app-1", "app-2"]
+						},
+						{
+							"guid": "route-guid-2",
+							"space": "some-space",
+							"host": "",
+							"domain": "tcp.example.com",
+							"port": 1024,
+							"path": "",
+							"url": "tcp.example.com:1024",
+							"apps": []
+						}
+					]
+				}`))
+
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+			})
+		})
+
+		Context("when --orglevel is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgLevel = true
+				fakeActor.GetOrganizationSpacesReturns(
+					[]v2action.Space{
+						{GUID: "space-guid-1", Name: "space-1"},
+						{GUID: "space-guid-2", Name: "space-2"},
+					},
+					v2action.Warnings{"get-spaces-warning"},
+					nil)
+				fakeActor.GetSpaceRoutesReturnsOnCall(0, []v2action.Route{{GUID: "route-guid-1", Host: "host-1", Domain: v2action.Domain{Name: "example.com"}}}, nil, nil)
+				fakeActor.GetSpaceRoutesReturnsOnCall(1, []v2action.Route{{GUID: "route-guid-2", Host: "host-2", Domain: v2action.Domain{Name: "example.com"}}}, nil, nil)
+			})
+
+			It("only requires a targeted org and lists the routes of every space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(2))
+				Expect(fakeActor.GetSpaceRoutesArgsForCall(0)).To(Equal("space-guid-1"))
+				Expect(fakeActor.GetSpaceRoutesArgsForCall(1)).To(Equal("space-guid-2"))
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+					"routes": [
+						{"guid": "route-guid-1", "space": "space-1", "host": "host-1", "domain": "example.com", "path": "", "url": "host-1.example.com", "apps": []},
+						{"guid": "route-guid-2", "space": "space-2", "host": "host-2", "domain": "example.com", "path": "", "url": "host-2.example.com", "apps": []}
+					]
+				}`))
+				Expect(testUI.Err).To(Say("get-spaces-warning"))
+			})
+		})
+	})
+})
@@ -17,6 +17,24 @@ type ServicesActor interface {
 	GetServiceInstancesSummaryBySpace(spaceGUID string) ([]v2action.ServiceInstanceSummary, v2action.Warnings, error)
 }
 
+type servicesDocument struct {
+	Services []serviceInstanceDocument `json:"services" yaml:"services"`
+}
+
+type serviceInstanceDocument struct {
+	GUID          string                `json:"guid" yaml:"guid"`
+	Name          string                `json:"name" yaml:"name"`
+	Service       string                `json:"service" yaml:"service"`
+	Plan          string                `json:"plan" yaml:"plan"`
+	BoundApps     []string              `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation lastOperationDocument `json:"last_operation" yaml:"last_operation"`
+}
+
+type lastOperationDocument struct {
+	Type  string `json:"type" yaml:"type"`
+	State string `json:"state" yaml:"state"`
+}
+
 type ServicesCommand struct {
 	usage           interface{} `usage:"CF_NAME services"`
 	relatedCommands interface{} `related_commands:"create-service, marketplace"`
@@ -40,6 +58,8 @@ func (cmd *ServicesCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (ServicesCommand) SupportsStructuredOutput() {}
+
 func (cmd ServicesCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -51,13 +71,15 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting services in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
-		map[string]interface{}{
-			"OrgName":     cmd.Config.TargetedOrganization().Name,
-			"SpaceName":   cmd.Config.TargetedSpace().Name,
-			"CurrentUser": user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting services in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"OrgName":     cmd.Config.TargetedOrganization().Name,
+				"SpaceName":   cmd.Config.TargetedSpace().Name,
+				"CurrentUser": user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	instanceSummaries, warnings, err := cmd.Actor.GetServiceInstancesSummaryBySpace(cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -65,6 +87,10 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayServicesDocument(instanceSummaries)
+	}
+
 	if len(instanceSummaries) == 0 {
 		cmd.UI.DisplayText("No services found")
 		return nil
@@ -78,24 +104,12 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		cmd.UI.TranslateText("last operation"),
 	}}
 
-	var boundAppNames []string
-
 	for _, summary := range instanceSummaries {
-		serviceLabel := summary.Service.Label
-		if summary.ServiceInstance.Type == constant.ServiceInstanceTypeUserProvidedService {
-			serviceLabel = "user-provided"
-		}
-
-		boundAppNames = []string{}
-		for _, boundApplication := range summary.BoundApplications {
-			boundAppNames = append(boundAppNames, boundApplication.AppName)
-		}
-
 		table = append(table, []string{
 			summary.Name,
-			serviceLabel,
+			serviceLabel(summary),
 			summary.ServicePlan.Name,
-			strings.Join(boundAppNames, ", "),
+			strings.Join(boundAppNames(summary), ", "),
 			fmt.Sprintf("%s %s", summary.LastOperation.Type, summary.LastOperation.State)},
 		)
 	}
@@ -103,3 +117,36 @@ func (cmd ServicesCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd ServicesCommand) displayServicesDocument(instanceSummaries []v2action.ServiceInstanceSummary) error {
+	document := servicesDocument{Services: []serviceInstanceDocument{}}
+	for _, summary := range instanceSummaries {
+		document.Services = append(document.Services, serviceInstanceDocument{
+			GUID:      summary.GUID,
+			Name:      summary.Name,
+			Service:   serviceLabel(summary),
+			Plan:      summary.ServicePlan.Name,
+			BoundApps: boundAppNames(summary),
+			LastOperation: lastOperationDocument{
+				Type:  summary.LastOperation.Type,
+				State: summary.LastOperation.State,
+			},
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(document)
+}
+
+func serviceLabel(summary v2action.ServiceInstanceSummary) string {
+	if summary.ServiceInstance.Type == constant.ServiceInstanceTypeUserProvidedService {
+		return "user-provided"
+	}
+	return summary.Service.Label
+}
+
+func boundAppNames(summary v2action.ServiceInstanceSummary) []string {
+	names := []string{}
+	for _, boundApplication := range summary.BoundApplications {
+		names = append(names, boundApplication.AppName)
+	}
+	return names
+}
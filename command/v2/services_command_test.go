@@ -175,6 +175,46 @@ var _ = Describe("services Command", func() {
 					Expect(testUI.Out).To(Say("instance-3\\s+user-provided\\s+"))
 					Expect(testUI.Err).To(Say("get-summary-warnings"))
 				})
+
+				Context("when displaying structured output", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatJSON
+					})
+
+					It("displays the services as a JSON document and the warnings to stderr", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Getting services in org"))
+						Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+							"services": [
+								{
+									"guid": "",
+									"name": "instance-1",
+									"service": "some-service-1",
+									"plan": "some-plan",
+									"bound_apps": ["app-1", "app-2"],
+									"last_operation": {"type": "some-type", "state": "some-state"}
+								},
+								{
+									"guid": "",
+									"name": "instance-2",
+									"service": "some-service-2",
+									"plan": "",
+									"bound_apps": [],
+									"last_operation": {"type": "", "state": ""}
+								},
+								{
+									"guid": "",
+									"name": "instance-3",
+									"service": "user-provided",
+									"plan": "",
+									"bound_apps": [],
+									"last_operation": {"type": "", "state": ""}
+								}
+							]
+						}`))
+						Expect(testUI.Err).To(Say("get-summary-warnings"))
+					})
+				})
 			})
 		})
 	})
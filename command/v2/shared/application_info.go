@@ -99,3 +99,81 @@ func zuluDate(input time.Time) string {
 	// "2006-01-02T15:04:05Z07:00"
 	return input.UTC().Format(time.RFC3339)
 }
+
+// AppSummaryDocument is the structured output representation of an
+// application summary.
+type AppSummaryDocument struct {
+	GUID             string                `json:"guid" yaml:"guid"`
+	Name             string                `json:"name" yaml:"name"`
+	RequestedState   string                `json:"requested_state" yaml:"requested_state"`
+	Instances        int                   `json:"instances" yaml:"instances"`
+	RunningInstances int                   `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64                `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64                `json:"disk_in_mb" yaml:"disk_in_mb"`
+	IsolationSegment string                `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Routes           []string              `json:"routes" yaml:"routes"`
+	LastUploaded     string                `json:"last_uploaded" yaml:"last_uploaded"`
+	Stack            string                `json:"stack" yaml:"stack"`
+	Buildpack        string                `json:"buildpack,omitempty" yaml:"buildpack,omitempty"`
+	DockerImage      string                `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	InstanceDetails  []AppInstanceDocument `json:"instance_details" yaml:"instance_details"`
+}
+
+// AppInstanceDocument is the structured output representation of a running
+// application instance.
+type AppInstanceDocument struct {
+	Index       int     `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	Since       string  `json:"since" yaml:"since"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	MemoryUsage int     `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota int     `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage   int     `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota   int     `json:"disk_quota" yaml:"disk_quota"`
+	Details     string  `json:"details" yaml:"details"`
+}
+
+// NewAppSummaryDocument converts the application summary into its structured
+// output representation.
+func NewAppSummaryDocument(appSummary v2action.ApplicationSummary) AppSummaryDocument {
+	document := AppSummaryDocument{
+		GUID:             appSummary.GUID,
+		Name:             appSummary.Name,
+		RequestedState:   strings.ToLower(string(appSummary.State)),
+		Instances:        appSummary.Instances.Value,
+		RunningInstances: appSummary.StartingOrRunningInstanceCount(),
+		MemoryInMB:       appSummary.Memory.Value,
+		DiskInMB:         appSummary.DiskQuota.Value,
+		IsolationSegment: appSummary.IsolationSegment,
+		Routes:           []string{},
+		LastUploaded:     zuluDate(appSummary.PackageUpdatedAt),
+		Stack:            appSummary.Stack.Name,
+		InstanceDetails:  []AppInstanceDocument{},
+	}
+
+	if appSummary.DockerImage == "" {
+		document.Buildpack = appSummary.Application.CalculatedBuildpack()
+	} else {
+		document.DockerImage = appSummary.DockerImage
+	}
+
+	for _, route := range appSummary.Routes {
+		document.Routes = append(document.Routes, route.String())
+	}
+
+	for _, instance := range appSummary.RunningInstances {
+		document.InstanceDetails = append(document.InstanceDetails, AppInstanceDocument{
+			Index:       instance.ID,
+			State:       strings.ToLower(string(instance.State)),
+			Since:       zuluDate(instance.TimeSinceCreation()),
+			CPU:         instance.CPU,
+			MemoryUsage: instance.Memory,
+			MemoryQuota: instance.MemoryQuota,
+			DiskUsage:   instance.Disk,
+			DiskQuota:   instance.DiskQuota,
+			Details:     instance.Details,
+		})
+	}
+
+	return document
+}
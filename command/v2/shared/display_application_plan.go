@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// DisplayApplicationPlan displays the routes, service bindings and files that
+// applying the plan would change.
+func DisplayApplicationPlan(ui command.UI, plan pushaction.ApplicationPlan) {
+	if !plan.HasChanges() {
+		ui.DisplayText("  no changes to routes, services or files")
+		return
+	}
+
+	for _, route := range plan.RoutesToCreate {
+		ui.DisplayText("  create route {{.Route}}", map[string]interface{}{"Route": route.String()})
+	}
+	for _, route := range plan.RoutesToMap {
+		ui.DisplayText("  map route {{.Route}}", map[string]interface{}{"Route": route.String()})
+	}
+	for _, route := range plan.RoutesToUnmap {
+		ui.DisplayText("  unmap route {{.Route}}", map[string]interface{}{"Route": route.String()})
+	}
+	for _, serviceInstanceName := range plan.ServicesToBind {
+		ui.DisplayText("  bind service {{.ServiceInstanceName}}", map[string]interface{}{"ServiceInstanceName": serviceInstanceName})
+	}
+	if plan.FilesToUpload > 0 {
+		ui.DisplayText("  upload {{.FileCount}} file(s) ({{.Size}})", map[string]interface{}{
+			"FileCount": plan.FilesToUpload,
+			"Size":      bytefmt.ByteSize(uint64(plan.BytesToUpload)),
+		})
+	}
+}
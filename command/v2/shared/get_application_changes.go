@@ -1,6 +1,9 @@
 package shared
 
 import (
+	"reflect"
+	"sort"
+
 	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -179,6 +182,43 @@ func GetApplicationChanges(appConfig pushaction.ApplicationConfig) []ui.Change {
 	return changes
 }
 
+// HasApplicationChanges returns true if any of the provided changes has a new
+// value that differs from its current value. The order of list values is
+// ignored and empty values are treated the same as unset values.
+func HasApplicationChanges(changes []ui.Change) bool {
+	for _, change := range changes {
+		switch current := change.CurrentValue.(type) {
+		case []string:
+			desired, _ := change.NewValue.([]string)
+			if !sameStrings(current, desired) {
+				return true
+			}
+		case map[string]string:
+			desired, _ := change.NewValue.(map[string]string)
+			if (len(current) > 0 || len(desired) > 0) && !reflect.DeepEqual(current, desired) {
+				return true
+			}
+		default:
+			if change.CurrentValue != change.NewValue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sameStrings(current []string, desired []string) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	sortedCurrent := append([]string{}, current...)
+	sortedDesired := append([]string{}, desired...)
+	sort.Strings(sortedCurrent)
+	sort.Strings(sortedDesired)
+	return reflect.DeepEqual(sortedCurrent, sortedDesired)
+}
+
 func SelectNonBlankValue(str ...string) string {
 	for _, s := range str {
 		if s != "" {
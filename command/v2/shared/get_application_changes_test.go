@@ -470,3 +470,33 @@ var _ = Describe("GetApplicationChanges", func() {
 		})
 	})
 })
+
+var _ = Describe("HasApplicationChanges", func() {
+	It("returns false when every value is unchanged", func() {
+		Expect(HasApplicationChanges([]ui.Change{
+			{Header: "name:", CurrentValue: "some-app", NewValue: "some-app"},
+			{Header: "instances:", CurrentValue: types.NullInt{IsSet: true, Value: 2}, NewValue: types.NullInt{IsSet: true, Value: 2}},
+			{Header: "services:", CurrentValue: []string{"service-2", "service-1"}, NewValue: []string{"service-1", "service-2"}},
+			{Header: "env:", CurrentValue: map[string]string(nil), NewValue: map[string]string{}},
+		})).To(BeFalse())
+	})
+
+	It("returns true when a value has changed", func() {
+		Expect(HasApplicationChanges([]ui.Change{
+			{Header: "name:", CurrentValue: "some-app", NewValue: "some-app"},
+			{Header: "memory:", CurrentValue: "256M", NewValue: "512M"},
+		})).To(BeTrue())
+	})
+
+	It("returns true when a list has changed", func() {
+		Expect(HasApplicationChanges([]ui.Change{
+			{Header: "routes:", CurrentValue: []string{"route1.example.com"}, NewValue: []string{"route2.example.com"}},
+		})).To(BeTrue())
+	})
+
+	It("returns true when a map has changed", func() {
+		Expect(HasApplicationChanges([]ui.Change{
+			{Header: "env:", CurrentValue: map[string]string{"a": "1"}, NewValue: map[string]string{"a": "2"}},
+		})).To(BeTrue())
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"github.com/cloudfoundry/noaa/consumer"
 )
 
@@ -59,7 +60,9 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 	if verbose {
 		noaaDebugPrinter.addOutput(ui.RequestLoggerTerminalDisplay())
 	}
-	if location != nil {
+	// Only the Cloud Controller, UAA and plugin repository requests are
+	// written in the JSON lines trace format.
+	if location != nil && config.TraceFormat() == configv3.TraceFormatText {
 		noaaDebugPrinter.addOutput(ui.RequestLoggerFileWriter(location))
 	}
 
@@ -14,6 +14,16 @@ type SpacesActor interface {
 	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
 }
 
+type spacesDocument struct {
+	Spaces []spaceDocument `json:"spaces" yaml:"spaces"`
+}
+
+type spaceDocument struct {
+	GUID     string `json:"guid" yaml:"guid"`
+	Name     string `json:"name" yaml:"name"`
+	AllowSSH bool   `json:"allow_ssh" yaml:"allow_ssh"`
+}
+
 type SpacesCommand struct {
 	usage           interface{} `usage:"CF_NAME spaces"`
 	relatedCommands interface{} `related_commands:"target"`
@@ -38,6 +48,8 @@ func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (SpacesCommand) SupportsStructuredOutput() {}
+
 func (cmd SpacesCommand) Execute([]string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -49,11 +61,13 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -61,6 +75,10 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displaySpacesDocument(spaces)
+	}
+
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
 	} else {
@@ -77,3 +95,15 @@ func (cmd SpacesCommand) displaySpaces(spaces []v2action.Space) {
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
+
+func (cmd SpacesCommand) displaySpacesDocument(spaces []v2action.Space) error {
+	document := spacesDocument{Spaces: []spaceDocument{}}
+	for _, space := range spaces {
+		document.Spaces = append(document.Spaces, spaceDocument{
+			GUID:     space.GUID,
+			Name:     space.Name,
+			AllowSSH: space.AllowSSH,
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(document)
+}
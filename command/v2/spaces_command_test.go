@@ -136,6 +136,32 @@ var _ = Describe("spaces Command", func() {
 				})
 			})
 
+			Context("when displaying structured output", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatYAML
+					fakeActor.GetOrganizationSpacesReturns(
+						[]v2action.Space{
+							{GUID: "space-guid-1", Name: "space-1", AllowSSH: true},
+						},
+						v2action.Warnings{"get-spaces-warning"},
+						nil)
+				})
+
+				It("displays the spaces as a YAML document and the warnings to stderr", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Getting spaces in org"))
+					Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchYAML(`
+spaces:
+- guid: space-guid-1
+  name: space-1
+  allow_ssh: true
+`))
+
+					Expect(testUI.Err).To(Say("get-spaces-warning"))
+				})
+			})
+
 			Context("when a translatable error is encountered getting spaces", func() {
 				BeforeEach(func() {
 					fakeActor.GetOrganizationSpacesReturns(
@@ -8,15 +8,19 @@ import (
 
 type SSHCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
+	AllInstances        bool         `long:"all-instances" description:"Run the command on every running instance in parallel, prefixing output with the instance index"`
 	AppInstanceIndex    int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
 	Command             string       `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
 	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
 	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
 	LocalPort           string       `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	RemotePort          string       `short:"R" description:"Remote port forward specification. This flag can be defined more than once."`
+	DynamicPort         string       `short:"D" description:"Dynamic port forward specification, as a local SOCKS5 proxy. This flag can be defined more than once."`
+	MaxInFlight         int          `long:"max-in-flight" default:"10" description:"Maximum number of instances to run the command on at once with --all-instances"`
 	RemotePseudoTTY     bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
 	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
 	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]"`
+	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-R [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-D [BIND_ADDRESS:]PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]\n   CF_NAME ssh APP_NAME --all-instances -c COMMAND [--max-in-flight NUMBER] [--skip-host-validation]"`
 	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 }
 
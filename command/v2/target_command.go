@@ -21,8 +21,9 @@ type TargetActor interface {
 type TargetCommand struct {
 	Organization    string      `short:"o" description:"Organization"`
 	Space           string      `short:"s" description:"Space"`
-	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
-	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
+	Profile         string      `long:"profile" description:"Profile to target, an empty profile is created if it does not exist"`
+	usage           interface{} `usage:"CF_NAME target [--profile PROFILE] [-o ORG] [-s SPACE]"`
+	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, profiles, spaces"`
 
 	UI          command.UI
 	Config      command.Config
@@ -35,6 +36,15 @@ func (cmd *TargetCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.SharedActor = sharedaction.NewActor(config)
 
+	// The profile is switched before the clients are created so that they
+	// target the profile's API endpoint.
+	if cmd.Profile != "" {
+		config.SetCurrentProfile(cmd.Profile)
+		if config.Target() == "" {
+			return nil
+		}
+	}
+
 	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
 	if err != nil {
 		return err
@@ -45,6 +55,17 @@ func (cmd *TargetCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd *TargetCommand) Execute(args []string) error {
+	if cmd.Profile != "" && cmd.Config.Target() == "" {
+		cmd.UI.DisplayText("Targeted profile {{.Profile}}.", map[string]interface{}{
+			"Profile": cmd.Profile,
+		})
+		cmd.UI.DisplayText("No API endpoint set. Use '{{.LoginTip}}' or '{{.APITip}}' to target an endpoint.", map[string]interface{}{
+			"LoginTip": fmt.Sprintf("%s login", cmd.Config.BinaryName()),
+			"APITip":   fmt.Sprintf("%s api", cmd.Config.BinaryName()),
+		})
+		return nil
+	}
+
 	err := command.WarnCLIVersionCheck(cmd.Config, cmd.UI)
 	if err != nil {
 		return err
@@ -191,6 +212,13 @@ func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 		{cmd.UI.TranslateText("user:"), user.Name},
 	}
 
+	// The profile is only shown once there is more than one to choose from.
+	if len(cmd.Config.Profiles()) > 1 {
+		table = append([][]string{
+			{cmd.UI.TranslateText("profile:"), cmd.Config.CurrentProfile()},
+		}, table...)
+	}
+
 	if cmd.Config.HasTargetedOrganization() {
 		table = append(table, []string{
 			cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganization().Name,
@@ -55,6 +55,20 @@ var _ = Describe("target Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when a profile without a cloud controller API endpoint is targeted", func() {
+		BeforeEach(func() {
+			cmd.Profile = "some-profile"
+		})
+
+		It("displays how to target an endpoint", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Targeted profile some-profile."))
+			Expect(testUI.Out).To(Say("No API endpoint set. Use '%s login' or '%s api' to target an endpoint.", binaryName, binaryName))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when a cloud controller API endpoint is set", func() {
 		BeforeEach(func() {
 			fakeConfig.TargetReturns("some-api-target")
@@ -204,6 +218,20 @@ var _ = Describe("target Command", func() {
 							Expect(testUI.Out).To(Say("space:          some-space"))
 						})
 					})
+
+					Context("when there is more than one profile", func() {
+						BeforeEach(func() {
+							fakeConfig.CurrentProfileReturns("some-profile")
+							fakeConfig.ProfilesReturns([]configv3.Profile{{Name: "some-profile"}, {Name: "other-profile"}})
+						})
+
+						It("displays the targeted profile", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("profile:        some-profile"))
+							Expect(testUI.Out).To(Say("api endpoint:   some-api-target"))
+						})
+					})
 				})
 
 				Context("when space is provided", func() {
@@ -45,6 +45,43 @@ type FakeLogsActor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
+	GetStreamingLogsForApplicationsByNameAndSpaceStub        func(appNames []string, spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForApplicationsByNameAndSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationsByNameAndSpaceArgsForCall []struct {
+		appNames  []string
+		spaceGUID string
+		client    v2action.NOAAClient
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	GetStreamingLogsForSpaceStub        func(spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForSpaceMutex       sync.RWMutex
+	getStreamingLogsForSpaceArgsForCall []struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+	}
+	getStreamingLogsForSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -164,6 +201,128 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	var appNamesCopy []string
+	if appNames != nil {
+		appNamesCopy = make([]string, len(appNames))
+		copy(appNamesCopy, appNames)
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall, struct {
+		appNames  []string
+		spaceGUID string
+		client    v2action.NOAAClient
+	}{appNamesCopy, spaceGUID, client})
+	fake.recordInvocation("GetStreamingLogsForApplicationsByNameAndSpace", []interface{}{appNamesCopy, spaceGUID, client})
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationsByNameAndSpaceStub != nil {
+		return fake.GetStreamingLogsForApplicationsByNameAndSpaceStub(appNames, spaceGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result1, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result2, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result3, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(i int) ([]string, string, v2action.NOAAClient) {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].appNames, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].client
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpace(spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	fake.getStreamingLogsForSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForSpaceReturnsOnCall[len(fake.getStreamingLogsForSpaceArgsForCall)]
+	fake.getStreamingLogsForSpaceArgsForCall = append(fake.getStreamingLogsForSpaceArgsForCall, struct {
+		spaceGUID string
+		client    v2action.NOAAClient
+	}{spaceGUID, client})
+	fake.recordInvocation("GetStreamingLogsForSpace", []interface{}{spaceGUID, client})
+	fake.getStreamingLogsForSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForSpaceStub != nil {
+		return fake.GetStreamingLogsForSpaceStub(spaceGUID, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getStreamingLogsForSpaceReturns.result1, fake.getStreamingLogsForSpaceReturns.result2, fake.getStreamingLogsForSpaceReturns.result3, fake.getStreamingLogsForSpaceReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceCallCount() int {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceArgsForCall(i int) (string, v2action.NOAAClient) {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	return fake.getStreamingLogsForSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForSpaceArgsForCall[i].client
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForSpaceStub = nil
+	fake.getStreamingLogsForSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForSpaceStub = nil
+	if fake.getStreamingLogsForSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -171,6 +330,10 @@ func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -79,6 +79,21 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	PlanApplicationStub        func(config pushaction.ApplicationConfig) (pushaction.ApplicationPlan, pushaction.Warnings, error)
+	planApplicationMutex       sync.RWMutex
+	planApplicationArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	planApplicationReturns struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}
+	planApplicationReturnsOnCall map[int]struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}
 	ReadManifestStub        func(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
 	readManifestMutex       sync.RWMutex
 	readManifestArgsForCall []struct {
@@ -357,6 +372,60 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) PlanApplication(config pushaction.ApplicationConfig) (pushaction.ApplicationPlan, pushaction.Warnings, error) {
+	fake.planApplicationMutex.Lock()
+	ret, specificReturn := fake.planApplicationReturnsOnCall[len(fake.planApplicationArgsForCall)]
+	fake.planApplicationArgsForCall = append(fake.planApplicationArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("PlanApplication", []interface{}{config})
+	fake.planApplicationMutex.Unlock()
+	if fake.PlanApplicationStub != nil {
+		return fake.PlanApplicationStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.planApplicationReturns.result1, fake.planApplicationReturns.result2, fake.planApplicationReturns.result3
+}
+
+func (fake *FakeV2PushActor) PlanApplicationCallCount() int {
+	fake.planApplicationMutex.RLock()
+	defer fake.planApplicationMutex.RUnlock()
+	return len(fake.planApplicationArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PlanApplicationArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.planApplicationMutex.RLock()
+	defer fake.planApplicationMutex.RUnlock()
+	return fake.planApplicationArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) PlanApplicationReturns(result1 pushaction.ApplicationPlan, result2 pushaction.Warnings, result3 error) {
+	fake.PlanApplicationStub = nil
+	fake.planApplicationReturns = struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) PlanApplicationReturnsOnCall(i int, result1 pushaction.ApplicationPlan, result2 pushaction.Warnings, result3 error) {
+	fake.PlanApplicationStub = nil
+	if fake.planApplicationReturnsOnCall == nil {
+		fake.planApplicationReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplicationPlan
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.planApplicationReturnsOnCall[i] = struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error) {
 	var pathsToVarsFilesCopy []string
 	if pathsToVarsFiles != nil {
@@ -436,6 +505,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.convertToApplicationConfigsMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.planApplicationMutex.RLock()
+	defer fake.planApplicationMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
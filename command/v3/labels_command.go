@@ -0,0 +1,153 @@
+package v3
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// LabelResourceTypes are the resource types supported by the label commands.
+const LabelResourceTypes = "app, org, space"
+
+//go:generate counterfeiter . LabelsActor
+
+type LabelsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v3action.Warnings, error)
+	GetOrganizationLabels(orgName string) (map[string]types.NullString, v3action.Warnings, error)
+	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v3action.Warnings, error)
+}
+
+type LabelsCommand struct {
+	RequiredArgs    flag.LabelsArgs `positional-args:"yes"`
+	usage           interface{}     `usage:"CF_NAME labels RESOURCE RESOURCE_NAME\n\nEXAMPLES:\n   cf labels app dora\n   cf labels org business\n   cf labels space dev\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}     `related_commands:"set-label, unset-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LabelsActor
+}
+
+func (cmd *LabelsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionMetadataV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd LabelsCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	resourceType := strings.ToLower(cmd.RequiredArgs.ResourceType)
+	err = checkLabelResourceTarget(cmd.SharedActor, resourceType)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var (
+		labels   map[string]types.NullString
+		warnings v3action.Warnings
+	)
+	switch resourceType {
+	case "app":
+		cmd.UI.DisplayTextWithFlavor("Getting labels for app {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", labelTemplateValues(cmd.Config, cmd.RequiredArgs.ResourceName, user))
+		labels, warnings, err = cmd.Actor.GetApplicationLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case "org":
+		cmd.UI.DisplayTextWithFlavor("Getting labels for org {{.ResourceName}} as {{.Username}}...", labelTemplateValues(cmd.Config, cmd.RequiredArgs.ResourceName, user))
+		labels, warnings, err = cmd.Actor.GetOrganizationLabels(cmd.RequiredArgs.ResourceName)
+	case "space":
+		cmd.UI.DisplayTextWithFlavor("Getting labels for space {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", labelTemplateValues(cmd.Config, cmd.RequiredArgs.ResourceName, user))
+		labels, warnings, err = cmd.Actor.GetSpaceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+
+	if len(labels) == 0 {
+		cmd.UI.DisplayText("No labels found.")
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("key"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+	for _, key := range keys {
+		table = append(table, []string{key, labels[key].Value})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// checkLabelResourceTarget validates the resource type and checks that the
+// user has targeted what is needed to find a resource of that type by name.
+func checkLabelResourceTarget(sharedActor command.SharedActor, resourceType string) error {
+	switch resourceType {
+	case "app":
+		return sharedActor.CheckTarget(true, true)
+	case "org":
+		return sharedActor.CheckTarget(false, false)
+	case "space":
+		return sharedActor.CheckTarget(true, false)
+	default:
+		return translatableerror.UnsupportedResourceTypeError{
+			ResourceType:   resourceType,
+			SupportedTypes: LabelResourceTypes,
+		}
+	}
+}
+
+func labelTemplateValues(config command.Config, resourceName string, user configv3.User) map[string]interface{} {
+	return map[string]interface{}{
+		"ResourceName": resourceName,
+		"OrgName":      config.TargetedOrganization().Name,
+		"SpaceName":    config.TargetedSpace().Name,
+		"Username":     user.Name,
+	}
+}
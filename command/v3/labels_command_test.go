@@ -0,0 +1,196 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("labels Command", func() {
+	var (
+		cmd             v3.LabelsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeLabelsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeLabelsActor)
+
+		cmd = v3.LabelsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		cmd.RequiredArgs.ResourceType = "app"
+		cmd.RequiredArgs.ResourceName = "some-app"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.62.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.62.0",
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+			Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+		})
+	})
+
+	Context("when the resource type is not supported", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "route"
+		})
+
+		It("returns an UnsupportedResourceTypeError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnsupportedResourceTypeError{
+				ResourceType:   "route",
+				SupportedTypes: "app, org, space",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when listing app labels", func() {
+		Context("when the app has labels", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationLabelsReturns(
+					map[string]types.NullString{
+						"some-other-key": types.NewNullString("some-other-value"),
+						"some-key":       types.NewNullString("some-value"),
+					},
+					v3action.Warnings{"some-warning"},
+					nil)
+			})
+
+			It("displays the labels sorted by key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+
+				Expect(testUI.Out).To(Say(`Getting labels for app some-app in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`key\s+value`))
+				Expect(testUI.Out).To(Say(`some-key\s+some-value`))
+				Expect(testUI.Out).To(Say(`some-other-key\s+some-other-value`))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetApplicationLabelsCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationLabelsArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the app has no labels", func() {
+			It("displays a message", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No labels found."))
+			})
+		})
+
+		Context("when getting the labels fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationLabelsReturns(nil, v3action.Warnings{"some-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+
+	Context("when listing org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "ORG"
+			cmd.RequiredArgs.ResourceName = "some-org"
+			fakeActor.GetOrganizationLabelsReturns(map[string]types.NullString{"some-key": types.NewNullString("some-value")}, nil, nil)
+		})
+
+		It("only requires the user to be logged in", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting labels for org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`some-key\s+some-value`))
+			Expect(fakeActor.GetOrganizationLabelsArgsForCall(0)).To(Equal("some-org"))
+		})
+	})
+
+	Context("when listing space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "space"
+			cmd.RequiredArgs.ResourceName = "some-space"
+			fakeActor.GetSpaceLabelsReturns(map[string]types.NullString{"some-key": types.NewNullString("some-value")}, nil, nil)
+		})
+
+		It("requires an org to be targeted", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting labels for space some-space in org some-org as some-user\.\.\.`))
+			spaceName, orgGUID := fakeActor.GetSpaceLabelsArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+
+	Context("when getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package v3
+
+import (
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . SetLabelActor
+
+type SetLabelActor interface {
+	CloudControllerAPIVersion() string
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+}
+
+type SetLabelCommand struct {
+	RequiredArgs    flag.SetLabelArgs `positional-args:"yes"`
+	usage           interface{}       `usage:"CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...\n\nEXAMPLES:\n   cf set-label app dora env=production\n   cf set-label org business pci=true public-facing=false\n   cf set-label space dev tier=backend\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}       `related_commands:"labels, unset-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetLabelActor
+}
+
+func (cmd *SetLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionMetadataV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd SetLabelCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	labels := map[string]types.NullString{}
+	for _, label := range cmd.RequiredArgs.Labels {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) < 2 || parts[0] == "" {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: label,
+				ExpectedType: "KEY=VALUE",
+			}
+		}
+		labels[parts[0]] = types.NewNullString(parts[1])
+	}
+
+	resourceType := strings.ToLower(cmd.RequiredArgs.ResourceType)
+	err = checkLabelResourceTarget(cmd.SharedActor, resourceType)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var warnings v3action.Warnings
+	switch resourceType {
+	case "app":
+		cmd.UI.DisplayTextWithFlavor("Adding label(s) to app {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", labelTemplateValues(cmd.Config, cmd.RequiredArgs.ResourceName, user))
+		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID, labels)
+	case "org":
+		cmd.UI.DisplayTextWithFlavor("Adding label(s) to org {{.ResourceName}} as {{.Username}}...", labelTemplateValues(cmd.Config, cmd.RequiredArgs.ResourceName, user))
+		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.RequiredArgs.ResourceName, labels)
+	case "space":
+		cmd.UI.DisplayTextWithFlavor("Adding label(s) to space {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", labelTemplateValues(cmd.Config, cmd.RequiredArgs.ResourceName, user))
+		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID, labels)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
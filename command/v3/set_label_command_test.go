@@ -0,0 +1,136 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-label Command", func() {
+	var (
+		cmd             v3.SetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeSetLabelActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeSetLabelActor)
+
+		cmd = v3.SetLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		cmd.RequiredArgs.ResourceType = "app"
+		cmd.RequiredArgs.ResourceName = "some-app"
+		cmd.RequiredArgs.Labels = []string{"env=prod", "query=a=b"}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when a label is not a KEY=VALUE pair", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Labels = []string{"env"}
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "env",
+				ExpectedType: "KEY=VALUE",
+			}))
+			Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when setting app labels", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("sets the labels, splitting on the first equals sign", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Adding label\(s\) to app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+			appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"env":   types.NewNullString("prod"),
+				"query": types.NewNullString("a=b"),
+			}))
+		})
+
+		Context("when updating the labels fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+
+	Context("when setting org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "org"
+			cmd.RequiredArgs.ResourceName = "some-org"
+		})
+
+		It("updates the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Adding label\(s\) to org some-org as some-user\.\.\.`))
+
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(labels).To(HaveKeyWithValue("env", types.NewNullString("prod")))
+		})
+	})
+
+	Context("when setting space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "space"
+			cmd.RequiredArgs.ResourceName = "some-space"
+		})
+
+		It("updates the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Adding label\(s\) to space some-space in org some-org as some-user\.\.\.`))
+
+			spaceName, orgGUID, _ := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
@@ -18,6 +19,9 @@ type AppSummaryDisplayer struct {
 	Actor           V3AppSummaryActor
 	V2AppRouteActor V2AppRouteActor
 	AppName         string
+	// ProcessType limits the displayed processes to the given type. All
+	// processes are displayed when it is empty.
+	ProcessType string
 }
 
 //go:generate counterfeiter . V2AppRouteActor
@@ -40,6 +44,13 @@ func (display AppSummaryDisplayer) DisplayAppInfo() error {
 	}
 	summary.ProcessSummaries.Sort()
 
+	if display.ProcessType != "" {
+		summary.ProcessSummaries, err = display.filterProcessSummaries(summary.ProcessSummaries)
+		if err != nil {
+			return err
+		}
+	}
+
 	var routes v2action.Routes
 	if len(summary.ProcessSummaries) > 0 {
 		var routeWarnings v2action.Warnings
@@ -50,11 +61,25 @@ func (display AppSummaryDisplayer) DisplayAppInfo() error {
 		}
 	}
 
+	if display.UI.IsStructuredOutput() {
+		return display.UI.DisplayStructuredOutput(NewAppSummaryDocument(summary, routes))
+	}
+
 	display.displayAppTable(summary, routes)
 
 	return nil
 }
 
+func (display AppSummaryDisplayer) filterProcessSummaries(processSummaries v3action.ProcessSummaries) (v3action.ProcessSummaries, error) {
+	for _, processSummary := range processSummaries {
+		if processSummary.Type == display.ProcessType {
+			return v3action.ProcessSummaries{processSummary}, nil
+		}
+	}
+
+	return nil, actionerror.ProcessNotFoundError{ProcessType: display.ProcessType}
+}
+
 func (display AppSummaryDisplayer) displayAppInstancesTable(processSummary v3action.ProcessSummary) {
 	display.UI.DisplayNewline()
 
@@ -107,6 +132,13 @@ func (display AppSummaryDisplayer) DisplayAppProcessInfo() error {
 	}
 	summary.ProcessSummaries.Sort()
 
+	if display.ProcessType != "" {
+		summary.ProcessSummaries, err = display.filterProcessSummaries(summary.ProcessSummaries)
+		if err != nil {
+			return err
+		}
+	}
+
 	display.displayProcessTable(summary)
 	return nil
 }
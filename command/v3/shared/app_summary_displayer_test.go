@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -158,6 +159,32 @@ var _ = Describe("app summary displayer", func() {
 				Expect(passedAppName).To(Equal("some-app"))
 				Expect(spaceName).To(Equal("some-space-guid"))
 			})
+
+			Context("when a process type is provided", func() {
+				BeforeEach(func() {
+					appSummaryDisplayer.ProcessType = "console"
+				})
+
+				It("only lists information for that process", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					processTable := helpers.ParseV3AppProcessTable(output.Contents())
+					Expect(len(processTable.Processes)).To(Equal(1))
+					Expect(processTable.Processes[0].Title).To(Equal("console:1/1"))
+				})
+			})
+
+			Context("when the provided process type does not exist", func() {
+				BeforeEach(func() {
+					appSummaryDisplayer.ProcessType = "worker"
+				})
+
+				It("returns a ProcessNotFoundError and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "worker"}))
+					Expect(testUI.Err).To(Say("get-app-summary-warning"))
+					Expect(output.Contents()).To(HaveLen(0))
+				})
+			})
 		})
 
 		Context("when getting the app summary fails", func() {
@@ -0,0 +1,117 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// AppSummaryDocument is the structured output representation of a V3
+// application summary.
+type AppSummaryDocument struct {
+	GUID           string            `json:"guid" yaml:"guid"`
+	Name           string            `json:"name" yaml:"name"`
+	RequestedState string            `json:"requested_state" yaml:"requested_state"`
+	Routes         []string          `json:"routes" yaml:"routes"`
+	Stack          string            `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpacks     []string          `json:"buildpacks,omitempty" yaml:"buildpacks,omitempty"`
+	DockerImage    string            `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	Processes      []ProcessDocument `json:"processes" yaml:"processes"`
+}
+
+// ProcessDocument is the structured output representation of an application
+// process and its instances.
+type ProcessDocument struct {
+	Type             string                    `json:"type" yaml:"type"`
+	Instances        int                       `json:"instances" yaml:"instances"`
+	HealthyInstances int                       `json:"healthy_instances" yaml:"healthy_instances"`
+	MemoryInMB       uint64                    `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64                    `json:"disk_in_mb" yaml:"disk_in_mb"`
+	InstanceDetails  []ProcessInstanceDocument `json:"instance_details" yaml:"instance_details"`
+}
+
+// ProcessInstanceDocument is the structured output representation of a
+// process instance.
+type ProcessInstanceDocument struct {
+	Index       int     `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	Since       string  `json:"since" yaml:"since"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	MemoryUsage uint64  `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota uint64  `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage   uint64  `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota   uint64  `json:"disk_quota" yaml:"disk_quota"`
+}
+
+// NewAppSummaryDocument converts the application summary and its routes into
+// their structured output representation.
+func NewAppSummaryDocument(summary v3action.ApplicationSummary, routes v2action.Routes) AppSummaryDocument {
+	document := AppSummaryDocument{
+		GUID:           summary.Application.GUID,
+		Name:           summary.Application.Name,
+		RequestedState: strings.ToLower(string(summary.State)),
+		Routes:         NewRoutesDocument(routes),
+		Stack:          summary.CurrentDroplet.Stack,
+		Processes:      NewProcessesDocument(summary.ProcessSummaries),
+	}
+
+	if summary.LifecycleType == constant.AppLifecycleTypeDocker {
+		document.DockerImage = summary.CurrentDroplet.Image
+	} else {
+		for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+			if buildpack.DetectOutput != "" {
+				document.Buildpacks = append(document.Buildpacks, buildpack.DetectOutput)
+			} else {
+				document.Buildpacks = append(document.Buildpacks, buildpack.Name)
+			}
+		}
+	}
+
+	return document
+}
+
+// NewProcessesDocument converts the process summaries into their structured
+// output representation.
+func NewProcessesDocument(processSummaries v3action.ProcessSummaries) []ProcessDocument {
+	processes := []ProcessDocument{}
+	for _, processSummary := range processSummaries {
+		process := ProcessDocument{
+			Type:             processSummary.Type,
+			Instances:        processSummary.TotalInstanceCount(),
+			HealthyInstances: processSummary.HealthyInstanceCount(),
+			MemoryInMB:       processSummary.MemoryInMB.Value,
+			DiskInMB:         processSummary.DiskInMB.Value,
+			InstanceDetails:  []ProcessInstanceDocument{},
+		}
+
+		for _, instance := range processSummary.InstanceDetails {
+			process.InstanceDetails = append(process.InstanceDetails, ProcessInstanceDocument{
+				Index:       instance.Index,
+				State:       strings.ToLower(string(instance.State)),
+				Since:       instance.StartTime().UTC().Format(time.RFC3339),
+				CPU:         instance.CPU,
+				MemoryUsage: instance.MemoryUsage,
+				MemoryQuota: instance.MemoryQuota,
+				DiskUsage:   instance.DiskUsage,
+				DiskQuota:   instance.DiskQuota,
+			})
+		}
+
+		processes = append(processes, process)
+	}
+
+	return processes
+}
+
+// NewRoutesDocument converts the routes into their structured output
+// representation.
+func NewRoutesDocument(routes v2action.Routes) []string {
+	formattedRoutes := []string{}
+	for _, route := range routes {
+		formattedRoutes = append(formattedRoutes, route.String())
+	}
+	return formattedRoutes
+}
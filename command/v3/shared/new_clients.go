@@ -7,6 +7,7 @@ import (
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 // NewClients creates a new V3 Cloud Controller client and UAA client using the
@@ -19,20 +20,26 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
 	if location != nil {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		if config.TraceFormat() == configv3.TraceFormatJSONL {
+			ccWrappers = append(ccWrappers, ccWrapper.NewJSONRequestLogger(ui.RequestLoggerJSONLWriter(location, config.TraceMaxSize())))
+		} else {
+			ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		}
 	}
 
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount(), config.RequestRetryBackoff()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewResponseCache(config.ResponseCacheDir(), config, config.NoCache()))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
-		AppName:            config.BinaryName(),
-		AppVersion:         config.BinaryVersion(),
-		JobPollingTimeout:  config.OverallPollingTimeout(),
-		JobPollingInterval: config.PollingInterval(),
-		Wrappers:           ccWrappers,
+		AppName:               config.BinaryName(),
+		AppVersion:            config.BinaryVersion(),
+		JobPollingTimeout:     config.OverallPollingTimeout(),
+		JobPollingInterval:    config.PollingInterval(),
+		PaginationConcurrency: config.PaginationConcurrency(),
+		Wrappers:              ccWrappers,
 	})
 
 	if !targetCF {
@@ -64,12 +71,16 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
 	if location != nil {
-		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		if config.TraceFormat() == configv3.TraceFormatJSONL {
+			uaaClient.WrapConnection(uaaWrapper.NewJSONRequestLogger(ui.RequestLoggerJSONLWriter(location, config.TraceMaxSize())))
+		} else {
+			uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		}
 	}
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount(), config.RequestRetryBackoff()))
 
 	err = uaaClient.SetupResources(ccClient.UAA())
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 // NewNetworkingClient creates a new cfnetworking client.
@@ -20,7 +21,9 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 	if verbose {
 		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
-	if location != nil {
+	// Only the Cloud Controller, UAA and plugin repository requests are
+	// written in the JSON lines trace format.
+	if location != nil && config.TraceFormat() == configv3.TraceFormatText {
 		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
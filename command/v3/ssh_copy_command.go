@@ -0,0 +1,189 @@
+package v3
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . SSHCopyActor
+
+type SSHCopyActor interface {
+	ExecuteSecureCopy(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, copyOptions sharedaction.SecureCopyOptions, progressBar clissh.ProgressBar) error
+}
+
+type SSHCopyCommand struct {
+	RequiredArgs       flag.SSHCopyArgs `positional-args:"yes"`
+	ProcessType        string           `long:"process" description:"App process name (Default: web)"`
+	Recursive          bool             `long:"recursive" short:"r" description:"Copy directories recursively"`
+	SkipHostValidation bool             `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	usage              interface{}      `usage:"CF_NAME ssh-copy APP_NAME[:INDEX]:REMOTE_PATH LOCAL_PATH [--process PROCESS] [-r] [--skip-host-validation]\n   CF_NAME ssh-copy LOCAL_PATH APP_NAME[:INDEX]:REMOTE_PATH [--process PROCESS] [-r] [--skip-host-validation]\n\nEXAMPLES:\n   CF_NAME ssh-copy my-app:1:/home/vcap/app/heap.hprof .\n   CF_NAME ssh-copy -r ./hotfix my-app:/home/vcap/app"`
+	relatedCommands    interface{}      `related_commands:"enable-ssh, ssh, ssh-code, ssh-enabled"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3SSHActor
+	SSHActor    SSHCopyActor
+	SSHClient   *clissh.SecureShell
+	ProgressBar clissh.ProgressBar
+}
+
+func (cmd *SSHCopyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd SSHCopyCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	source := parseSSHCopyPath(cmd.RequiredArgs.Source)
+	destination := parseSSHCopyPath(cmd.RequiredArgs.Destination)
+	if source.remote == destination.remote {
+		return translatableerror.SSHCopyRemotePathError{}
+	}
+
+	remote := source
+	if destination.remote {
+		remote = destination
+	}
+
+	if cmd.ProcessType == "" {
+		cmd.ProcessType = "web"
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Copying {{.Source}} to {{.Destination}} for instance {{.InstanceIndex}} of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Source":        source.path,
+		"Destination":   destination.path,
+		"InstanceIndex": remote.index,
+		"ProcessType":   cmd.ProcessType,
+		"AppName":       remote.appName,
+		"OrgName":       cmd.Config.TargetedOrganization().Name,
+		"SpaceName":     cmd.Config.TargetedSpace().Name,
+		"Username":      user.Name,
+	})
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		remote.appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		remote.index,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SSHActor.ExecuteSecureCopy(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		sharedaction.SecureCopyOptions{
+			Source:      source.path,
+			Destination: destination.path,
+			ToRemote:    destination.remote,
+			Recursive:   cmd.Recursive,
+		},
+		cmd.ProgressBar,
+	)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+type sshCopyPath struct {
+	remote  bool
+	appName string
+	index   uint
+	path    string
+}
+
+// parseSSHCopyPath parses APP_NAME[:INDEX]:PATH paths in an app instance. An
+// argument is local when it has no colon, when a slash or backslash comes
+// before the first colon, or when it starts with a Windows drive letter.
+func parseSSHCopyPath(arg string) sshCopyPath {
+	colon := strings.Index(arg, ":")
+	if colon <= 0 || strings.ContainsAny(arg[:colon], `/\`) || isWindowsDrivePath(arg) {
+		return sshCopyPath{path: arg}
+	}
+
+	parsed := sshCopyPath{
+		remote:  true,
+		appName: arg[:colon],
+		path:    arg[colon+1:],
+	}
+
+	if parts := strings.SplitN(parsed.path, ":", 2); len(parts) == 2 {
+		if index, err := strconv.ParseUint(parts[0], 10, 0); err == nil {
+			parsed.index = uint(index)
+			parsed.path = parts[1]
+		}
+	}
+
+	if parsed.path == "" {
+		parsed.path = "."
+	}
+	return parsed
+}
+
+func isWindowsDrivePath(arg string) bool {
+	if len(arg) < 3 || arg[1] != ':' || (arg[2] != '\\' && arg[2] != '/') {
+		return false
+	}
+	letter := arg[0] | 0x20
+	return letter >= 'a' && letter <= 'z'
+}
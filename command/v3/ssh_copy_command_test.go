@@ -0,0 +1,205 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh-copy Command", func() {
+	var (
+		cmd             v3.SSHCopyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3SSHActor
+		fakeSSHActor    *v3fakes.FakeSSHCopyActor
+		fakeProgressBar *clisshfakes.FakeProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3SSHActor)
+		fakeSSHActor = new(v3fakes.FakeSSHCopyActor)
+		fakeProgressBar = new(clisshfakes.FakeProgressBar)
+
+		cmd = v3.SSHCopyCommand{
+			RequiredArgs: flag.SSHCopyArgs{
+				Source:      "some-app:1:/home/vcap/app/heap.hprof",
+				Destination: "some-local-dir",
+			},
+
+			ProcessType:        "some-process-type",
+			Recursive:          true,
+			SkipHostValidation: true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SSHActor:    fakeSSHActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+
+		It("displays the experimental warning", func() {
+			Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	DescribeTable("when neither or both paths are in an app instance",
+		func(source string, destination string) {
+			cmd.RequiredArgs = flag.SSHCopyArgs{Source: source, Destination: destination}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.SSHCopyRemotePathError{}))
+		},
+		Entry("both local", "some-dir/some-file", "some-other-file"),
+		Entry("both remote", "some-app:some-file", "some-other-app:some-other-file"),
+		Entry("colon after a slash", "./some-app:some-file", "some-dir/a:b"),
+		Entry("windows drive letters", `C:\some-file`, "D:/some-other-file"),
+	)
+
+	Context("when getting the secure shell authentication information succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			}, v3action.Warnings{"some-warnings"}, nil)
+		})
+
+		It("copies from the app instance and displays all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Copying /home/vcap/app/heap.hprof to some-local-dir for instance 1 of process some-process-type of app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+			appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appNameArg).To(Equal("some-app"))
+			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+			Expect(processTypeArg).To(Equal("some-process-type"))
+			Expect(processIndexArg).To(Equal(uint(1)))
+
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(1))
+			_, sshOptionsArg, copyOptionsArg, progressBarArg := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+			Expect(sshOptionsArg).To(Equal(sharedaction.SSHOptions{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				SkipHostValidation: true,
+				Username:           "some-username",
+			}))
+			Expect(copyOptionsArg).To(Equal(sharedaction.SecureCopyOptions{
+				Source:      "/home/vcap/app/heap.hprof",
+				Destination: "some-local-dir",
+				Recursive:   true,
+			}))
+			Expect(progressBarArg).To(Equal(fakeProgressBar))
+		})
+
+		Context("when copying to the app instance", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.SSHCopyArgs{
+					Source:      "some-local-dir/hotfix.jar",
+					Destination: "some-app:app/lib:v2/hotfix.jar",
+				}
+				cmd.ProcessType = ""
+			})
+
+			It("copies to index 0 of the web process, keeping colons in the path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+				Expect(processTypeArg).To(Equal("web"))
+				Expect(processIndexArg).To(Equal(uint(0)))
+
+				_, _, copyOptionsArg, _ := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+				Expect(copyOptionsArg).To(Equal(sharedaction.SecureCopyOptions{
+					Source:      "some-local-dir/hotfix.jar",
+					Destination: "app/lib:v2/hotfix.jar",
+					ToRemote:    true,
+					Recursive:   true,
+				}))
+			})
+		})
+
+		Context("when copying fails", func() {
+			BeforeEach(func() {
+				fakeSSHActor.ExecuteSecureCopyReturns(errors.New("some-copy-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-copy-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	Context("when getting the secure shell authentication fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.SSHAuthentication{}, v3action.Warnings{"some-warnings"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,120 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unset-label Command", func() {
+	var (
+		cmd             v3.UnsetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnsetLabelActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnsetLabelActor)
+
+		cmd = v3.UnsetLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		cmd.RequiredArgs.ResourceType = "app"
+		cmd.RequiredArgs.ResourceName = "some-app"
+		cmd.RequiredArgs.LabelKeys = []string{"env", "tier"}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the resource type is not supported", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "buildpack"
+		})
+
+		It("returns an UnsupportedResourceTypeError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnsupportedResourceTypeError{
+				ResourceType:   "buildpack",
+				SupportedTypes: "app, org, space",
+			}))
+		})
+	})
+
+	Context("when removing app labels", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("unsets each key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Removing label\(s\) from app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"env":  {},
+				"tier": {},
+			}))
+		})
+	})
+
+	Context("when removing org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "org"
+			cmd.RequiredArgs.ResourceName = "some-org"
+		})
+
+		It("updates the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Removing label\(s\) from org some-org as some-user\.\.\.`))
+
+			orgName, _ := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+		})
+	})
+
+	Context("when removing space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "space"
+			cmd.RequiredArgs.ResourceName = "some-space"
+		})
+
+		It("updates the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Removing label\(s\) from space some-space in org some-org as some-user\.\.\.`))
+
+			spaceName, orgGUID, _ := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
@@ -26,7 +26,8 @@ type V3AppActor interface {
 type V3AppCommand struct {
 	RequiredArgs flag.AppName `positional-args:"yes"`
 	GUID         bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage        interface{}  `usage:"CF_NAME v3-app APP_NAME [--guid]"`
+	ProcessType  string       `long:"process" description:"Only display the process of this type (e.g. web, worker)"`
+	usage        interface{}  `usage:"CF_NAME v3-app APP_NAME [--guid] [--process PROCESS_TYPE]"`
 
 	UI                  command.UI
 	Config              command.Config
@@ -64,10 +65,13 @@ func (cmd *V3AppCommand) Setup(config command.Config, ui command.UI) error {
 		Actor:           cmd.Actor,
 		V2AppRouteActor: v2Actor,
 		AppName:         cmd.RequiredArgs.AppName,
+		ProcessType:     cmd.ProcessType,
 	}
 	return nil
 }
 
+func (V3AppCommand) SupportsStructuredOutput() {}
+
 func (cmd V3AppCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
@@ -90,13 +94,15 @@ func (cmd V3AppCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   cmd.RequiredArgs.AppName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	return cmd.AppSummaryDisplayer.DisplayAppInfo()
 }
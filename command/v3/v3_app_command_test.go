@@ -253,6 +253,29 @@ var _ = Describe("v3-app Command", func() {
 
 			Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(0))
 		})
+
+		Context("when structured output is requested", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatYAML
+			})
+
+			It("displays app information as YAML", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Showing health and status"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchYAML(`
+guid: some-guid
+name: some-app
+requested_state: started
+routes: []
+docker_image: docker/some-image
+processes: []
+`))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+			})
+		})
 	})
 
 	Context("when app has no processes", func() {
@@ -570,6 +593,36 @@ var _ = Describe("v3-app Command", func() {
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 				})
+
+				Context("when the --process flag is provided", func() {
+					BeforeEach(func() {
+						cmd.AppSummaryDisplayer.ProcessType = constant.ProcessTypeWeb
+					})
+
+					It("only displays the given process", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("processes:\\s+web:3/3\n"))
+						Expect(testUI.Out).To(Say("memory usage:\\s+32M x 3\n"))
+						Expect(testUI.Out).To(Say("web:3/3"))
+						Expect(testUI.Out).To(Say("#2\\s+running"))
+						Expect(testUI.Out).ToNot(Say("console:0/0"))
+						Expect(testUI.Out).ToNot(Say("worker:0/1"))
+					})
+				})
+
+				Context("when the process provided by --process does not exist", func() {
+					BeforeEach(func() {
+						cmd.AppSummaryDisplayer.ProcessType = "some-process"
+					})
+
+					It("returns a ProcessNotFoundError and displays all warnings", func() {
+						Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process"}))
+
+						Expect(testUI.Err).To(Say("warning-1"))
+						Expect(testUI.Err).To(Say("warning-2"))
+					})
+				})
 			})
 		})
 	})
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -20,11 +21,24 @@ import (
 
 type V3AppsActor interface {
 	CloudControllerAPIVersion() string
-	GetApplicationsWithProcessesBySpace(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
+	GetApplicationsWithProcessesBySpace(spaceGUID string, queries ...ccv3.Query) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
+}
+
+type appsDocument struct {
+	Apps []appDocument `json:"apps" yaml:"apps"`
+}
+
+type appDocument struct {
+	GUID           string                   `json:"guid" yaml:"guid"`
+	Name           string                   `json:"name" yaml:"name"`
+	RequestedState string                   `json:"requested_state" yaml:"requested_state"`
+	Processes      []shared.ProcessDocument `json:"processes" yaml:"processes"`
+	Routes         []string                 `json:"routes" yaml:"routes"`
 }
 
 type V3AppsCommand struct {
-	usage interface{} `usage:"CF_NAME v3-apps"`
+	Labels string      `long:"labels" description:"Selector to filter apps by labels, e.g. 'env=prod,tier notin (backend)'"`
+	usage  interface{} `usage:"CF_NAME v3-apps [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME v3-apps\n   CF_NAME v3-apps --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
 
 	UI              command.UI
 	Config          command.Config
@@ -58,6 +72,8 @@ func (cmd *V3AppsCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (V3AppsCommand) SupportsStructuredOutput() {}
+
 func (cmd V3AppsCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
@@ -66,6 +82,15 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	var queries []ccv3.Query
+	if cmd.Labels != "" {
+		err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3, "Option '--labels'")
+		if err != nil {
+			return err
+		}
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{cmd.Labels}})
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -76,19 +101,25 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
-	summaries, warnings, err := cmd.Actor.GetApplicationsWithProcessesBySpace(cmd.Config.TargetedSpace().GUID)
+	summaries, warnings, err := cmd.Actor.GetApplicationsWithProcessesBySpace(cmd.Config.TargetedSpace().GUID, queries...)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayAppsDocument(summaries)
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
@@ -126,3 +157,28 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd V3AppsCommand) displayAppsDocument(summaries []v3action.ApplicationWithProcessSummary) error {
+	document := appsDocument{Apps: []appDocument{}}
+	for _, summary := range summaries {
+		var routes v2action.Routes
+		if len(summary.ProcessSummaries) > 0 {
+			var warnings v2action.Warnings
+			var err error
+			routes, warnings, err = cmd.V2AppRouteActor.GetApplicationRoutes(summary.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+
+		document.Apps = append(document.Apps, appDocument{
+			GUID:           summary.GUID,
+			Name:           summary.Name,
+			RequestedState: strings.ToLower(string(summary.State)),
+			Processes:      shared.NewProcessesDocument(summary.ProcessSummaries),
+			Routes:         shared.NewRoutesDocument(routes),
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(document)
+}
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -113,6 +114,41 @@ var _ = Describe("v3-apps Command", func() {
 		})
 	})
 
+	Context("when a label selector is provided", func() {
+		BeforeEach(func() {
+			cmd.Labels = "env=prod,tier notin (backend)"
+		})
+
+		Context("when the API version is below the minimum for metadata", func() {
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '--labels'",
+					CurrentVersion: ccversion.MinVersionV3,
+					MinimumVersion: ccversion.MinVersionMetadataV3,
+				}))
+				Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the API version supports metadata", func() {
+			BeforeEach(func() {
+				fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+			})
+
+			It("filters the applications by the label selector", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
+				spaceGUID, queries := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(queries).To(ConsistOf(ccv3.Query{
+					Key:    ccv3.LabelSelectorFilter,
+					Values: []string{"env=prod,tier notin (backend)"},
+				}))
+			})
+		})
+	})
+
 	Context("when getting the applications returns an error", func() {
 		var expectedErr error
 
@@ -284,7 +320,7 @@ var _ = Describe("v3-apps Command", func() {
 				Expect(testUI.Err).To(Say("route-warning-4"))
 
 				Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+				spaceGUID, _ := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 
 				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(2))
@@ -320,13 +356,85 @@ var _ = Describe("v3-apps Command", func() {
 				Expect(testUI.Err).To(Say("warning"))
 
 				Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
-				spaceGUID := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+				spaceGUID, _ := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 
 				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(0))
 			})
 		})
 
+		Context("when structured output is requested", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+				appSummaries := []v3action.ApplicationWithProcessSummary{
+					{
+						Application: v3action.Application{
+							GUID:  "app-guid-2",
+							Name:  "some-app-2",
+							State: constant.ApplicationStopped,
+						},
+						ProcessSummaries: []v3action.ProcessSummary{
+							{
+								Process: v3action.Process{
+									Type: constant.ProcessTypeWeb,
+								},
+								InstanceDetails: []v3action.ProcessInstance{},
+							},
+						},
+					},
+					{
+						Application: v3action.Application{
+							GUID:  "app-guid",
+							Name:  "some-app",
+							State: constant.ApplicationStarted,
+						},
+						ProcessSummaries: []v3action.ProcessSummary{},
+					},
+				}
+				fakeActor.GetApplicationsWithProcessesBySpaceReturns(appSummaries, v3action.Warnings{"warning"}, nil)
+			})
+
+			It("displays the apps as JSON and outputs warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting apps in org"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+					"apps": [
+						{
+							"guid": "app-guid-2",
+							"name": "some-app-2",
+							"requested_state": "stopped",
+							"processes": [
+								{
+									"type": "web",
+									"instances": 0,
+									"healthy_instances": 0,
+									"memory_in_mb": 0,
+									"disk_in_mb": 0,
+									"instance_details": []
+								}
+							],
+							"routes": ["some-app-2.some-domain"]
+						},
+						{
+							"guid": "app-guid",
+							"name": "some-app",
+							"requested_state": "started",
+							"processes": [],
+							"routes": []
+						}
+					]
+				}`))
+
+				Expect(testUI.Err).To(Say("warning"))
+				Expect(testUI.Err).To(Say("route-warning-3"))
+				Expect(testUI.Err).To(Say("route-warning-4"))
+
+				Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.GetApplicationRoutesArgsForCall(0)).To(Equal("app-guid-2"))
+			})
+		})
+
 		Context("with no apps", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationsWithProcessesBySpaceReturns([]v3action.ApplicationWithProcessSummary{}, v3action.Warnings{"warning-1", "warning-2"}, nil)
@@ -2,7 +2,11 @@ package v3
 
 import (
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
 
+	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -22,6 +26,8 @@ import (
 
 type V2PushActor interface {
 	CreateAndMapDefaultApplicationRoute(orgGUID string, spaceGUID string, app v2action.Application) (pushaction.Warnings, error)
+	GetUnmatchedResources(resources []sharedaction.Resource) ([]sharedaction.Resource, pushaction.Warnings, error)
+	PlanDefaultApplicationRoute(orgGUID string, spaceGUID string, app v2action.Application) (pushaction.ApplicationPlan, pushaction.Warnings, error)
 }
 
 //go:generate counterfeiter . V3PushActor
@@ -30,29 +36,65 @@ type V3PushActor interface {
 	CloudControllerAPIVersion() string
 	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
 	CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v3action.DockerImageCredentials) (v3action.Package, v3action.Warnings, error)
+	CancelDeployment(deploymentGUID string) (v3action.Warnings, error)
+	CopyApplicationEnvironmentVariables(sourceAppGUID string, destinationAppGUID string) (v3action.Warnings, error)
+	CopyApplicationProcesses(sourceAppGUID string, destinationAppGUID string) (v3action.Warnings, error)
 	CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	CreateDeployment(appGUID string, dropletGUID string) (string, v3action.Warnings, error)
+	DeleteApplicationByNameAndSpace(name string, spaceGUID string) (v3action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+	GetBitsPackageResources(bitsPath string) ([]sharedaction.Resource, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
+	PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error
 	PollStart(appGUID string, warnings chan<- v3action.Warnings) error
 	SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (v3action.Warnings, error)
 	StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 	UpdateApplication(app v3action.Application) (v3action.Application, v3action.Warnings, error)
+	UpdateProcessByTypeAndApplication(processType string, appGUID string, updatedProcess v3action.Process) (v3action.Warnings, error)
 }
 
+//go:generate counterfeiter . V2PushRouteActor
+
+type V2PushRouteActor interface {
+	GetApplicationRoutes(appGUID string) (v2action.Routes, v2action.Warnings, error)
+	MapRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	UnmapRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . V2PushServiceActor
+
+type V2PushServiceActor interface {
+	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	GetServiceBindingsByApplication(appGUID string) ([]v2action.ServiceBinding, v2action.Warnings, error)
+}
+
+const (
+	// blueGreenAppSuffix is appended to the app name to name the temporary app
+	// that a blue-green push stages and starts before taking over the routes.
+	blueGreenAppSuffix = "-blue-green"
+
+	// blueGreenOldAppSuffix is appended to the app name to name the old app
+	// while the temporary app is renamed, until it is deleted.
+	blueGreenOldAppSuffix = "-venerable"
+)
+
 type V3PushCommand struct {
 	RequiredArgs   flag.AppName                `positional-args:"yes"`
 	Buildpacks     []string                    `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	DockerImage    flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DryRun         bool                        `long:"dry-run" description:"Display the changes that would be made without making them; exits with an error if there are changes"`
 	NoRoute        bool                        `long:"no-route" description:"Do not map a route to this app"`
 	NoStart        bool                        `long:"no-start" description:"Do not stage and start the app after pushing"`
 	AppPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	StartCommand   flag.Command                `short:"c" description:"Startup command for the web process, set to null to reset to default start command"`
+	Strategy       flag.DeploymentStrategy     `long:"strategy" description:"Replace a running app without downtime, either 'rolling' (uses CC deployments) or 'blue-green' (pushes to a temporary app and switches routes)"`
 	dockerPassword interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
 
-	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [--no-start]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [--no-start]"`
+	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [-c COMMAND] [--no-route] [--no-start] [--strategy STRATEGY] [--dry-run]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [-c COMMAND] [--no-route] [--no-start] [--strategy STRATEGY] [--dry-run]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -62,8 +104,14 @@ type V3PushCommand struct {
 	SharedActor         command.SharedActor
 	Actor               V3PushActor
 	V2PushActor         V2PushActor
+	V2RouteActor        V2PushRouteActor
+	V2ServiceActor      V2PushServiceActor
 	AppSummaryDisplayer shared.AppSummaryDisplayer
 	PackageDisplayer    shared.PackageDisplayer
+
+	// Interrupt receives the interrupt signal while a deployment is being
+	// waited on, so that the deployment can be canceled.
+	Interrupt chan os.Signal
 }
 
 func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -90,6 +138,9 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.SharedActor = sharedActor
 	cmd.V2PushActor = pushaction.NewActor(v2Actor, cmd.Actor, sharedActor)
+	cmd.V2RouteActor = v2Actor
+	cmd.V2ServiceActor = v2Actor
+	cmd.Interrupt = make(chan os.Signal, 1)
 
 	v2AppActor := v2action.NewActor(ccClientV2, uaaClientV2, config)
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
@@ -133,7 +184,14 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return translatableerror.ConflictingBuildpacksError{}
 	}
 
-	var app v3action.Application
+	if cmd.DryRun {
+		return cmd.displayPlan()
+	}
+
+	var (
+		app      v3action.Application
+		strategy string
+	)
 	app, err = cmd.getApplication()
 	if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
 		app, err = cmd.createApplication(user.Name)
@@ -143,18 +201,31 @@ func (cmd V3PushCommand) Execute(args []string) error {
 	} else if err != nil {
 		return err
 	} else {
+		if app.Started() {
+			strategy = cmd.deploymentStrategy()
+		}
+
+		if strategy == flag.DeploymentStrategyBlueGreen {
+			return cmd.blueGreenPush(app, user.Name)
+		}
+
 		app, err = cmd.updateApplication(user.Name, app.GUID)
 		if err != nil {
 			return err
 		}
 	}
 
+	err = cmd.updateStartCommand(app.GUID)
+	if err != nil {
+		return err
+	}
+
 	pkg, err := cmd.createPackage()
 	if err != nil {
 		return err
 	}
 
-	if app.Started() {
+	if app.Started() && strategy != flag.DeploymentStrategyRolling {
 		err = cmd.stopApplication(app.GUID, user.Name)
 		if err != nil {
 			return err
@@ -170,6 +241,22 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if strategy == flag.DeploymentStrategyRolling {
+		if !cmd.NoRoute {
+			err = cmd.createAndMapRoutes(app)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = cmd.rollingDeploy(app.GUID, dropletGUID, user.Name)
+		if err != nil {
+			return err
+		}
+
+		return cmd.displayAppSummary(user.Name)
+	}
+
 	err = cmd.setApplicationDroplet(dropletGUID, user.Name)
 	if err != nil {
 		return err
@@ -187,40 +274,145 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayText("Waiting for app to start...")
+	err = cmd.waitForStart(app.GUID, nil)
+	if err != nil {
+		return err
+	}
 
-	warnings := make(chan v3action.Warnings)
-	done := make(chan bool)
-	go func() {
-		for {
-			select {
-			case message := <-warnings:
-				cmd.UI.DisplayWarnings(message)
-			case <-done:
-				return
-			}
+	return cmd.displayAppSummary(user.Name)
+}
+
+// displayPlan displays the changes that pushing the app would make without
+// making them. It returns an error if pushing would create the app, change its
+// lifecycle, start command or routes, or upload files that the Cloud
+// Controller does not already have.
+func (cmd V3PushCommand) displayPlan() error {
+	var hasChanges bool
+
+	app, err := cmd.getApplication()
+	if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
+		cmd.UI.DisplayText("Plan for new app {{.AppName}}:", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+		app = v3action.Application{Name: cmd.RequiredArgs.AppName}
+		hasChanges = true
+	} else if err != nil {
+		return err
+	} else {
+		cmd.UI.DisplayText("Plan for app {{.AppName}}:", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+	}
+
+	if cmd.lifecycleChanged(app) {
+		hasChanges = true
+		if cmd.DockerImage.Path != "" {
+			cmd.UI.DisplayText("  set lifecycle to docker")
+		} else {
+			cmd.UI.DisplayText("  set lifecycle to buildpack")
 		}
-	}()
+	}
+	if len(cmd.Buildpacks) > 0 && !stringSlicesEqual(app.LifecycleBuildpacks, cmd.Buildpacks) {
+		hasChanges = true
+		cmd.UI.DisplayText("  set buildpacks to {{.Buildpacks}}", map[string]interface{}{
+			"Buildpacks": strings.Join(cmd.Buildpacks, ", "),
+		})
+	}
 
-	err = cmd.Actor.PollStart(app.GUID, warnings)
-	done <- true
+	if cmd.StartCommand.IsSet {
+		hasChanges = true
+		cmd.UI.DisplayText("  set start command of the web process")
+	}
 
-	if err != nil {
-		if _, ok := err.(actionerror.StartupTimeoutError); ok {
-			return translatableerror.StartupTimeoutError{
-				AppName:    cmd.RequiredArgs.AppName,
-				BinaryName: cmd.Config.BinaryName(),
-			}
+	if !cmd.NoRoute {
+		plan, warnings, err := cmd.V2PushActor.PlanDefaultApplicationRoute(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, v2action.Application{Name: app.Name, GUID: app.GUID})
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
 		}
 
-		return err
+		if plan.HasChanges() {
+			hasChanges = true
+			sharedV2.DisplayApplicationPlan(cmd.UI, plan)
+		}
+	}
+
+	if cmd.DockerImage.Path != "" {
+		cmd.UI.DisplayText("  use docker image {{.DockerImage}}", map[string]interface{}{
+			"DockerImage": cmd.DockerImage.Path,
+		})
+	} else {
+		resources, err := cmd.Actor.GetBitsPackageResources(string(cmd.AppPath))
+		if err != nil {
+			return err
+		}
+
+		unmatched, warnings, err := cmd.V2PushActor.GetUnmatchedResources(resources)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if len(unmatched) > 0 {
+			hasChanges = true
+			cmd.UI.DisplayText("  upload {{.FileCount}} of {{.TotalFileCount}} file(s) ({{.Size}})", map[string]interface{}{
+				"FileCount":      len(unmatched),
+				"TotalFileCount": len(resources),
+				"Size":           bytefmt.ByteSize(uint64(resourcesSize(unmatched))),
+			})
+		} else {
+			cmd.UI.DisplayText("  all {{.FileCount}} file(s) ({{.Size}}) already uploaded", map[string]interface{}{
+				"FileCount": len(resources),
+				"Size":      bytefmt.ByteSize(uint64(resourcesSize(resources))),
+			})
+		}
 	}
 
+	if app.Started() {
+		if strategy := cmd.deploymentStrategy(); strategy != "" {
+			cmd.UI.DisplayText("  deploy with the {{.Strategy}} strategy", map[string]interface{}{
+				"Strategy": strategy,
+			})
+		}
+	}
+	cmd.UI.DisplayNewline()
+
+	if hasChanges {
+		return translatableerror.PushDryRunChangesError{AppNames: []string{cmd.RequiredArgs.AppName}}
+	}
+
+	cmd.UI.DisplayText("No changes to apply.")
+	return nil
+}
+
+// resourcesSize returns the total size in bytes of the resources.
+func resourcesSize(resources []sharedaction.Resource) int64 {
+	var size int64
+	for _, resource := range resources {
+		size += resource.Size
+	}
+	return size
+}
+
+// lifecycleChanged returns true if pushing would change the lifecycle type of
+// the app.
+func (cmd V3PushCommand) lifecycleChanged(app v3action.Application) bool {
+	if app.GUID == "" {
+		return false
+	}
+
+	if cmd.DockerImage.Path != "" {
+		return app.LifecycleType != constant.AppLifecycleTypeDocker
+	}
+	return app.LifecycleType != constant.AppLifecycleTypeBuildpack
+}
+
+func (cmd V3PushCommand) displayAppSummary(userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
 		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
+		"Username":  userName,
 	})
 	cmd.UI.DisplayNewline()
 
@@ -243,6 +435,10 @@ func (cmd V3PushCommand) validateArgs() error {
 		}
 	case cmd.DockerUsername != "" && cmd.Config.DockerPassword() == "":
 		return translatableerror.DockerPasswordNotSetError{}
+	case cmd.Strategy.Name != "" && cmd.NoStart:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--no-start", "--strategy"},
+		}
 	}
 	return nil
 }
@@ -321,6 +517,18 @@ func (cmd V3PushCommand) updateApplication(userName string, appGUID string) (v3a
 	return app, nil
 }
 
+func (cmd V3PushCommand) updateStartCommand(appGUID string) error {
+	if !cmd.StartCommand.IsSet {
+		return nil
+	}
+
+	warnings, err := cmd.Actor.UpdateProcessByTypeAndApplication(constant.ProcessTypeWeb, appGUID, v3action.Process{
+		Command: cmd.StartCommand.FilteredString,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	return err
+}
+
 func (cmd V3PushCommand) createAndMapRoutes(app v3action.Application) error {
 	cmd.UI.DisplayText("Mapping routes...")
 	routeWarnings, err := cmd.V2PushActor.CreateAndMapDefaultApplicationRoute(cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID, v2action.Application{Name: app.Name, GUID: app.GUID})
@@ -443,6 +651,417 @@ func (cmd V3PushCommand) stopApplication(appGUID string, userName string) error
 	return nil
 }
 
+// deploymentStrategy returns the strategy used to replace the running app.
+// Rolling deployments fall back to blue-green when the targeted Cloud
+// Controller does not support deployments.
+func (cmd V3PushCommand) deploymentStrategy() string {
+	if cmd.Strategy.Name != flag.DeploymentStrategyRolling {
+		return cmd.Strategy.Name
+	}
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionZeroDowntimePushV3)
+	if err != nil {
+		cmd.UI.DisplayWarning("Rolling deployments require CC API version {{.MinimumVersion}} or higher. Falling back to a blue-green deployment.", map[string]interface{}{
+			"MinimumVersion": ccversion.MinVersionZeroDowntimePushV3,
+		})
+		return flag.DeploymentStrategyBlueGreen
+	}
+
+	return flag.DeploymentStrategyRolling
+}
+
+func (cmd V3PushCommand) rollingDeploy(appGUID string, dropletGUID string, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Starting rolling deployment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	deploymentGUID, warnings, err := cmd.Actor.CreateDeployment(appGUID, dropletGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Waiting for app to deploy...")
+	err = cmd.waitFor(
+		func(warnings chan<- v3action.Warnings) error {
+			return cmd.Actor.PollDeployment(deploymentGUID, warnings)
+		},
+		func() error {
+			cmd.UI.DisplayText("Canceling deployment for app {{.AppName}}...", map[string]interface{}{
+				"AppName": cmd.RequiredArgs.AppName,
+			})
+			cancelWarnings, cancelErr := cmd.Actor.CancelDeployment(deploymentGUID)
+			cmd.UI.DisplayWarnings(cancelWarnings)
+			return cancelErr
+		},
+	)
+	if err != nil {
+		return cmd.convertStartupTimeoutError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// blueGreenPush pushes the new version of the app to a temporary app with the
+// configuration of the running app, moves the routes of the running app to it
+// once it has started, and swaps the names of the two apps before deleting the
+// old one. Until the old app is deleted, any failure restores the routes and
+// name of the old app and deletes the temporary app.
+func (cmd V3PushCommand) blueGreenPush(oldApp v3action.Application, userName string) error {
+	tempCmd := cmd
+	tempCmd.RequiredArgs.AppName = cmd.RequiredArgs.AppName + blueGreenAppSuffix
+
+	cmd.UI.DisplayTextWithFlavor("Pushing new version of app {{.AppName}} to temporary app {{.TempAppName}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"TempAppName": tempCmd.RequiredArgs.AppName,
+	})
+	cmd.UI.DisplayNewline()
+
+	tempApp, err := tempCmd.createApplication(userName)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.copyApplicationConfiguration(oldApp, tempApp)
+	if err != nil {
+		tempCmd.deleteTemporaryApplication(userName)
+		return err
+	}
+
+	err = tempCmd.updateStartCommand(tempApp.GUID)
+	if err != nil {
+		tempCmd.deleteTemporaryApplication(userName)
+		return err
+	}
+
+	err = tempCmd.startBlueGreenApp(tempApp, oldApp, userName)
+	if err != nil {
+		tempCmd.deleteTemporaryApplication(userName)
+		return err
+	}
+
+	routes, warnings, err := cmd.V2RouteActor.GetApplicationRoutes(oldApp.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		tempCmd.deleteTemporaryApplication(userName)
+		return err
+	}
+
+	// Deleting the temporary app also removes the routes mapped to it, so a
+	// rollback only has to map the routes back to the old app.
+	rollBack := func() {
+		cmd.restoreRoutes(routes, oldApp)
+		tempCmd.deleteTemporaryApplication(userName)
+	}
+
+	if len(routes) > 0 {
+		err = cmd.mapRoutes(routes, tempApp)
+		if err != nil {
+			tempCmd.deleteTemporaryApplication(userName)
+			return err
+		}
+
+		err = cmd.unmapRoutes(routes, oldApp)
+		if err != nil {
+			rollBack()
+			return err
+		}
+	} else if !cmd.NoRoute {
+		err = cmd.createAndMapRoutes(v3action.Application{Name: cmd.RequiredArgs.AppName, GUID: tempApp.GUID})
+		if err != nil {
+			tempCmd.deleteTemporaryApplication(userName)
+			return err
+		}
+	}
+
+	oldCmd := cmd
+	oldCmd.RequiredArgs.AppName = cmd.RequiredArgs.AppName + blueGreenOldAppSuffix
+
+	err = oldCmd.renameApplication(v3action.Application{GUID: oldApp.GUID, Name: cmd.RequiredArgs.AppName}, userName)
+	if err != nil {
+		rollBack()
+		return err
+	}
+
+	err = cmd.renameApplication(tempApp, userName)
+	if err != nil {
+		cmd.restoreApplicationName(v3action.Application{GUID: oldApp.GUID, Name: oldCmd.RequiredArgs.AppName})
+		rollBack()
+		return err
+	}
+
+	err = oldCmd.deleteApplication(userName)
+	if err != nil {
+		return err
+	}
+
+	return cmd.displayAppSummary(userName)
+}
+
+// copyApplicationConfiguration copies the environment variables and service
+// bindings of the old app to the temporary app of a blue-green push, so that
+// they are available while it stages.
+func (cmd V3PushCommand) copyApplicationConfiguration(oldApp v3action.Application, tempApp v3action.Application) error {
+	cmd.UI.DisplayText("Copying configuration of app {{.AppName}} to app {{.TempAppName}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"TempAppName": tempApp.Name,
+	})
+
+	warnings, err := cmd.Actor.CopyApplicationEnvironmentVariables(oldApp.GUID, tempApp.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	bindings, v2Warnings, err := cmd.V2ServiceActor.GetServiceBindingsByApplication(oldApp.GUID)
+	cmd.UI.DisplayWarnings(v2Warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, binding := range bindings {
+		v2Warnings, err = cmd.V2ServiceActor.BindServiceByApplicationAndServiceInstance(tempApp.GUID, binding.ServiceInstanceGUID)
+		cmd.UI.DisplayWarnings(v2Warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd V3PushCommand) startBlueGreenApp(app v3action.Application, oldApp v3action.Application, userName string) error {
+	pkg, err := cmd.createPackage()
+	if err != nil {
+		return err
+	}
+
+	dropletGUID, err := cmd.stagePackage(pkg, userName)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.setApplicationDroplet(dropletGUID, userName)
+	if err != nil {
+		return err
+	}
+
+	// The processes of the temporary app only exist once its droplet is set.
+	warnings, err := cmd.Actor.CopyApplicationProcesses(oldApp.GUID, app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.startApplication(app.GUID, userName)
+	if err != nil {
+		return err
+	}
+
+	return cmd.waitForStart(app.GUID, func() error {
+		return actionerror.DeploymentCanceledError{}
+	})
+}
+
+func (cmd V3PushCommand) mapRoutes(routes v2action.Routes, app v3action.Application) error {
+	cmd.UI.DisplayText("Mapping routes to app {{.AppName}}...", map[string]interface{}{
+		"AppName": app.Name,
+	})
+
+	for _, route := range routes {
+		warnings, err := cmd.V2RouteActor.MapRouteToApplication(route.GUID, app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd V3PushCommand) unmapRoutes(routes v2action.Routes, app v3action.Application) error {
+	cmd.UI.DisplayText("Unmapping routes from app {{.AppName}}...", map[string]interface{}{
+		"AppName": app.Name,
+	})
+
+	for _, route := range routes {
+		warnings, err := cmd.V2RouteActor.UnmapRouteFromApplication(route.GUID, app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// restoreRoutes maps the routes of a failed blue-green push back to the old
+// app. Errors are displayed as warnings so that the error that caused the
+// rollback is the one returned to the user.
+func (cmd V3PushCommand) restoreRoutes(routes v2action.Routes, oldApp v3action.Application) {
+	for _, route := range routes {
+		warnings, err := cmd.V2RouteActor.MapRouteToApplication(route.GUID, oldApp.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Unable to map route {{.Route}} back to app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"Route":   route.String(),
+				"AppName": cmd.RequiredArgs.AppName,
+				"Error":   err.Error(),
+			})
+		}
+	}
+}
+
+// restoreApplicationName renames the old app of a failed blue-green push back
+// to the app name. Errors are displayed as warnings so that the error that
+// caused the rollback is the one returned to the user.
+func (cmd V3PushCommand) restoreApplicationName(oldApp v3action.Application) {
+	_, warnings, err := cmd.Actor.UpdateApplication(v3action.Application{
+		GUID: oldApp.GUID,
+		Name: cmd.RequiredArgs.AppName,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to rename app {{.OldAppName}} back to {{.AppName}}: {{.Error}}", map[string]interface{}{
+			"OldAppName": oldApp.Name,
+			"AppName":    cmd.RequiredArgs.AppName,
+			"Error":      err.Error(),
+		})
+	}
+}
+
+func (cmd V3PushCommand) deleteApplication(userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Deleting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  userName,
+	})
+
+	warnings, err := cmd.Actor.DeleteApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+// deleteTemporaryApplication cleans up the temporary app of a failed
+// blue-green push. Errors are displayed as warnings so that the error that
+// caused the cleanup is the one returned to the user.
+func (cmd V3PushCommand) deleteTemporaryApplication(userName string) {
+	err := cmd.deleteApplication(userName)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to delete temporary app {{.AppName}}: {{.Error}}", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+			"Error":   err.Error(),
+		})
+	}
+}
+
+func (cmd V3PushCommand) renameApplication(app v3action.Application, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Renaming app {{.OldAppName}} to {{.AppName}} as {{.Username}}...", map[string]interface{}{
+		"OldAppName": app.Name,
+		"AppName":    cmd.RequiredArgs.AppName,
+		"Username":   userName,
+	})
+
+	_, warnings, err := cmd.Actor.UpdateApplication(v3action.Application{
+		GUID: app.GUID,
+		Name: cmd.RequiredArgs.AppName,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd V3PushCommand) waitForStart(appGUID string, onInterrupt func() error) error {
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	err := cmd.waitFor(
+		func(warnings chan<- v3action.Warnings) error {
+			return cmd.Actor.PollStart(appGUID, warnings)
+		},
+		onInterrupt,
+	)
+	return cmd.convertStartupTimeoutError(err)
+}
+
+// waitFor displays the warnings sent by poll until it returns. When the user
+// interrupts the wait, onInterrupt is called; if it returns an error the wait
+// is abandoned with that error, otherwise the wait continues so that poll can
+// observe the cancellation. A second interrupt terminates the CLI.
+func (cmd V3PushCommand) waitFor(poll func(chan<- v3action.Warnings) error, onInterrupt func() error) error {
+	warnings := make(chan v3action.Warnings)
+	result := make(chan error, 1)
+	go func() {
+		result <- poll(warnings)
+		close(warnings)
+	}()
+
+	var interrupt chan os.Signal
+	if onInterrupt != nil && cmd.Interrupt != nil {
+		interrupt = cmd.Interrupt
+		signal.Notify(interrupt, os.Interrupt)
+		defer signal.Stop(interrupt)
+	}
+
+	for {
+		select {
+		case message := <-warnings:
+			cmd.UI.DisplayWarnings(message)
+		case err := <-result:
+			for message := range warnings {
+				cmd.UI.DisplayWarnings(message)
+			}
+			return err
+		case <-interrupt:
+			signal.Stop(interrupt)
+			interrupt = nil
+
+			err := onInterrupt()
+			if err != nil {
+				go func() {
+					for range warnings {
+					}
+				}()
+				return err
+			}
+		}
+	}
+}
+
+func (cmd V3PushCommand) convertStartupTimeoutError(err error) error {
+	if _, ok := err.(actionerror.StartupTimeoutError); ok {
+		return translatableerror.StartupTimeoutError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	}
+
+	return err
+}
+
 func verifyBuildpacks(buildpacks []string) bool {
 	if len(buildpacks) < 2 {
 		return true
@@ -455,3 +1074,15 @@ func verifyBuildpacks(buildpacks []string) bool {
 	}
 	return true
 }
+
+func stringSlicesEqual(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
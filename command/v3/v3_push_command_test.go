@@ -2,10 +2,12 @@ package v3_test
 
 import (
 	"errors"
+	"os"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -29,20 +31,22 @@ import (
 
 var _ = Describe("v3-push Command", func() {
 	var (
-		cmd             v3.V3PushCommand
-		testUI          *ui.UI
-		fakeConfig      *commandfakes.FakeConfig
-		fakeSharedActor *commandfakes.FakeSharedActor
-		fakeNOAAClient  *v3actionfakes.FakeNOAAClient
-		fakeActor       *v3fakes.FakeV3PushActor
-		fakeV2PushActor *v3fakes.FakeV2PushActor
-		fakeV2AppActor  *sharedfakes.FakeV2AppRouteActor
-		binaryName      string
-		executeErr      error
-		app             string
-		userName        string
-		spaceName       string
-		orgName         string
+		cmd              v3.V3PushCommand
+		testUI           *ui.UI
+		fakeConfig       *commandfakes.FakeConfig
+		fakeSharedActor  *commandfakes.FakeSharedActor
+		fakeNOAAClient   *v3actionfakes.FakeNOAAClient
+		fakeActor        *v3fakes.FakeV3PushActor
+		fakeV2PushActor  *v3fakes.FakeV2PushActor
+		fakeRouteActor   *v3fakes.FakeV2PushRouteActor
+		fakeServiceActor *v3fakes.FakeV2PushServiceActor
+		fakeV2AppActor   *sharedfakes.FakeV2AppRouteActor
+		binaryName       string
+		executeErr       error
+		app              string
+		userName         string
+		spaceName        string
+		orgName          string
 	)
 
 	BeforeEach(func() {
@@ -51,6 +55,8 @@ var _ = Describe("v3-push Command", func() {
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeV3PushActor)
 		fakeV2PushActor = new(v3fakes.FakeV2PushActor)
+		fakeRouteActor = new(v3fakes.FakeV2PushRouteActor)
+		fakeServiceActor = new(v3fakes.FakeV2PushServiceActor)
 		fakeV2AppActor = new(sharedfakes.FakeV2AppRouteActor)
 		fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
 
@@ -84,6 +90,9 @@ var _ = Describe("v3-push Command", func() {
 			Actor:       fakeActor,
 			V2PushActor: fakeV2PushActor,
 
+			V2RouteActor:   fakeRouteActor,
+			V2ServiceActor: fakeServiceActor,
+
 			NOAAClient:          fakeNOAAClient,
 			AppSummaryDisplayer: appSummaryDisplayer,
 			PackageDisplayer:    packageDisplayer,
@@ -177,6 +186,19 @@ var _ = Describe("v3-push Command", func() {
 			}),
 	)
 
+	Context("when a strategy is provided with --no-start", func() {
+		BeforeEach(func() {
+			cmd.Strategy.Name = flag.DeploymentStrategyRolling
+			cmd.NoStart = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--no-start", "--strategy"},
+			}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -200,6 +222,157 @@ var _ = Describe("v3-push Command", func() {
 
 		})
 
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+				fakeActor.GetBitsPackageResourcesReturns([]sharedaction.Resource{
+					{Filename: "file-1", Size: 1024},
+					{Filename: "file-2", Size: 1024},
+					{Filename: "file-3", Size: 1024},
+				}, nil)
+				fakeV2PushActor.GetUnmatchedResourcesReturns([]sharedaction.Resource{
+					{Filename: "file-2", Size: 1024},
+					{Filename: "file-3", Size: 1024},
+				}, pushaction.Warnings{"resource-match-warning"}, nil)
+			})
+
+			Context("when the application doesn't exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-warning"}, actionerror.ApplicationNotFoundError{Name: app})
+					fakeV2PushActor.PlanDefaultApplicationRouteReturns(pushaction.ApplicationPlan{
+						RoutesToCreate: []v2action.Route{{Host: app, Domain: v2action.Domain{Name: "example.com"}}},
+						RoutesToMap:    []v2action.Route{{Host: app, Domain: v2action.Domain{Name: "example.com"}}},
+					}, pushaction.Warnings{"route-plan-warning"}, nil)
+				})
+
+				It("displays the plan and returns a PushDryRunChangesError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesError{AppNames: []string{app}}))
+
+					Expect(testUI.Out).To(Say("Plan for new app some-app:"))
+					Expect(testUI.Out).To(Say("create route some-app.example.com"))
+					Expect(testUI.Out).To(Say("map route some-app.example.com"))
+					Expect(testUI.Out).To(Say("upload 2 of 3 file\\(s\\) \\(2K\\)"))
+					Expect(testUI.Err).To(Say("get-warning"))
+					Expect(testUI.Err).To(Say("route-plan-warning"))
+					Expect(testUI.Err).To(Say("resource-match-warning"))
+
+					Expect(fakeV2PushActor.PlanDefaultApplicationRouteCallCount()).To(Equal(1))
+					orgGUID, spaceGUID, v2App := fakeV2PushActor.PlanDefaultApplicationRouteArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(v2App).To(Equal(v2action.Application{Name: app}))
+
+					Expect(fakeActor.GetBitsPackageResourcesCallCount()).To(Equal(1))
+					Expect(fakeV2PushActor.GetUnmatchedResourcesCallCount()).To(Equal(1))
+					Expect(fakeV2PushActor.GetUnmatchedResourcesArgsForCall(0)).To(HaveLen(3))
+
+					Expect(fakeActor.CreateApplicationInSpaceCallCount()).To(Equal(0))
+					Expect(fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+					Expect(fakeV2PushActor.CreateAndMapDefaultApplicationRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the application exists and nothing would change", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{
+						Name:          app,
+						GUID:          "some-app-guid",
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					}, nil, nil)
+					fakeV2PushActor.PlanDefaultApplicationRouteReturns(pushaction.ApplicationPlan{}, nil, nil)
+					fakeV2PushActor.GetUnmatchedResourcesReturns(nil, nil, nil)
+				})
+
+				It("displays the plan and succeeds", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Plan for app some-app:"))
+					Expect(testUI.Out).To(Say("all 3 file\\(s\\) \\(3K\\) already uploaded"))
+					Expect(testUI.Out).To(Say("No changes to apply\\."))
+
+					Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the application exists and some files are not uploaded yet", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{
+						Name:          app,
+						GUID:          "some-app-guid",
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					}, nil, nil)
+					fakeV2PushActor.PlanDefaultApplicationRouteReturns(pushaction.ApplicationPlan{}, nil, nil)
+				})
+
+				It("displays the files to upload and returns a PushDryRunChangesError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesError{AppNames: []string{app}}))
+
+					Expect(testUI.Out).To(Say("upload 2 of 3 file\\(s\\) \\(2K\\)"))
+				})
+			})
+
+			Context("when resource matching fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{Name: app, GUID: "some-app-guid"}, nil, nil)
+					fakeV2PushActor.GetUnmatchedResourcesReturns(nil, pushaction.Warnings{"resource-match-warning"}, errors.New("some-match-error"))
+				})
+
+				It("returns the error and displays the warnings", func() {
+					Expect(executeErr).To(MatchError("some-match-error"))
+					Expect(testUI.Err).To(Say("resource-match-warning"))
+				})
+			})
+
+			Context("when the application exists and its lifecycle would change", func() {
+				BeforeEach(func() {
+					cmd.DockerImage.Path = "some-docker-image"
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{
+						Name:          app,
+						GUID:          "some-app-guid",
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					}, nil, nil)
+					fakeV2PushActor.PlanDefaultApplicationRouteReturns(pushaction.ApplicationPlan{}, nil, nil)
+				})
+
+				It("returns a PushDryRunChangesError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesError{AppNames: []string{app}}))
+
+					Expect(testUI.Out).To(Say("set lifecycle to docker"))
+					Expect(testUI.Out).To(Say("use docker image some-docker-image"))
+					Expect(fakeActor.GetBitsPackageResourcesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --no-route is provided", func() {
+				BeforeEach(func() {
+					cmd.NoRoute = true
+					fakeV2PushActor.GetUnmatchedResourcesReturns(nil, nil, nil)
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{
+						Name:          app,
+						GUID:          "some-app-guid",
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					}, nil, nil)
+				})
+
+				It("does not plan the default route", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeV2PushActor.PlanDefaultApplicationRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when planning the default route fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{Name: app, GUID: "some-app-guid"}, nil, nil)
+					fakeV2PushActor.PlanDefaultApplicationRouteReturns(pushaction.ApplicationPlan{}, pushaction.Warnings{"route-plan-warning"}, errors.New("some-route-error"))
+				})
+
+				It("returns the error and displays the warnings", func() {
+					Expect(executeErr).To(MatchError("some-route-error"))
+					Expect(testUI.Err).To(Say("route-plan-warning"))
+				})
+			})
+		})
+
 		Context("when looking up the application returns some api error", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-warning"}, errors.New("some-error"))
@@ -253,6 +426,44 @@ var _ = Describe("v3-push Command", func() {
 					Expect(createSpaceGUID).To(Equal("some-space-guid"))
 				})
 
+				It("does not update the start command", func() {
+					Expect(fakeActor.UpdateProcessByTypeAndApplicationCallCount()).To(Equal(0))
+				})
+
+				Context("when a start command is provided with -c", func() {
+					BeforeEach(func() {
+						cmd.StartCommand = flag.Command{FilteredString: types.FilteredString{IsSet: true, Value: "some-command"}}
+						fakeActor.UpdateProcessByTypeAndApplicationReturns(v3action.Warnings{"update-process-warning"}, nil)
+					})
+
+					It("sets the command of the web process and displays warnings", func() {
+						Expect(testUI.Err).To(Say("update-process-warning"))
+
+						Expect(fakeActor.UpdateProcessByTypeAndApplicationCallCount()).To(Equal(1))
+						processType, appGUID, process := fakeActor.UpdateProcessByTypeAndApplicationArgsForCall(0)
+						Expect(processType).To(Equal(constant.ProcessTypeWeb))
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(process).To(Equal(v3action.Process{
+							Command: types.FilteredString{IsSet: true, Value: "some-command"},
+						}))
+					})
+
+					Context("when updating the start command fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("update process error")
+							fakeActor.UpdateProcessByTypeAndApplicationReturns(v3action.Warnings{"update-process-warning"}, expectedErr)
+						})
+
+						It("returns the error without creating a package", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("update-process-warning"))
+							Expect(fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when creating the package fails", func() {
 					var expectedErr error
 
@@ -923,6 +1134,307 @@ var _ = Describe("v3-push Command", func() {
 				})
 			})
 
+			Context("when the application is running and a strategy is provided", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{
+						Name:  "some-app",
+						GUID:  "some-app-guid",
+						State: constant.ApplicationStarted,
+					}, nil, nil)
+					fakeActor.UpdateApplicationReturns(v3action.Application{GUID: "some-app-guid", State: constant.ApplicationStarted}, nil, nil)
+				})
+
+				Context("when the strategy is rolling", func() {
+					BeforeEach(func() {
+						cmd.Strategy.Name = flag.DeploymentStrategyRolling
+						fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionZeroDowntimePushV3)
+						fakeActor.CreateDeploymentReturns("some-deployment-guid", v3action.Warnings{"deployment-warning"}, nil)
+						fakeActor.PollDeploymentStub = func(_ string, warnings chan<- v3action.Warnings) error {
+							warnings <- v3action.Warnings{"poll-warning"}
+							return nil
+						}
+					})
+
+					It("deploys the new droplet without stopping the app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+						Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+						Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+
+						Expect(testUI.Out).To(Say(`Starting rolling deployment for app some-app in org some-org / space some-space as banana\.\.\.`))
+						Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
+						Expect(testUI.Out).To(Say(`Showing health and status for app some-app`))
+						Expect(testUI.Err).To(Say("deployment-warning"))
+						Expect(testUI.Err).To(Say("poll-warning"))
+
+						Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(1))
+						appGUID, _ := fakeActor.CreateDeploymentArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						deploymentGUID, _ := fakeActor.PollDeploymentArgsForCall(0)
+						Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+						Expect(fakeV2PushActor.CreateAndMapDefaultApplicationRouteCallCount()).To(Equal(1))
+					})
+
+					Context("when the deployment times out", func() {
+						BeforeEach(func() {
+							fakeActor.PollDeploymentStub = nil
+							fakeActor.PollDeploymentReturns(actionerror.StartupTimeoutError{})
+						})
+
+						It("returns a StartupTimeoutError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+								AppName:    "some-app",
+								BinaryName: binaryName,
+							}))
+						})
+					})
+
+					Context("when the user interrupts the deployment", func() {
+						BeforeEach(func() {
+							canceled := make(chan struct{})
+							fakeActor.CancelDeploymentStub = func(_ string) (v3action.Warnings, error) {
+								close(canceled)
+								return v3action.Warnings{"cancel-warning"}, nil
+							}
+							fakeActor.PollDeploymentStub = func(_ string, _ chan<- v3action.Warnings) error {
+								<-canceled
+								return actionerror.DeploymentCanceledError{}
+							}
+
+							cmd.Interrupt = make(chan os.Signal, 1)
+							cmd.Interrupt <- os.Interrupt
+						})
+
+						It("cancels the deployment and waits for it to roll back", func() {
+							Expect(executeErr).To(MatchError(actionerror.DeploymentCanceledError{}))
+
+							Expect(testUI.Out).To(Say("Canceling deployment for app some-app..."))
+							Expect(testUI.Err).To(Say("cancel-warning"))
+							Expect(fakeActor.CancelDeploymentCallCount()).To(Equal(1))
+							Expect(fakeActor.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+						})
+					})
+
+					Context("when the API does not support deployments", func() {
+						BeforeEach(func() {
+							fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+						})
+
+						It("falls back to a blue-green deployment", func() {
+							Expect(testUI.Err).To(Say("Rolling deployments require CC API version 3.57.0 or higher. Falling back to a blue-green deployment."))
+							Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+							Expect(fakeActor.CreateApplicationInSpaceCallCount()).To(Equal(1))
+						})
+					})
+				})
+
+				Context("when the strategy is blue-green", func() {
+					BeforeEach(func() {
+						cmd.Strategy.Name = flag.DeploymentStrategyBlueGreen
+						fakeActor.CreateApplicationInSpaceReturns(v3action.Application{Name: "some-app-blue-green", GUID: "temp-app-guid"}, nil, nil)
+						fakeRouteActor.GetApplicationRoutesReturns(v2action.Routes{
+							{GUID: "route-guid-1"},
+							{GUID: "route-guid-2"},
+						}, v2action.Warnings{"get-routes-warning"}, nil)
+						fakeServiceActor.GetServiceBindingsByApplicationReturns([]v2action.ServiceBinding{
+							{GUID: "binding-guid", ServiceInstanceGUID: "service-instance-guid"},
+						}, v2action.Warnings{"get-bindings-warning"}, nil)
+					})
+
+					It("pushes to a temporary app and replaces the old app with it", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Pushing new version of app some-app to temporary app some-app-blue-green..."))
+						Expect(testUI.Out).To(Say("Creating app some-app-blue-green"))
+						Expect(testUI.Out).To(Say("Copying configuration of app some-app to app some-app-blue-green..."))
+						Expect(testUI.Out).To(Say("Waiting for app to start..."))
+						Expect(testUI.Out).To(Say("Mapping routes to app some-app-blue-green..."))
+						Expect(testUI.Out).To(Say("Unmapping routes from app some-app..."))
+						Expect(testUI.Out).To(Say("Renaming app some-app to some-app-venerable as banana..."))
+						Expect(testUI.Out).To(Say("Renaming app some-app-blue-green to some-app as banana..."))
+						Expect(testUI.Out).To(Say("Deleting app some-app-venerable in org some-org / space some-space as banana..."))
+						Expect(testUI.Out).To(Say("Showing health and status for app some-app"))
+						Expect(testUI.Err).To(Say("get-bindings-warning"))
+						Expect(testUI.Err).To(Say("get-routes-warning"))
+
+						Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+
+						appToCreate, _ := fakeActor.CreateApplicationInSpaceArgsForCall(0)
+						Expect(appToCreate.Name).To(Equal("some-app-blue-green"))
+						appName, _, _ := fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app-blue-green"))
+						Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("temp-app-guid"))
+						pollAppGUID, _ := fakeActor.PollStartArgsForCall(0)
+						Expect(pollAppGUID).To(Equal("temp-app-guid"))
+
+						Expect(fakeActor.CopyApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+						sourceGUID, destinationGUID := fakeActor.CopyApplicationEnvironmentVariablesArgsForCall(0)
+						Expect(sourceGUID).To(Equal("some-app-guid"))
+						Expect(destinationGUID).To(Equal("temp-app-guid"))
+						Expect(fakeServiceActor.GetServiceBindingsByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+						Expect(fakeServiceActor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(1))
+						boundAppGUID, serviceInstanceGUID := fakeServiceActor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+						Expect(boundAppGUID).To(Equal("temp-app-guid"))
+						Expect(serviceInstanceGUID).To(Equal("service-instance-guid"))
+						Expect(fakeActor.CopyApplicationProcessesCallCount()).To(Equal(1))
+						sourceGUID, destinationGUID = fakeActor.CopyApplicationProcessesArgsForCall(0)
+						Expect(sourceGUID).To(Equal("some-app-guid"))
+						Expect(destinationGUID).To(Equal("temp-app-guid"))
+
+						Expect(fakeRouteActor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+						Expect(fakeRouteActor.MapRouteToApplicationCallCount()).To(Equal(2))
+						routeGUID, appGUID := fakeRouteActor.MapRouteToApplicationArgsForCall(1)
+						Expect(routeGUID).To(Equal("route-guid-2"))
+						Expect(appGUID).To(Equal("temp-app-guid"))
+						Expect(fakeRouteActor.UnmapRouteFromApplicationCallCount()).To(Equal(2))
+						routeGUID, appGUID = fakeRouteActor.UnmapRouteFromApplicationArgsForCall(0)
+						Expect(routeGUID).To(Equal("route-guid-1"))
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(fakeV2PushActor.CreateAndMapDefaultApplicationRouteCallCount()).To(Equal(0))
+
+						Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						deletedAppName, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+						Expect(deletedAppName).To(Equal("some-app-venerable"))
+						Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(2))
+						Expect(fakeActor.UpdateApplicationArgsForCall(0)).To(Equal(v3action.Application{
+							GUID: "some-app-guid",
+							Name: "some-app-venerable",
+						}))
+						Expect(fakeActor.UpdateApplicationArgsForCall(1)).To(Equal(v3action.Application{
+							GUID: "temp-app-guid",
+							Name: "some-app",
+						}))
+					})
+
+					Context("when copying the configuration fails", func() {
+						BeforeEach(func() {
+							fakeServiceActor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-warning"}, errors.New("some-bind-error"))
+						})
+
+						It("deletes the temporary app without staging it", func() {
+							Expect(executeErr).To(MatchError("some-bind-error"))
+							Expect(testUI.Err).To(Say("bind-warning"))
+
+							Expect(fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+							deletedAppName, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+							Expect(deletedAppName).To(Equal("some-app-blue-green"))
+						})
+					})
+
+					Context("when copying the processes fails", func() {
+						BeforeEach(func() {
+							fakeActor.CopyApplicationProcessesReturns(v3action.Warnings{"copy-processes-warning"}, errors.New("some-scale-error"))
+						})
+
+						It("deletes the temporary app without starting it", func() {
+							Expect(executeErr).To(MatchError("some-scale-error"))
+							Expect(testUI.Err).To(Say("copy-processes-warning"))
+
+							Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+							deletedAppName, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+							Expect(deletedAppName).To(Equal("some-app-blue-green"))
+						})
+					})
+
+					Context("when unmapping the routes from the old app fails", func() {
+						BeforeEach(func() {
+							fakeRouteActor.UnmapRouteFromApplicationReturnsOnCall(1, nil, errors.New("some-unmap-error"))
+						})
+
+						It("maps the routes back to the old app and deletes the temporary app", func() {
+							Expect(executeErr).To(MatchError("some-unmap-error"))
+
+							Expect(fakeRouteActor.MapRouteToApplicationCallCount()).To(Equal(4))
+							routeGUID, appGUID := fakeRouteActor.MapRouteToApplicationArgsForCall(2)
+							Expect(routeGUID).To(Equal("route-guid-1"))
+							Expect(appGUID).To(Equal("some-app-guid"))
+							routeGUID, appGUID = fakeRouteActor.MapRouteToApplicationArgsForCall(3)
+							Expect(routeGUID).To(Equal("route-guid-2"))
+							Expect(appGUID).To(Equal("some-app-guid"))
+
+							Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+							deletedAppName, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+							Expect(deletedAppName).To(Equal("some-app-blue-green"))
+						})
+					})
+
+					Context("when renaming the temporary app fails", func() {
+						BeforeEach(func() {
+							fakeActor.UpdateApplicationReturnsOnCall(1, v3action.Application{}, v3action.Warnings{"rename-warning"}, errors.New("some-rename-error"))
+						})
+
+						It("restores the old app and deletes the temporary app", func() {
+							Expect(executeErr).To(MatchError("some-rename-error"))
+							Expect(testUI.Err).To(Say("rename-warning"))
+
+							Expect(fakeActor.UpdateApplicationCallCount()).To(Equal(3))
+							Expect(fakeActor.UpdateApplicationArgsForCall(2)).To(Equal(v3action.Application{
+								GUID: "some-app-guid",
+								Name: "some-app",
+							}))
+
+							Expect(fakeRouteActor.MapRouteToApplicationCallCount()).To(Equal(4))
+							_, appGUID := fakeRouteActor.MapRouteToApplicationArgsForCall(3)
+							Expect(appGUID).To(Equal("some-app-guid"))
+
+							Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+							deletedAppName, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+							Expect(deletedAppName).To(Equal("some-app-blue-green"))
+						})
+					})
+
+					Context("when the temporary app fails to start", func() {
+						BeforeEach(func() {
+							fakeActor.PollStartReturns(errors.New("some-start-error"))
+						})
+
+						It("deletes the temporary app and leaves the old app running", func() {
+							Expect(executeErr).To(MatchError("some-start-error"))
+
+							Expect(testUI.Out).To(Say("Deleting app some-app-blue-green"))
+							Expect(fakeActor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+							deletedAppName, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+							Expect(deletedAppName).To(Equal("some-app-blue-green"))
+							Expect(fakeRouteActor.MapRouteToApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the user interrupts the push", func() {
+						BeforeEach(func() {
+							fakeActor.PollStartStub = func(_ string, _ chan<- v3action.Warnings) error {
+								select {}
+							}
+
+							cmd.Interrupt = make(chan os.Signal, 1)
+							cmd.Interrupt <- os.Interrupt
+						})
+
+						It("deletes the temporary app and returns a DeploymentCanceledError", func() {
+							Expect(executeErr).To(MatchError(actionerror.DeploymentCanceledError{}))
+
+							deletedAppName, _ := fakeActor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+							Expect(deletedAppName).To(Equal("some-app-blue-green"))
+						})
+					})
+
+					Context("when the old app has no routes", func() {
+						BeforeEach(func() {
+							fakeRouteActor.GetApplicationRoutesReturns(nil, nil, nil)
+						})
+
+						It("maps the default route to the new app", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeV2PushActor.CreateAndMapDefaultApplicationRouteCallCount()).To(Equal(1))
+							_, _, v2App := fakeV2PushActor.CreateAndMapDefaultApplicationRouteArgsForCall(0)
+							Expect(v2App).To(Equal(v2action.Application{Name: "some-app", GUID: "temp-app-guid"}))
+						})
+					})
+				})
+			})
+
 			Context("when updating the application succeeds", func() {
 				Context("when the application is stopped", func() {
 					BeforeEach(func() {
@@ -1,7 +1,10 @@
 package v3
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -12,12 +15,14 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . SSHActor
 
 type SSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellCommandOnInstances(newSSHClient func() sharedaction.SecureShellClient, instances []sharedaction.InstanceCommandOptions, maxInFlight int) []sharedaction.InstanceCommandResult
 }
 
 //go:generate counterfeiter . V3SSHActor
@@ -25,21 +30,27 @@ type SSHActor interface {
 type V3SSHActor interface {
 	CloudControllerAPIVersion() string
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v3action.SSHAuthentication, v3action.Warnings, error)
+	GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string) ([]v3action.SSHAuthentication, v3action.Warnings, error)
+	GetSSHPasscode() (string, error)
 }
 
 type V3SSHCommand struct {
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" description:"App process instance index (Default: 0)"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" description:"App process name (Default: web)"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"cf v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Run the command on every running instance in parallel, prefixing output with the instance index"`
+	MaxInFlight             int                             `long:"max-in-flight" default:"10" description:"Maximum number of instances to run the command on at once with --all-instances"`
+	ProcessIndex            uint                            `long:"app-instance-index" short:"i" description:"App process instance index (Default: 0)"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic port forward specification, as a local SOCKS5 proxy"`
+	ProcessType             string                          `long:"process" description:"App process name (Default: web)"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"cf v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]...\n   [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n   cf v3-ssh APP_NAME --all-instances -c COMMAND [--process PROCESS] [--max-in-flight NUMBER] [--skip-host-validation]"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 
 	UI          command.UI
@@ -48,6 +59,9 @@ type V3SSHCommand struct {
 	Actor       V3SSHActor
 	SSHActor    SSHActor
 	SSHClient   *clissh.SecureShell
+
+	// NewSSHClient returns a client for each instance with --all-instances.
+	NewSSHClient func() sharedaction.SecureShellClient
 }
 
 func (cmd *V3SSHCommand) Setup(config command.Config, ui command.UI) error {
@@ -69,6 +83,9 @@ func (cmd *V3SSHCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
 
 	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+		return clissh.NewDefaultSecureShell()
+	}
 
 	return nil
 }
@@ -95,11 +112,25 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 		cmd.ProcessType = "web"
 	}
 
+	if cmd.AllInstances {
+		return cmd.executeOnAllInstances()
+	}
+
 	var forwardSpecs []sharedaction.LocalPortForward
 	for _, spec := range cmd.LocalPortForwardSpecs {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardAddresses []string
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardAddresses = append(dynamicForwardAddresses, spec.LocalAddress)
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -114,15 +145,17 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			Commands:                    cmd.Commands,
+			DynamicPortForwardAddresses: dynamicForwardAddresses,
+			Endpoint:                    sshAuth.Endpoint,
+			HostKeyFingerprint:          sshAuth.HostKeyFingerprint,
+			LocalPortForwardSpecs:       forwardSpecs,
+			Passcode:                    sshAuth.Passcode,
+			RemotePortForwardSpecs:      remoteForwardSpecs,
+			SkipHostValidation:          cmd.SkipHostValidation,
+			SkipRemoteExecution:         cmd.SkipRemoteExecution,
+			TTYOption:                   ttyOption,
+			Username:                    sshAuth.Username,
 		})
 	if err != nil {
 		return err
@@ -131,6 +164,119 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd V3SSHCommand) executeOnAllInstances() error {
+	err := cmd.validateAllInstancesArgs()
+	if err != nil {
+		return err
+	}
+
+	sshAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessType(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	outLock := &sync.Mutex{}
+	errLock := &sync.Mutex{}
+	var writers []*ui.LinePrefixWriter
+	var instances []sharedaction.InstanceCommandOptions
+	for _, sshAuth := range sshAuths {
+		prefix := fmt.Sprintf("[%d] ", sshAuth.InstanceIndex)
+		stdout := ui.NewLinePrefixWriter(cmd.UI.GetOut(), prefix, outLock)
+		stderr := ui.NewLinePrefixWriter(cmd.UI.GetErr(), prefix, errLock)
+		writers = append(writers, stdout, stderr)
+
+		instances = append(instances, sharedaction.InstanceCommandOptions{
+			InstanceIndex: sshAuth.InstanceIndex,
+			SSHOptions: sharedaction.SSHOptions{
+				Commands:           cmd.Commands,
+				Endpoint:           sshAuth.Endpoint,
+				HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+				SkipHostValidation: cmd.SkipHostValidation,
+				Username:           sshAuth.Username,
+			},
+			GetPasscode: cmd.Actor.GetSSHPasscode,
+			Stdout:      stdout,
+			Stderr:      stderr,
+		})
+	}
+
+	results := cmd.SSHActor.ExecuteSecureShellCommandOnInstances(cmd.NewSSHClient, instances, cmd.MaxInFlight)
+	for _, writer := range writers {
+		_ = writer.Flush()
+	}
+
+	cmd.UI.DisplayNewline()
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("exit status"),
+		},
+	}
+
+	var failedIndexes []string
+	for _, result := range results {
+		status := fmt.Sprint(result.ExitStatus)
+		if result.Err != nil {
+			status = result.Err.Error()
+		}
+		if result.Err != nil || result.ExitStatus != 0 {
+			failedIndexes = append(failedIndexes, fmt.Sprint(result.InstanceIndex))
+		}
+
+		table = append(table, []string{fmt.Sprintf("#%d", result.InstanceIndex), status})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if len(failedIndexes) > 0 {
+		return translatableerror.SSHCommandFailedOnInstancesError{
+			InstanceIndexes: strings.Join(failedIndexes, ", "),
+		}
+	}
+
+	return nil
+}
+
+// validateAllInstancesArgs returns an error when --all-instances is used
+// without a command or with flags that only apply to a single session.
+func (cmd V3SSHCommand) validateAllInstancesArgs() error {
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}
+	}
+
+	conflicts := []string{"--all-instances"}
+	if cmd.ProcessIndex != 0 {
+		conflicts = append(conflicts, "--app-instance-index, -i")
+	}
+	if len(cmd.LocalPortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-L")
+	}
+	if len(cmd.RemotePortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-R")
+	}
+	if len(cmd.DynamicPortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-D")
+	}
+	if cmd.SkipRemoteExecution {
+		conflicts = append(conflicts, "--skip-remote-execution, -N")
+	}
+	if cmd.ForcePseudoTTY {
+		conflicts = append(conflicts, "--force-pseudo-tty")
+	}
+	if cmd.RequestPseudoTTY {
+		conflicts = append(conflicts, "--request-pseudo-tty, -t")
+	}
+	if len(conflicts) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: conflicts}
+	}
+
+	return nil
+}
+
 func (cmd V3SSHCommand) parseForwardSpecs() ([]sharedaction.LocalPortForward, error) {
 	return nil, nil
 }
@@ -191,6 +191,28 @@ var _ = Describe("v3-ssh Command", func() {
 					})
 				})
 
+				Context("when working with remote and dynamic port forwarding", func() {
+					BeforeEach(func() {
+						cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+							{RemoteAddress: "localhost:5005", LocalAddress: "localhost:8000"},
+						}
+						cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+							{LocalAddress: "localhost:1080"},
+						}
+					})
+
+					It("passes along the remote forwarding specs and SOCKS proxy addresses", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+						_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+						Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+							{RemoteAddress: "localhost:5005", LocalAddress: "localhost:8000"},
+						}))
+						Expect(sshOptionsArg.DynamicPortForwardAddresses).To(Equal([]string{"localhost:1080"}))
+					})
+				})
+
 				Context("when executing the secure shell fails", func() {
 					BeforeEach(func() {
 						cmd.DisablePseudoTTY = true
@@ -215,6 +237,135 @@ var _ = Describe("v3-ssh Command", func() {
 					Expect(testUI.Err).To(Say("some-warnings"))
 				})
 			})
+
+			Context("when running a command on all instances", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = true
+					cmd.ProcessIndex = 0
+					cmd.SkipRemoteExecution = false
+					cmd.MaxInFlight = 5
+
+					fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns(
+						[]v3action.SSHAuthentication{
+							{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Username: "some-username/0", InstanceIndex: 0},
+							{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Username: "some-username/2", InstanceIndex: 2},
+						},
+						v3action.Warnings{"some-warnings"},
+						nil,
+					)
+
+					fakeSSHActor.ExecuteSecureShellCommandOnInstancesStub = func(_ func() sharedaction.SecureShellClient, instances []sharedaction.InstanceCommandOptions, _ int) []sharedaction.InstanceCommandResult {
+						var results []sharedaction.InstanceCommandResult
+						for _, instance := range instances {
+							_, err := instance.Stdout.Write([]byte("some-output\npartial"))
+							Expect(err).ToNot(HaveOccurred())
+							results = append(results, sharedaction.InstanceCommandResult{InstanceIndex: instance.InstanceIndex})
+						}
+						return results
+					}
+				})
+
+				It("runs the command on every running instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("some-warnings"))
+
+					Expect(fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(1))
+					appNameArg, spaceGUIDArg, processTypeArg := fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+					Expect(appNameArg).To(Equal(appName))
+					Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+					Expect(processTypeArg).To(Equal("some-process-type"))
+
+					Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+					Expect(fakeSSHActor.ExecuteSecureShellCommandOnInstancesCallCount()).To(Equal(1))
+					_, instancesArg, maxInFlightArg := fakeSSHActor.ExecuteSecureShellCommandOnInstancesArgsForCall(0)
+					Expect(maxInFlightArg).To(Equal(5))
+					Expect(instancesArg).To(HaveLen(2))
+					Expect(instancesArg[1].InstanceIndex).To(Equal(uint(2)))
+					Expect(instancesArg[1].SSHOptions).To(Equal(sharedaction.SSHOptions{
+						Commands:           []string{"some", "commands"},
+						Endpoint:           "some-endpoint",
+						HostKeyFingerprint: "some-fingerprint",
+						SkipHostValidation: true,
+						Username:           "some-username/2",
+					}))
+				})
+
+				It("has each instance fetch its own passcode when it connects", func() {
+					fakeActor.GetSSHPasscodeReturns("some-passcode", nil)
+
+					_, instancesArg, _ := fakeSSHActor.ExecuteSecureShellCommandOnInstancesArgsForCall(0)
+					Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(0))
+					for _, instance := range instancesArg {
+						Expect(instance.GetPasscode()).To(Equal("some-passcode"))
+					}
+					Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(2))
+				})
+
+				It("prefixes the output with the instance index and displays the exit statuses", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`\[0\] some-output\n`))
+					Expect(testUI.Out).To(Say(`\[2\] some-output\n`))
+					Expect(testUI.Out).To(Say(`\[0\] partial\n`))
+					Expect(testUI.Out).To(Say(`\[2\] partial\n`))
+					Expect(testUI.Out).To(Say(`instance\s+exit status`))
+					Expect(testUI.Out).To(Say(`#0\s+0`))
+					Expect(testUI.Out).To(Say(`#2\s+0`))
+				})
+
+				Context("when the command fails on some instances", func() {
+					BeforeEach(func() {
+						fakeSSHActor.ExecuteSecureShellCommandOnInstancesStub = nil
+						fakeSSHActor.ExecuteSecureShellCommandOnInstancesReturns([]sharedaction.InstanceCommandResult{
+							{InstanceIndex: 0, ExitStatus: 3},
+							{InstanceIndex: 2, Err: errors.New("some-connect-error")},
+						})
+					})
+
+					It("displays each instance's result and returns an error naming them", func() {
+						Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedOnInstancesError{InstanceIndexes: "0, 2"}))
+						Expect(testUI.Out).To(Say(`#0\s+3`))
+						Expect(testUI.Out).To(Say(`#2\s+some-connect-error`))
+					})
+				})
+
+				Context("when no command is provided", func() {
+					BeforeEach(func() {
+						cmd.Commands = nil
+					})
+
+					It("returns a RequiredFlagsError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command, -c"}))
+						Expect(fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when flags for a single session are provided", func() {
+					BeforeEach(func() {
+						cmd.ProcessIndex = 1
+						cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{
+							{LocalAddress: "localhost:8888", RemoteAddress: "remote:4444"},
+						}
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+							Args: []string{"--all-instances", "--app-instance-index, -i", "-L"},
+						}))
+					})
+				})
+
+				Context("when getting the secure shell configurations fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceAndProcessTypeReturns(nil, v3action.Warnings{"some-warnings"}, errors.New("some-error"))
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError("some-error"))
+						Expect(testUI.Err).To(Say("some-warnings"))
+						Expect(fakeSSHActor.ExecuteSecureShellCommandOnInstancesCallCount()).To(Equal(0))
+					})
+				})
+			})
 		})
 	})
 
@@ -21,6 +21,19 @@ type FakeSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellCommandOnInstancesStub        func(newSSHClient func() sharedaction.SecureShellClient, instances []sharedaction.InstanceCommandOptions, maxInFlight int) []sharedaction.InstanceCommandResult
+	executeSecureShellCommandOnInstancesMutex       sync.RWMutex
+	executeSecureShellCommandOnInstancesArgsForCall []struct {
+		newSSHClient func() sharedaction.SecureShellClient
+		instances    []sharedaction.InstanceCommandOptions
+		maxInFlight  int
+	}
+	executeSecureShellCommandOnInstancesReturns struct {
+		result1 []sharedaction.InstanceCommandResult
+	}
+	executeSecureShellCommandOnInstancesReturnsOnCall map[int]struct {
+		result1 []sharedaction.InstanceCommandResult
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -74,11 +87,68 @@ func (fake *FakeSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeSSHActor) ExecuteSecureShellCommandOnInstances(newSSHClient func() sharedaction.SecureShellClient, instances []sharedaction.InstanceCommandOptions, maxInFlight int) []sharedaction.InstanceCommandResult {
+	var instancesCopy []sharedaction.InstanceCommandOptions
+	if instances != nil {
+		instancesCopy = make([]sharedaction.InstanceCommandOptions, len(instances))
+		copy(instancesCopy, instances)
+	}
+	fake.executeSecureShellCommandOnInstancesMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellCommandOnInstancesReturnsOnCall[len(fake.executeSecureShellCommandOnInstancesArgsForCall)]
+	fake.executeSecureShellCommandOnInstancesArgsForCall = append(fake.executeSecureShellCommandOnInstancesArgsForCall, struct {
+		newSSHClient func() sharedaction.SecureShellClient
+		instances    []sharedaction.InstanceCommandOptions
+		maxInFlight  int
+	}{newSSHClient, instancesCopy, maxInFlight})
+	fake.recordInvocation("ExecuteSecureShellCommandOnInstances", []interface{}{newSSHClient, instancesCopy, maxInFlight})
+	fake.executeSecureShellCommandOnInstancesMutex.Unlock()
+	if fake.ExecuteSecureShellCommandOnInstancesStub != nil {
+		return fake.ExecuteSecureShellCommandOnInstancesStub(newSSHClient, instances, maxInFlight)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.executeSecureShellCommandOnInstancesReturns.result1
+}
+
+func (fake *FakeSSHActor) ExecuteSecureShellCommandOnInstancesCallCount() int {
+	fake.executeSecureShellCommandOnInstancesMutex.RLock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.RUnlock()
+	return len(fake.executeSecureShellCommandOnInstancesArgsForCall)
+}
+
+func (fake *FakeSSHActor) ExecuteSecureShellCommandOnInstancesArgsForCall(i int) (func() sharedaction.SecureShellClient, []sharedaction.InstanceCommandOptions, int) {
+	fake.executeSecureShellCommandOnInstancesMutex.RLock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.RUnlock()
+	return fake.executeSecureShellCommandOnInstancesArgsForCall[i].newSSHClient, fake.executeSecureShellCommandOnInstancesArgsForCall[i].instances, fake.executeSecureShellCommandOnInstancesArgsForCall[i].maxInFlight
+}
+
+func (fake *FakeSSHActor) ExecuteSecureShellCommandOnInstancesReturns(result1 []sharedaction.InstanceCommandResult) {
+	fake.ExecuteSecureShellCommandOnInstancesStub = nil
+	fake.executeSecureShellCommandOnInstancesReturns = struct {
+		result1 []sharedaction.InstanceCommandResult
+	}{result1}
+}
+
+func (fake *FakeSSHActor) ExecuteSecureShellCommandOnInstancesReturnsOnCall(i int, result1 []sharedaction.InstanceCommandResult) {
+	fake.ExecuteSecureShellCommandOnInstancesStub = nil
+	if fake.executeSecureShellCommandOnInstancesReturnsOnCall == nil {
+		fake.executeSecureShellCommandOnInstancesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.InstanceCommandResult
+		})
+	}
+	fake.executeSecureShellCommandOnInstancesReturnsOnCall[i] = struct {
+		result1 []sharedaction.InstanceCommandResult
+	}{result1}
+}
+
 func (fake *FakeSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellCommandOnInstancesMutex.RLock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
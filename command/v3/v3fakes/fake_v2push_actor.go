@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/v3"
 )
@@ -25,6 +26,38 @@ type FakeV2PushActor struct {
 		result1 pushaction.Warnings
 		result2 error
 	}
+	PlanDefaultApplicationRouteStub        func(orgGUID string, spaceGUID string, app v2action.Application) (pushaction.ApplicationPlan, pushaction.Warnings, error)
+	planDefaultApplicationRouteMutex       sync.RWMutex
+	planDefaultApplicationRouteArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		app       v2action.Application
+	}
+	planDefaultApplicationRouteReturns struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}
+	planDefaultApplicationRouteReturnsOnCall map[int]struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}
+	GetUnmatchedResourcesStub        func(resources []sharedaction.Resource) ([]sharedaction.Resource, pushaction.Warnings, error)
+	getUnmatchedResourcesMutex       sync.RWMutex
+	getUnmatchedResourcesArgsForCall []struct {
+		resources []sharedaction.Resource
+	}
+	getUnmatchedResourcesReturns struct {
+		result1 []sharedaction.Resource
+		result2 pushaction.Warnings
+		result3 error
+	}
+	getUnmatchedResourcesReturnsOnCall map[int]struct {
+		result1 []sharedaction.Resource
+		result2 pushaction.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -82,11 +115,130 @@ func (fake *FakeV2PushActor) CreateAndMapDefaultApplicationRouteReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) PlanDefaultApplicationRoute(orgGUID string, spaceGUID string, app v2action.Application) (pushaction.ApplicationPlan, pushaction.Warnings, error) {
+	fake.planDefaultApplicationRouteMutex.Lock()
+	ret, specificReturn := fake.planDefaultApplicationRouteReturnsOnCall[len(fake.planDefaultApplicationRouteArgsForCall)]
+	fake.planDefaultApplicationRouteArgsForCall = append(fake.planDefaultApplicationRouteArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		app       v2action.Application
+	}{orgGUID, spaceGUID, app})
+	fake.recordInvocation("PlanDefaultApplicationRoute", []interface{}{orgGUID, spaceGUID, app})
+	fake.planDefaultApplicationRouteMutex.Unlock()
+	if fake.PlanDefaultApplicationRouteStub != nil {
+		return fake.PlanDefaultApplicationRouteStub(orgGUID, spaceGUID, app)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.planDefaultApplicationRouteReturns.result1, fake.planDefaultApplicationRouteReturns.result2, fake.planDefaultApplicationRouteReturns.result3
+}
+
+func (fake *FakeV2PushActor) PlanDefaultApplicationRouteCallCount() int {
+	fake.planDefaultApplicationRouteMutex.RLock()
+	defer fake.planDefaultApplicationRouteMutex.RUnlock()
+	return len(fake.planDefaultApplicationRouteArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PlanDefaultApplicationRouteArgsForCall(i int) (string, string, v2action.Application) {
+	fake.planDefaultApplicationRouteMutex.RLock()
+	defer fake.planDefaultApplicationRouteMutex.RUnlock()
+	return fake.planDefaultApplicationRouteArgsForCall[i].orgGUID, fake.planDefaultApplicationRouteArgsForCall[i].spaceGUID, fake.planDefaultApplicationRouteArgsForCall[i].app
+}
+
+func (fake *FakeV2PushActor) PlanDefaultApplicationRouteReturns(result1 pushaction.ApplicationPlan, result2 pushaction.Warnings, result3 error) {
+	fake.PlanDefaultApplicationRouteStub = nil
+	fake.planDefaultApplicationRouteReturns = struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) PlanDefaultApplicationRouteReturnsOnCall(i int, result1 pushaction.ApplicationPlan, result2 pushaction.Warnings, result3 error) {
+	fake.PlanDefaultApplicationRouteStub = nil
+	if fake.planDefaultApplicationRouteReturnsOnCall == nil {
+		fake.planDefaultApplicationRouteReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplicationPlan
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.planDefaultApplicationRouteReturnsOnCall[i] = struct {
+		result1 pushaction.ApplicationPlan
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) GetUnmatchedResources(resources []sharedaction.Resource) ([]sharedaction.Resource, pushaction.Warnings, error) {
+	var resourcesCopy []sharedaction.Resource
+	if resources != nil {
+		resourcesCopy = make([]sharedaction.Resource, len(resources))
+		copy(resourcesCopy, resources)
+	}
+	fake.getUnmatchedResourcesMutex.Lock()
+	ret, specificReturn := fake.getUnmatchedResourcesReturnsOnCall[len(fake.getUnmatchedResourcesArgsForCall)]
+	fake.getUnmatchedResourcesArgsForCall = append(fake.getUnmatchedResourcesArgsForCall, struct {
+		resources []sharedaction.Resource
+	}{resourcesCopy})
+	fake.recordInvocation("GetUnmatchedResources", []interface{}{resourcesCopy})
+	fake.getUnmatchedResourcesMutex.Unlock()
+	if fake.GetUnmatchedResourcesStub != nil {
+		return fake.GetUnmatchedResourcesStub(resources)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUnmatchedResourcesReturns.result1, fake.getUnmatchedResourcesReturns.result2, fake.getUnmatchedResourcesReturns.result3
+}
+
+func (fake *FakeV2PushActor) GetUnmatchedResourcesCallCount() int {
+	fake.getUnmatchedResourcesMutex.RLock()
+	defer fake.getUnmatchedResourcesMutex.RUnlock()
+	return len(fake.getUnmatchedResourcesArgsForCall)
+}
+
+func (fake *FakeV2PushActor) GetUnmatchedResourcesArgsForCall(i int) []sharedaction.Resource {
+	fake.getUnmatchedResourcesMutex.RLock()
+	defer fake.getUnmatchedResourcesMutex.RUnlock()
+	return fake.getUnmatchedResourcesArgsForCall[i].resources
+}
+
+func (fake *FakeV2PushActor) GetUnmatchedResourcesReturns(result1 []sharedaction.Resource, result2 pushaction.Warnings, result3 error) {
+	fake.GetUnmatchedResourcesStub = nil
+	fake.getUnmatchedResourcesReturns = struct {
+		result1 []sharedaction.Resource
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2PushActor) GetUnmatchedResourcesReturnsOnCall(i int, result1 []sharedaction.Resource, result2 pushaction.Warnings, result3 error) {
+	fake.GetUnmatchedResourcesStub = nil
+	if fake.getUnmatchedResourcesReturnsOnCall == nil {
+		fake.getUnmatchedResourcesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.Resource
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.getUnmatchedResourcesReturnsOnCall[i] = struct {
+		result1 []sharedaction.Resource
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createAndMapDefaultApplicationRouteMutex.RLock()
 	defer fake.createAndMapDefaultApplicationRouteMutex.RUnlock()
+	fake.planDefaultApplicationRouteMutex.RLock()
+	defer fake.planDefaultApplicationRouteMutex.RUnlock()
+	fake.getUnmatchedResourcesMutex.RLock()
+	defer fake.getUnmatchedResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/command/v3"
 )
 
@@ -18,10 +19,11 @@ type FakeV3AppsActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	GetApplicationsWithProcessesBySpaceStub        func(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
+	GetApplicationsWithProcessesBySpaceStub        func(spaceGUID string, queries ...ccv3.Query) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
 	getApplicationsWithProcessesBySpaceMutex       sync.RWMutex
 	getApplicationsWithProcessesBySpaceArgsForCall []struct {
 		spaceGUID string
+		queries   []ccv3.Query
 	}
 	getApplicationsWithProcessesBySpaceReturns struct {
 		result1 []v3action.ApplicationWithProcessSummary
@@ -77,16 +79,17 @@ func (fake *FakeV3AppsActor) CloudControllerAPIVersionReturnsOnCall(i int, resul
 	}{result1}
 }
 
-func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpace(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error) {
+func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpace(spaceGUID string, queries ...ccv3.Query) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error) {
 	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationsWithProcessesBySpaceReturnsOnCall[len(fake.getApplicationsWithProcessesBySpaceArgsForCall)]
 	fake.getApplicationsWithProcessesBySpaceArgsForCall = append(fake.getApplicationsWithProcessesBySpaceArgsForCall, struct {
 		spaceGUID string
-	}{spaceGUID})
-	fake.recordInvocation("GetApplicationsWithProcessesBySpace", []interface{}{spaceGUID})
+		queries   []ccv3.Query
+	}{spaceGUID, queries})
+	fake.recordInvocation("GetApplicationsWithProcessesBySpace", []interface{}{spaceGUID, queries})
 	fake.getApplicationsWithProcessesBySpaceMutex.Unlock()
 	if fake.GetApplicationsWithProcessesBySpaceStub != nil {
-		return fake.GetApplicationsWithProcessesBySpaceStub(spaceGUID)
+		return fake.GetApplicationsWithProcessesBySpaceStub(spaceGUID, queries...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -100,10 +103,10 @@ func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceCallCount() int
 	return len(fake.getApplicationsWithProcessesBySpaceArgsForCall)
 }
 
-func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceArgsForCall(i int) string {
+func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceArgsForCall(i int) (string, []ccv3.Query) {
 	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
 	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
-	return fake.getApplicationsWithProcessesBySpaceArgsForCall[i].spaceGUID
+	return fake.getApplicationsWithProcessesBySpaceArgsForCall[i].spaceGUID, fake.getApplicationsWithProcessesBySpaceArgsForCall[i].queries
 }
 
 func (fake *FakeV3AppsActor) GetApplicationsWithProcessesBySpaceReturns(result1 []v3action.ApplicationWithProcessSummary, result2 v3action.Warnings, result3 error) {
@@ -4,6 +4,7 @@ package v3fakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/v3"
 )
@@ -52,6 +53,19 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	CancelDeploymentStub        func(deploymentGUID string) (v3action.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		deploymentGUID string
+	}
+	cancelDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CreateApplicationInSpaceStub        func(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	createApplicationInSpaceMutex       sync.RWMutex
 	createApplicationInSpaceArgsForCall []struct {
@@ -68,6 +82,36 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	CreateDeploymentStub        func(appGUID string, dropletGUID string) (string, v3action.Warnings, error)
+	createDeploymentMutex       sync.RWMutex
+	createDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createDeploymentReturns struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
+	createDeploymentReturnsOnCall map[int]struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
+	DeleteApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v3action.Warnings, error)
+	deleteApplicationByNameAndSpaceMutex       sync.RWMutex
+	deleteApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	deleteApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deleteApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -119,6 +163,18 @@ type FakeV3PushActor struct {
 		result3 v3action.Warnings
 		result4 error
 	}
+	PollDeploymentStub        func(deploymentGUID string, warnings chan<- v3action.Warnings) error
+	pollDeploymentMutex       sync.RWMutex
+	pollDeploymentArgsForCall []struct {
+		deploymentGUID string
+		warnings       chan<- v3action.Warnings
+	}
+	pollDeploymentReturns struct {
+		result1 error
+	}
+	pollDeploymentReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PollStartStub        func(appGUID string, warnings chan<- v3action.Warnings) error
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
@@ -205,6 +261,62 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	UpdateProcessByTypeAndApplicationStub        func(processType string, appGUID string, updatedProcess v3action.Process) (v3action.Warnings, error)
+	updateProcessByTypeAndApplicationMutex       sync.RWMutex
+	updateProcessByTypeAndApplicationArgsForCall []struct {
+		processType    string
+		appGUID        string
+		updatedProcess v3action.Process
+	}
+	updateProcessByTypeAndApplicationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	updateProcessByTypeAndApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	CopyApplicationEnvironmentVariablesStub        func(sourceAppGUID string, destinationAppGUID string) (v3action.Warnings, error)
+	copyApplicationEnvironmentVariablesMutex       sync.RWMutex
+	copyApplicationEnvironmentVariablesArgsForCall []struct {
+		sourceAppGUID      string
+		destinationAppGUID string
+	}
+	copyApplicationEnvironmentVariablesReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	copyApplicationEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	CopyApplicationProcessesStub        func(sourceAppGUID string, destinationAppGUID string) (v3action.Warnings, error)
+	copyApplicationProcessesMutex       sync.RWMutex
+	copyApplicationProcessesArgsForCall []struct {
+		sourceAppGUID      string
+		destinationAppGUID string
+	}
+	copyApplicationProcessesReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	copyApplicationProcessesReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	GetBitsPackageResourcesStub        func(bitsPath string) ([]sharedaction.Resource, error)
+	getBitsPackageResourcesMutex       sync.RWMutex
+	getBitsPackageResourcesArgsForCall []struct {
+		bitsPath string
+	}
+	getBitsPackageResourcesReturns struct {
+		result1 []sharedaction.Resource
+		result2 error
+	}
+	getBitsPackageResourcesReturnsOnCall map[int]struct {
+		result1 []sharedaction.Resource
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -361,6 +473,57 @@ func (fake *FakeV3PushActor) CreateDockerPackageByApplicationNameAndSpaceReturns
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) CancelDeployment(deploymentGUID string) (v3action.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		deploymentGUID string
+	}{deploymentGUID})
+	fake.recordInvocation("CancelDeployment", []interface{}{deploymentGUID})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(deploymentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cancelDeploymentReturns.result1, fake.cancelDeploymentReturns.result2
+}
+
+func (fake *FakeV3PushActor) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeV3PushActor) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return fake.cancelDeploymentArgsForCall[i].deploymentGUID
+}
+
+func (fake *FakeV3PushActor) CancelDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) CancelDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.createApplicationInSpaceMutex.Lock()
 	ret, specificReturn := fake.createApplicationInSpaceReturnsOnCall[len(fake.createApplicationInSpaceArgsForCall)]
@@ -416,6 +579,113 @@ func (fake *FakeV3PushActor) CreateApplicationInSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) CreateDeployment(appGUID string, dropletGUID string) (string, v3action.Warnings, error) {
+	fake.createDeploymentMutex.Lock()
+	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
+	fake.createDeploymentArgsForCall = append(fake.createDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createDeploymentMutex.Unlock()
+	if fake.CreateDeploymentStub != nil {
+		return fake.CreateDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createDeploymentReturns.result1, fake.createDeploymentReturns.result2, fake.createDeploymentReturns.result3
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentCallCount() int {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return len(fake.createDeploymentArgsForCall)
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentArgsForCall(i int) (string, string) {
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	return fake.createDeploymentArgsForCall[i].appGUID, fake.createDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentReturns(result1 string, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	fake.createDeploymentReturns = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3PushActor) CreateDeploymentReturnsOnCall(i int, result1 string, result2 v3action.Warnings, result3 error) {
+	fake.CreateDeploymentStub = nil
+	if fake.createDeploymentReturnsOnCall == nil {
+		fake.createDeploymentReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createDeploymentReturnsOnCall[i] = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3PushActor) DeleteApplicationByNameAndSpace(name string, spaceGUID string) (v3action.Warnings, error) {
+	fake.deleteApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationByNameAndSpaceReturnsOnCall[len(fake.deleteApplicationByNameAndSpaceArgsForCall)]
+	fake.deleteApplicationByNameAndSpaceArgsForCall = append(fake.deleteApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("DeleteApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.deleteApplicationByNameAndSpaceMutex.Unlock()
+	if fake.DeleteApplicationByNameAndSpaceStub != nil {
+		return fake.DeleteApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationByNameAndSpaceReturns.result1, fake.deleteApplicationByNameAndSpaceReturns.result2
+}
+
+func (fake *FakeV3PushActor) DeleteApplicationByNameAndSpaceCallCount() int {
+	fake.deleteApplicationByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.deleteApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3PushActor) DeleteApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.deleteApplicationByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.deleteApplicationByNameAndSpaceArgsForCall[i].name, fake.deleteApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3PushActor) DeleteApplicationByNameAndSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeleteApplicationByNameAndSpaceStub = nil
+	fake.deleteApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeleteApplicationByNameAndSpaceStub = nil
+	if fake.deleteApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.deleteApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -585,6 +855,55 @@ func (fake *FakeV3PushActor) GetStreamingLogsForApplicationByNameAndSpaceReturns
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeV3PushActor) PollDeployment(deploymentGUID string, warnings chan<- v3action.Warnings) error {
+	fake.pollDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
+	fake.pollDeploymentArgsForCall = append(fake.pollDeploymentArgsForCall, struct {
+		deploymentGUID string
+		warnings       chan<- v3action.Warnings
+	}{deploymentGUID, warnings})
+	fake.recordInvocation("PollDeployment", []interface{}{deploymentGUID, warnings})
+	fake.pollDeploymentMutex.Unlock()
+	if fake.PollDeploymentStub != nil {
+		return fake.PollDeploymentStub(deploymentGUID, warnings)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pollDeploymentReturns.result1
+}
+
+func (fake *FakeV3PushActor) PollDeploymentCallCount() int {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return len(fake.pollDeploymentArgsForCall)
+}
+
+func (fake *FakeV3PushActor) PollDeploymentArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
+	return fake.pollDeploymentArgsForCall[i].deploymentGUID, fake.pollDeploymentArgsForCall[i].warnings
+}
+
+func (fake *FakeV3PushActor) PollDeploymentReturns(result1 error) {
+	fake.PollDeploymentStub = nil
+	fake.pollDeploymentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3PushActor) PollDeploymentReturnsOnCall(i int, result1 error) {
+	fake.PollDeploymentStub = nil
+	if fake.pollDeploymentReturnsOnCall == nil {
+		fake.pollDeploymentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeV3PushActor) PollStart(appGUID string, warnings chan<- v3action.Warnings) error {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
@@ -901,6 +1220,214 @@ func (fake *FakeV3PushActor) UpdateApplicationReturnsOnCall(i int, result1 v3act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) UpdateProcessByTypeAndApplication(processType string, appGUID string, updatedProcess v3action.Process) (v3action.Warnings, error) {
+	fake.updateProcessByTypeAndApplicationMutex.Lock()
+	ret, specificReturn := fake.updateProcessByTypeAndApplicationReturnsOnCall[len(fake.updateProcessByTypeAndApplicationArgsForCall)]
+	fake.updateProcessByTypeAndApplicationArgsForCall = append(fake.updateProcessByTypeAndApplicationArgsForCall, struct {
+		processType    string
+		appGUID        string
+		updatedProcess v3action.Process
+	}{processType, appGUID, updatedProcess})
+	fake.recordInvocation("UpdateProcessByTypeAndApplication", []interface{}{processType, appGUID, updatedProcess})
+	fake.updateProcessByTypeAndApplicationMutex.Unlock()
+	if fake.UpdateProcessByTypeAndApplicationStub != nil {
+		return fake.UpdateProcessByTypeAndApplicationStub(processType, appGUID, updatedProcess)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateProcessByTypeAndApplicationReturns.result1, fake.updateProcessByTypeAndApplicationReturns.result2
+}
+
+func (fake *FakeV3PushActor) UpdateProcessByTypeAndApplicationCallCount() int {
+	fake.updateProcessByTypeAndApplicationMutex.RLock()
+	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
+	return len(fake.updateProcessByTypeAndApplicationArgsForCall)
+}
+
+func (fake *FakeV3PushActor) UpdateProcessByTypeAndApplicationArgsForCall(i int) (string, string, v3action.Process) {
+	fake.updateProcessByTypeAndApplicationMutex.RLock()
+	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
+	return fake.updateProcessByTypeAndApplicationArgsForCall[i].processType, fake.updateProcessByTypeAndApplicationArgsForCall[i].appGUID, fake.updateProcessByTypeAndApplicationArgsForCall[i].updatedProcess
+}
+
+func (fake *FakeV3PushActor) UpdateProcessByTypeAndApplicationReturns(result1 v3action.Warnings, result2 error) {
+	fake.UpdateProcessByTypeAndApplicationStub = nil
+	fake.updateProcessByTypeAndApplicationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) UpdateProcessByTypeAndApplicationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.UpdateProcessByTypeAndApplicationStub = nil
+	if fake.updateProcessByTypeAndApplicationReturnsOnCall == nil {
+		fake.updateProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.updateProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) CopyApplicationEnvironmentVariables(sourceAppGUID string, destinationAppGUID string) (v3action.Warnings, error) {
+	fake.copyApplicationEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.copyApplicationEnvironmentVariablesReturnsOnCall[len(fake.copyApplicationEnvironmentVariablesArgsForCall)]
+	fake.copyApplicationEnvironmentVariablesArgsForCall = append(fake.copyApplicationEnvironmentVariablesArgsForCall, struct {
+		sourceAppGUID      string
+		destinationAppGUID string
+	}{sourceAppGUID, destinationAppGUID})
+	fake.recordInvocation("CopyApplicationEnvironmentVariables", []interface{}{sourceAppGUID, destinationAppGUID})
+	fake.copyApplicationEnvironmentVariablesMutex.Unlock()
+	if fake.CopyApplicationEnvironmentVariablesStub != nil {
+		return fake.CopyApplicationEnvironmentVariablesStub(sourceAppGUID, destinationAppGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.copyApplicationEnvironmentVariablesReturns.result1, fake.copyApplicationEnvironmentVariablesReturns.result2
+}
+
+func (fake *FakeV3PushActor) CopyApplicationEnvironmentVariablesCallCount() int {
+	fake.copyApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.copyApplicationEnvironmentVariablesMutex.RUnlock()
+	return len(fake.copyApplicationEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeV3PushActor) CopyApplicationEnvironmentVariablesArgsForCall(i int) (string, string) {
+	fake.copyApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.copyApplicationEnvironmentVariablesMutex.RUnlock()
+	return fake.copyApplicationEnvironmentVariablesArgsForCall[i].sourceAppGUID, fake.copyApplicationEnvironmentVariablesArgsForCall[i].destinationAppGUID
+}
+
+func (fake *FakeV3PushActor) CopyApplicationEnvironmentVariablesReturns(result1 v3action.Warnings, result2 error) {
+	fake.CopyApplicationEnvironmentVariablesStub = nil
+	fake.copyApplicationEnvironmentVariablesReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) CopyApplicationEnvironmentVariablesReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.CopyApplicationEnvironmentVariablesStub = nil
+	if fake.copyApplicationEnvironmentVariablesReturnsOnCall == nil {
+		fake.copyApplicationEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.copyApplicationEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) CopyApplicationProcesses(sourceAppGUID string, destinationAppGUID string) (v3action.Warnings, error) {
+	fake.copyApplicationProcessesMutex.Lock()
+	ret, specificReturn := fake.copyApplicationProcessesReturnsOnCall[len(fake.copyApplicationProcessesArgsForCall)]
+	fake.copyApplicationProcessesArgsForCall = append(fake.copyApplicationProcessesArgsForCall, struct {
+		sourceAppGUID      string
+		destinationAppGUID string
+	}{sourceAppGUID, destinationAppGUID})
+	fake.recordInvocation("CopyApplicationProcesses", []interface{}{sourceAppGUID, destinationAppGUID})
+	fake.copyApplicationProcessesMutex.Unlock()
+	if fake.CopyApplicationProcessesStub != nil {
+		return fake.CopyApplicationProcessesStub(sourceAppGUID, destinationAppGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.copyApplicationProcessesReturns.result1, fake.copyApplicationProcessesReturns.result2
+}
+
+func (fake *FakeV3PushActor) CopyApplicationProcessesCallCount() int {
+	fake.copyApplicationProcessesMutex.RLock()
+	defer fake.copyApplicationProcessesMutex.RUnlock()
+	return len(fake.copyApplicationProcessesArgsForCall)
+}
+
+func (fake *FakeV3PushActor) CopyApplicationProcessesArgsForCall(i int) (string, string) {
+	fake.copyApplicationProcessesMutex.RLock()
+	defer fake.copyApplicationProcessesMutex.RUnlock()
+	return fake.copyApplicationProcessesArgsForCall[i].sourceAppGUID, fake.copyApplicationProcessesArgsForCall[i].destinationAppGUID
+}
+
+func (fake *FakeV3PushActor) CopyApplicationProcessesReturns(result1 v3action.Warnings, result2 error) {
+	fake.CopyApplicationProcessesStub = nil
+	fake.copyApplicationProcessesReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) CopyApplicationProcessesReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.CopyApplicationProcessesStub = nil
+	if fake.copyApplicationProcessesReturnsOnCall == nil {
+		fake.copyApplicationProcessesReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.copyApplicationProcessesReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) GetBitsPackageResources(bitsPath string) ([]sharedaction.Resource, error) {
+	fake.getBitsPackageResourcesMutex.Lock()
+	ret, specificReturn := fake.getBitsPackageResourcesReturnsOnCall[len(fake.getBitsPackageResourcesArgsForCall)]
+	fake.getBitsPackageResourcesArgsForCall = append(fake.getBitsPackageResourcesArgsForCall, struct {
+		bitsPath string
+	}{bitsPath})
+	fake.recordInvocation("GetBitsPackageResources", []interface{}{bitsPath})
+	fake.getBitsPackageResourcesMutex.Unlock()
+	if fake.GetBitsPackageResourcesStub != nil {
+		return fake.GetBitsPackageResourcesStub(bitsPath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getBitsPackageResourcesReturns.result1, fake.getBitsPackageResourcesReturns.result2
+}
+
+func (fake *FakeV3PushActor) GetBitsPackageResourcesCallCount() int {
+	fake.getBitsPackageResourcesMutex.RLock()
+	defer fake.getBitsPackageResourcesMutex.RUnlock()
+	return len(fake.getBitsPackageResourcesArgsForCall)
+}
+
+func (fake *FakeV3PushActor) GetBitsPackageResourcesArgsForCall(i int) string {
+	fake.getBitsPackageResourcesMutex.RLock()
+	defer fake.getBitsPackageResourcesMutex.RUnlock()
+	return fake.getBitsPackageResourcesArgsForCall[i].bitsPath
+}
+
+func (fake *FakeV3PushActor) GetBitsPackageResourcesReturns(result1 []sharedaction.Resource, result2 error) {
+	fake.GetBitsPackageResourcesStub = nil
+	fake.getBitsPackageResourcesReturns = struct {
+		result1 []sharedaction.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) GetBitsPackageResourcesReturnsOnCall(i int, result1 []sharedaction.Resource, result2 error) {
+	fake.GetBitsPackageResourcesStub = nil
+	if fake.getBitsPackageResourcesReturnsOnCall == nil {
+		fake.getBitsPackageResourcesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.Resource
+			result2 error
+		})
+	}
+	fake.getBitsPackageResourcesReturnsOnCall[i] = struct {
+		result1 []sharedaction.Resource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -910,14 +1437,22 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RUnlock()
 	fake.createDockerPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createDockerPackageByApplicationNameAndSpaceMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createDeploymentMutex.RLock()
+	defer fake.createDeploymentMutex.RUnlock()
+	fake.deleteApplicationByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.pollDeploymentMutex.RLock()
+	defer fake.pollDeploymentMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
 	fake.setApplicationDropletMutex.RLock()
@@ -930,6 +1465,14 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateProcessByTypeAndApplicationMutex.RLock()
+	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
+	fake.copyApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.copyApplicationEnvironmentVariablesMutex.RUnlock()
+	fake.copyApplicationProcessesMutex.RLock()
+	defer fake.copyApplicationProcessesMutex.RUnlock()
+	fake.getBitsPackageResourcesMutex.RLock()
+	defer fake.getBitsPackageResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -21,12 +21,17 @@ var _ = Describe("ssh command", func() {
 				Eventually(session).Should(Say(`NAME:`))
 				Eventually(session).Should(Say(`ssh - SSH to an application container instance`))
 				Eventually(session).Should(Say(`USAGE:`))
-				Eventually(session).Should(Say(`cf ssh APP_NAME \[-i INDEX\] \[-c COMMAND\]\.\.\. \[-L \[BIND_ADDRESS:\]PORT:HOST:HOST_PORT\] \[--skip-host-validation\] \[--skip-remote-execution\] \[--disable-pseudo-tty \| --force-pseudo-tty \| --request-pseudo-tty\]`))
+				Eventually(session).Should(Say(`cf ssh APP_NAME \[-i INDEX\] \[-c COMMAND\]\.\.\. \[-L \[BIND_ADDRESS:\]PORT:HOST:HOST_PORT\] \[-R \[BIND_ADDRESS:\]PORT:HOST:HOST_PORT\] \[-D \[BIND_ADDRESS:\]PORT\] \[--skip-host-validation\] \[--skip-remote-execution\] \[--disable-pseudo-tty \| --force-pseudo-tty \| --request-pseudo-tty\]`))
+				Eventually(session).Should(Say(`cf ssh APP_NAME --all-instances -c COMMAND \[--max-in-flight NUMBER\] \[--skip-host-validation\]`))
+				Eventually(session).Should(Say(`--all-instances\s+Run the command on every running instance in parallel, prefixing output with the instance index`))
 				Eventually(session).Should(Say(`--app-instance-index, -i\s+Application instance index \(Default: 0\)`))
 				Eventually(session).Should(Say(`--command, -c\s+Command to run\. This flag can be defined more than once\.`))
 				Eventually(session).Should(Say(`--disable-pseudo-tty, -T\s+Disable pseudo-tty allocation`))
 				Eventually(session).Should(Say(`--force-pseudo-tty\s+Force pseudo-tty allocation`))
 				Eventually(session).Should(Say(`-L\s+Local port forward specification\. This flag can be defined more than once\.`))
+				Eventually(session).Should(Say(`-R\s+Remote port forward specification\. This flag can be defined more than once\.`))
+				Eventually(session).Should(Say(`-D\s+Dynamic port forward specification, as a local SOCKS5 proxy\. This flag can be defined more than once\.`))
+				Eventually(session).Should(Say(`--max-in-flight\s+Maximum number of instances to run the command on at once with --all-instances \(Default: 10\)`))
 				Eventually(session).Should(Say(`--request-pseudo-tty, -t\s+Request pseudo-tty allocation`))
 				Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation`))
 				Eventually(session).Should(Say(`--skip-remote-execution, -N\s+Do not execute a remote command`))
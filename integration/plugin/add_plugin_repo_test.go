@@ -24,9 +24,11 @@ var _ = Describe("add-plugin-repo command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("add-plugin-repo - Add a new plugin repository"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf add-plugin-repo REPO_NAME URL"))
+				Eventually(session).Should(Say("cf add-plugin-repo REPO_NAME URL \\[--public-key KEY\\]"))
 				Eventually(session).Should(Say("EXAMPLES"))
 				Eventually(session).Should(Say("cf add-plugin-repo ExampleRepo https://example\\.com/repo"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say("--public-key\\s+Minisign or Ed25519 public key used to verify the signatures of the repository's plugins"))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("install-plugin, list-plugin-repos"))
 				Eventually(session).Should(Exit(0))
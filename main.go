@@ -47,8 +47,25 @@ func main() {
 }
 
 func parse(args []string) int {
+	if len(args) > 0 && args[0] == "__complete" {
+		if err := executionWrapper(&common.CompleteCommand{}, args[1:]); err != nil {
+			return 1
+		}
+		return 0
+	}
+
 	parser := flags.NewParser(&common.Commands, flags.HelpFlag)
-	parser.CommandHandler = executionWrapper
+	parser.CommandHandler = func(cmd flags.Commander, args []string) error {
+		if common.Commands.Output.Format != "" {
+			if _, ok := cmd.(command.StructuredOutputCommander); !ok {
+				return &flags.Error{
+					Type:    flags.ErrInvalidChoice,
+					Message: fmt.Sprintf("The '--output' flag is not supported by the '%s' command", parser.Active.Name),
+				}
+			}
+		}
+		return executionWrapper(cmd, args)
+	}
 	extraArgs, err := parser.ParseArgs(args)
 	if err == nil {
 		return 0
@@ -156,7 +173,9 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		NoCache:      common.Commands.NoCache,
+		OutputFormat: common.Commands.Output.Format,
+		Verbose:      common.Commands.VerboseOrVersion,
 	})
 	if configErr != nil {
 		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
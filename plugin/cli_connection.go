@@ -50,6 +50,21 @@ func (c *cliConnection) sendPluginMetadataToCliServer(metadata PluginMetadata) {
 	os.Exit(0)
 }
 
+func (c *cliConnection) sendPluginCompletionsToCliServer(completions []string) {
+	var success bool
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.SetPluginCompletions", completions, &success)
+	})
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
 func (c *cliConnection) isMinCliVersion(version string) bool {
 	var result bool
 
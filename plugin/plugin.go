@@ -70,4 +70,67 @@ type Command struct {
 	Alias        string
 	HelpText     string
 	UsageDetails Usage //Detail usage to be displayed in `cf help <cmd>`
+
+	// Flags and Arguments are optional and are used to complete the command
+	// in the shell. Flags that are not listed in UsageDetails.Options are
+	// also displayed in `cf help <cmd>`.
+	Flags     []Flag
+	Arguments []Argument
+}
+
+type FlagType string
+
+const (
+	FlagTypeBool   FlagType = "bool"
+	FlagTypeString FlagType = "string"
+	FlagTypeInt    FlagType = "int"
+)
+
+// CompletionType is the source of the shell completions for a flag value or
+// a positional argument.
+type CompletionType string
+
+const (
+	CompletionNone    CompletionType = ""
+	CompletionFile    CompletionType = "file"
+	CompletionApp     CompletionType = "app"
+	CompletionService CompletionType = "service"
+	CompletionOrg     CompletionType = "org"
+	CompletionSpace   CompletionType = "space"
+
+	// CompletionPlugin completions are returned by the plugin's Completer.
+	CompletionPlugin CompletionType = "plugin"
+)
+
+// Flag is a flag of a plugin command. Name is the long name without the
+// leading dashes. Flags of type FlagTypeBool, the default, take no value.
+type Flag struct {
+	Name        string
+	ShortName   string
+	Description string
+	Type        FlagType
+	Completion  CompletionType
+
+	// Choices are completed when Completion is CompletionNone.
+	Choices []string
+}
+
+// Argument is a positional argument of a plugin command.
+type Argument struct {
+	Name        string
+	Description string
+	Completion  CompletionType
+
+	// Choices are completed when Completion is CompletionNone.
+	Choices []string
+}
+
+/**
+	Completer can be implemented by a plugin to complete the flag values and
+	arguments that are declared with CompletionPlugin. args holds the command
+	name followed by the words typed so far; the last word is the one being
+	completed.
+**/
+type Completer interface {
+	Complete(cliConnection CliConnection, args []string) []string
 }
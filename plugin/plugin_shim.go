@@ -11,6 +11,8 @@ import (
 	* os.Args[1] port CF_CLI rpc server is running on
 	* os.Args[2] **OPTIONAL**
 		* SendMetadata - used to fetch the plugin metadata
+		* SendCompletions - used to fetch completions from a Completer, followed
+		  by the command name and the words to complete
 **/
 func Start(cmd Plugin) {
 	if len(os.Args) < 2 {
@@ -22,6 +24,12 @@ func Start(cmd Plugin) {
 	cliConnection.pingCLI()
 	if isMetadataRequest(os.Args) {
 		cliConnection.sendPluginMetadataToCliServer(cmd.GetMetadata())
+	} else if isCompletionRequest(os.Args) {
+		var completions []string
+		if completer, ok := cmd.(Completer); ok {
+			completions = completer.Complete(cliConnection, os.Args[3:])
+		}
+		cliConnection.sendPluginCompletionsToCliServer(completions)
 	} else {
 		if version := MinCliVersionStr(cmd.GetMetadata().MinCliVersion); version != "" {
 			ok := cliConnection.isMinCliVersion(version)
@@ -39,6 +47,10 @@ func isMetadataRequest(args []string) bool {
 	return len(args) == 3 && args[2] == "SendMetadata"
 }
 
+func isCompletionRequest(args []string) bool {
+	return len(args) >= 4 && args[2] == "SendCompletions"
+}
+
 func MinCliVersionStr(version VersionType) string {
 	if version.Major == 0 && version.Minor == 0 && version.Build == 0 {
 		return ""
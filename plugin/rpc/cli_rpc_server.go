@@ -38,6 +38,7 @@ type CliRpcService struct {
 
 type CliRpcCmd struct {
 	PluginMetadata       *plugin.PluginMetadata
+	PluginCompletions    []string
 	MetadataMutex        *sync.RWMutex
 	outputCapture        OutputCapture
 	terminalOutputSwitch TerminalOutputSwitch
@@ -92,6 +93,11 @@ func NewRpcService(
 		return nil, err
 	}
 
+	err = rpcService.Server.RegisterName("CliRpcCmdV2", &CliRpcCmdV2{cmd: rpcService.RpcCmd})
+	if err != nil {
+		return nil, err
+	}
+
 	return rpcService, nil
 }
 
@@ -163,6 +169,15 @@ func (cmd *CliRpcCmd) SetPluginMetadata(pluginMetadata plugin.PluginMetadata, re
 	return nil
 }
 
+func (cmd *CliRpcCmd) SetPluginCompletions(completions []string, retVal *bool) error {
+	cmd.MetadataMutex.Lock()
+	defer cmd.MetadataMutex.Unlock()
+
+	cmd.PluginCompletions = completions
+	*retVal = true
+	return nil
+}
+
 func (cmd *CliRpcCmd) DisableTerminalOutput(disable bool, retVal *bool) error {
 	cmd.terminalOutputSwitch.DisableTerminalOutput(disable)
 	*retVal = true
@@ -206,6 +206,37 @@ var _ = Describe("Server", func() {
 		})
 	})
 
+	Describe(".SetPluginCompletions", func() {
+		BeforeEach(func() {
+			rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+			Expect(err).ToNot(HaveOccurred())
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		It("sets the rpc command's completions", func() {
+			var success bool
+			err = client.Call("CliRpcCmd.SetPluginCompletions", []string{"completion-1", "completion-2"}, &success)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(success).To(BeTrue())
+			Expect(rpcService.RpcCmd.PluginCompletions).To(Equal([]string{"completion-1", "completion-2"}))
+		})
+	})
+
 	Describe(".GetOutputAndReset", func() {
 		Context("success", func() {
 			BeforeEach(func() {
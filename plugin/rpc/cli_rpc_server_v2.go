@@ -0,0 +1,94 @@
+package rpc
+
+import (
+	"bufio"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin/v2"
+)
+
+// CliRpcCmdV2 serves the v2 plugin API. It is registered next to CliRpcCmd
+// so that plugins built against either API can call back into the CLI.
+type CliRpcCmdV2 struct {
+	cmd *CliRpcCmd
+}
+
+// RunCommand runs a CLI command without displaying its output and returns
+// the captured output and, if the command failed, its error.
+func (cmdV2 *CliRpcCmdV2) RunCommand(args []string, retVal *v2.CommandResult) error {
+	*retVal = v2.CommandResult{Args: args}
+	if len(args) == 0 {
+		retVal.Error = "no command given"
+		return nil
+	}
+
+	cmdV2.cmd.terminalOutputSwitch.DisableTerminalOutput(true)
+	defer cmdV2.cmd.terminalOutputSwitch.DisableTerminalOutput(false)
+
+	var success bool
+	err := cmdV2.cmd.CallCoreCommand(args, &success)
+	switch {
+	case err != nil:
+		retVal.Error = err.Error()
+	case !success:
+		retVal.Error = "'" + args[0] + "' is not a registered command"
+	}
+	retVal.Succeeded = success
+
+	return cmdV2.cmd.GetOutputAndReset(success, &retVal.Output)
+}
+
+// CloudControllerRequest makes an authenticated request to the targeted API,
+// refreshing the access token if it has expired.
+func (cmdV2 *CliRpcCmdV2) CloudControllerRequest(request v2.CloudControllerRequest, retVal *v2.CloudControllerResponse) error {
+	var header string
+	if len(request.Body) > 0 {
+		header = "Content-Type: application/json"
+	}
+
+	rawHeaders, body, err := cmdV2.cmd.repoLocator.GetCurlRepository().Request(request.Method, request.Path, header, string(request.Body))
+	if err != nil {
+		return err
+	}
+
+	response, err := http.ReadResponse(bufio.NewReader(strings.NewReader(rawHeaders)), nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	*retVal = v2.CloudControllerResponse{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		Body:       []byte(body),
+	}
+	return nil
+}
+
+// AccessToken returns a current access token, refreshing it if needed.
+func (cmdV2 *CliRpcCmdV2) AccessToken(args string, retVal *string) error {
+	return cmdV2.cmd.AccessToken(args, retVal)
+}
+
+// Target returns the targeted API, organization and space, and the logged
+// in user.
+func (cmdV2 *CliRpcCmdV2) Target(_ string, retVal *v2.Target) error {
+	config := cmdV2.cmd.cliConfig
+	*retVal = v2.Target{
+		APIEndpoint:       config.APIEndpoint(),
+		APIVersion:        config.APIVersion(),
+		SkipSSLValidation: config.IsSSLDisabled(),
+		Organization: v2.Organization{
+			GUID: config.OrganizationFields().GUID,
+			Name: config.OrganizationFields().Name,
+		},
+		Space: v2.Space{
+			GUID: config.SpaceFields().GUID,
+			Name: config.SpaceFields().Name,
+		},
+		Username: config.Username(),
+		UserGUID: config.UserGUID(),
+	}
+	return nil
+}
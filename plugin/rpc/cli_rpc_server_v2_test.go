@@ -0,0 +1,148 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/http"
+	"net/rpc"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	testconfig "code.cloudfoundry.org/cli/cf/util/testhelpers/configuration"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server V2", func() {
+	var (
+		err        error
+		client     *rpc.Client
+		rpcService *CliRpcService
+
+		config      coreconfig.Repository
+		repoLocator api.RepositoryLocator
+		fakeCurl    *apifakes.FakeCurlRepository
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		config = testconfig.NewRepositoryWithDefaults()
+		fakeCurl = new(apifakes.FakeCurlRepository)
+		repoLocator = api.RepositoryLocator{}.SetCurlRepository(fakeCurl)
+	})
+
+	JustBeforeEach(func() {
+		outputCapture := terminal.NewTeePrinter(os.Stdout)
+		rpcService, err = NewRpcService(outputCapture, outputCapture, config, repoLocator, NewCommandRunner(), nil, os.Stdout, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Close()
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Describe(".RunCommand", func() {
+		It("returns the output of the command", func() {
+			var result v2.CommandResult
+			err = client.Call("CliRpcCmdV2.RunCommand", []string{"fake-command"}, &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal(v2.CommandResult{
+				Args:      []string{"fake-command"},
+				Output:    []string{"Requirement executed", "Command Executed"},
+				Succeeded: true,
+			}))
+		})
+
+		Context("when the command does not exist", func() {
+			It("reports the failure in the result", func() {
+				var result v2.CommandResult
+				err = client.Call("CliRpcCmdV2.RunCommand", []string{"not_a_cmd"}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result.Succeeded).To(BeFalse())
+				Expect(result.Error).To(Equal("'not_a_cmd' is not a registered command"))
+			})
+		})
+	})
+
+	Describe(".CloudControllerRequest", func() {
+		BeforeEach(func() {
+			fakeCurl.RequestReturns("HTTP/1.1 201 Created\r\nContent-Type: application/json\r\nX-Cf-Warnings: some-warning\r\n\r\n", `{"guid":"some-guid"}`, nil)
+		})
+
+		It("makes the request through the curl repository and returns the parsed response", func() {
+			var response v2.CloudControllerResponse
+			err = client.Call("CliRpcCmdV2.CloudControllerRequest", v2.CloudControllerRequest{
+				Method: http.MethodPost,
+				Path:   "/v3/apps",
+				Body:   []byte(`{"name":"some-app"}`),
+			}, &response)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeCurl.RequestCallCount()).To(Equal(1))
+			method, path, header, body := fakeCurl.RequestArgsForCall(0)
+			Expect(method).To(Equal(http.MethodPost))
+			Expect(path).To(Equal("/v3/apps"))
+			Expect(header).To(Equal("Content-Type: application/json"))
+			Expect(body).To(Equal(`{"name":"some-app"}`))
+
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Header["X-Cf-Warnings"]).To(Equal([]string{"some-warning"}))
+			Expect(string(response.Body)).To(Equal(`{"guid":"some-guid"}`))
+		})
+
+		Context("when the request errors", func() {
+			BeforeEach(func() {
+				fakeCurl.RequestReturns("", "", errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				var response v2.CloudControllerResponse
+				err = client.Call("CliRpcCmdV2.CloudControllerRequest", v2.CloudControllerRequest{Method: http.MethodGet, Path: "/v3/apps"}, &response)
+				Expect(err).To(MatchError("some-error"))
+			})
+		})
+	})
+
+	Describe(".Target", func() {
+		BeforeEach(func() {
+			config.SetAPIEndpoint("https://api.example.com")
+			config.SetAPIVersion("2.100.0")
+			config.SetOrganizationFields(models.OrganizationFields{GUID: "org-guid", Name: "some-org"})
+			config.SetSpaceFields(models.SpaceFields{GUID: "space-guid", Name: "some-space"})
+		})
+
+		It("returns the targeted resources", func() {
+			var target v2.Target
+			err = client.Call("CliRpcCmdV2.Target", "", &target)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(target.APIEndpoint).To(Equal("https://api.example.com"))
+			Expect(target.APIVersion).To(Equal("2.100.0"))
+			Expect(target.Organization).To(Equal(v2.Organization{GUID: "org-guid", Name: "some-org"}))
+			Expect(target.Space).To(Equal(v2.Space{GUID: "space-guid", Name: "some-space"}))
+			Expect(target.Username).To(Equal(config.Username()))
+			Expect(target.UserGUID).To(Equal(config.UserGUID()))
+		})
+	})
+})
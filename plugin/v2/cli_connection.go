@@ -0,0 +1,299 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/rpc"
+	"net/url"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+const networkPoliciesPath = "/networking/v1/external/policies"
+
+type cliConnection struct {
+	cliServerPort string
+}
+
+// NewCliConnection returns a CliConnection that calls the CLI RPC server
+// listening on the given port.
+func NewCliConnection(cliServerPort string) *cliConnection {
+	return &cliConnection{
+		cliServerPort: cliServerPort,
+	}
+}
+
+func (c *cliConnection) withClientDo(f func(client *rpc.Client) error) error {
+	client, err := rpc.Dial("tcp", "127.0.0.1:"+c.cliServerPort)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return f(client)
+}
+
+func (c *cliConnection) RunCommand(args ...string) (CommandResult, error) {
+	var result CommandResult
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.RunCommand", args, &result)
+	})
+	return result, err
+}
+
+func (c *cliConnection) CloudControllerRequest(method string, path string, body []byte) (CloudControllerResponse, error) {
+	var response CloudControllerResponse
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.CloudControllerRequest", CloudControllerRequest{
+			Method: method,
+			Path:   path,
+			Body:   body,
+		}, &response)
+	})
+	return response, err
+}
+
+func (c *cliConnection) AccessToken() (string, error) {
+	var token string
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.AccessToken", "", &token)
+	})
+	return token, err
+}
+
+func (c *cliConnection) Target() (Target, error) {
+	var target Target
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmdV2.Target", "", &target)
+	})
+	return target, err
+}
+
+func (c *cliConnection) GetApps() ([]App, error) {
+	spaceGUID, err := c.targetedSpaceGUID()
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []App
+	err = c.getPaginatedResources("/v3/apps?"+url.Values{"space_guids": {spaceGUID}}.Encode(), func(resources json.RawMessage) error {
+		var page []App
+		err := json.Unmarshal(resources, &page)
+		apps = append(apps, page...)
+		return err
+	})
+	return apps, err
+}
+
+func (c *cliConnection) GetApp(name string) (App, error) {
+	spaceGUID, err := c.targetedSpaceGUID()
+	if err != nil {
+		return App{}, err
+	}
+
+	var apps []App
+	err = c.getPaginatedResources("/v3/apps?"+url.Values{"names": {name}, "space_guids": {spaceGUID}}.Encode(), func(resources json.RawMessage) error {
+		var page []App
+		err := json.Unmarshal(resources, &page)
+		apps = append(apps, page...)
+		return err
+	})
+	if err != nil {
+		return App{}, err
+	}
+
+	if len(apps) == 0 {
+		return App{}, AppNotFoundError{Name: name}
+	}
+	return apps[0], nil
+}
+
+func (c *cliConnection) GetProcesses(appGUID string) ([]Process, error) {
+	var processes []Process
+	err := c.getPaginatedResources("/v3/apps/"+url.PathEscape(appGUID)+"/processes", func(resources json.RawMessage) error {
+		var page []Process
+		err := json.Unmarshal(resources, &page)
+		processes = append(processes, page...)
+		return err
+	})
+	return processes, err
+}
+
+func (c *cliConnection) GetDroplets(appGUID string) ([]Droplet, error) {
+	var droplets []Droplet
+	err := c.getPaginatedResources("/v3/apps/"+url.PathEscape(appGUID)+"/droplets", func(resources json.RawMessage) error {
+		var page []Droplet
+		err := json.Unmarshal(resources, &page)
+		droplets = append(droplets, page...)
+		return err
+	})
+	return droplets, err
+}
+
+func (c *cliConnection) GetTasks(appGUID string) ([]Task, error) {
+	var tasks []Task
+	err := c.getPaginatedResources("/v3/apps/"+url.PathEscape(appGUID)+"/tasks", func(resources json.RawMessage) error {
+		var page []Task
+		err := json.Unmarshal(resources, &page)
+		tasks = append(tasks, page...)
+		return err
+	})
+	return tasks, err
+}
+
+func (c *cliConnection) GetIsolationSegments() ([]IsolationSegment, error) {
+	var isolationSegments []IsolationSegment
+	err := c.getPaginatedResources("/v3/isolation_segments", func(resources json.RawMessage) error {
+		var page []IsolationSegment
+		err := json.Unmarshal(resources, &page)
+		isolationSegments = append(isolationSegments, page...)
+		return err
+	})
+	return isolationSegments, err
+}
+
+func (c *cliConnection) GetNetworkPolicies(appGUID string) ([]NetworkPolicy, error) {
+	response, err := c.get(networkPoliciesPath + "?" + url.Values{"id": {appGUID}}.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var policies struct {
+		Policies []NetworkPolicy `json:"policies"`
+	}
+	if err := json.Unmarshal(response.Body, &policies); err != nil {
+		return nil, err
+	}
+	return policies.Policies, nil
+}
+
+func (c *cliConnection) targetedSpaceGUID() (string, error) {
+	target, err := c.Target()
+	if err != nil {
+		return "", err
+	}
+	if target.Space.GUID == "" {
+		return "", ErrNoSpaceTargeted
+	}
+	return target.Space.GUID, nil
+}
+
+// get makes a GET request and returns a RequestError for non-2XX responses.
+func (c *cliConnection) get(path string) (CloudControllerResponse, error) {
+	response, err := c.CloudControllerRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return CloudControllerResponse{}, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return CloudControllerResponse{}, newRequestError(response)
+	}
+	return response, nil
+}
+
+// getPaginatedResources calls appendPage with the resources of every page of
+// a V3 list.
+func (c *cliConnection) getPaginatedResources(path string, appendPage func(json.RawMessage) error) error {
+	for path != "" {
+		response, err := c.get(path)
+		if err != nil {
+			return err
+		}
+
+		var page struct {
+			Pagination struct {
+				Next struct {
+					HREF string `json:"href"`
+				} `json:"next"`
+			} `json:"pagination"`
+			Resources json.RawMessage `json:"resources"`
+		}
+		if err := json.Unmarshal(response.Body, &page); err != nil {
+			return err
+		}
+		if err := appendPage(page.Resources); err != nil {
+			return err
+		}
+
+		path = ""
+		if page.Pagination.Next.HREF != "" {
+			next, err := url.Parse(page.Pagination.Next.HREF)
+			if err != nil {
+				return err
+			}
+			path = next.RequestURI()
+		}
+	}
+	return nil
+}
+
+func (c *cliConnection) sendPluginMetadataToCliServer(metadata plugin.PluginMetadata) {
+	var success bool
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.SetPluginMetadata", metadata, &success)
+	})
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if !success {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func (c *cliConnection) sendPluginCompletionsToCliServer(completions []string) {
+	var success bool
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.SetPluginCompletions", completions, &success)
+	})
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func (c *cliConnection) isMinCliVersion(version string) bool {
+	var result bool
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.IsMinCliVersion", version, &result)
+	})
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	return result
+}
+
+func (c *cliConnection) pingCLI() {
+	//call back to cf saying we have been setup
+	var connErr error
+	var conn net.Conn
+	for i := 0; i < 5; i++ {
+		conn, connErr = net.Dial("tcp", "127.0.0.1:"+c.cliServerPort)
+		if connErr != nil {
+			time.Sleep(200 * time.Millisecond)
+		} else {
+			conn.Close()
+			break
+		}
+	}
+	if connErr != nil {
+		fmt.Println(connErr)
+		os.Exit(1)
+	}
+}
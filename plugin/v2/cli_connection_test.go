@@ -0,0 +1,232 @@
+package v2_test
+
+import (
+	"net"
+	"net/http"
+	"net/rpc"
+	"strconv"
+
+	. "code.cloudfoundry.org/cli/plugin/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeCliRpcCmdV2 stands in for the CLI's CliRpcCmdV2 receiver.
+type fakeCliRpcCmdV2 struct {
+	target    Target
+	requests  []CloudControllerRequest
+	responses map[string]CloudControllerResponse
+}
+
+func (cmd *fakeCliRpcCmdV2) RunCommand(args []string, retVal *CommandResult) error {
+	*retVal = CommandResult{Args: args, Output: []string{"some-output"}, Succeeded: true}
+	return nil
+}
+
+func (cmd *fakeCliRpcCmdV2) CloudControllerRequest(request CloudControllerRequest, retVal *CloudControllerResponse) error {
+	cmd.requests = append(cmd.requests, request)
+	response, ok := cmd.responses[request.Path]
+	if !ok {
+		response = CloudControllerResponse{StatusCode: http.StatusNotFound}
+	}
+	*retVal = response
+	return nil
+}
+
+func (cmd *fakeCliRpcCmdV2) AccessToken(_ string, retVal *string) error {
+	*retVal = "bearer some-token"
+	return nil
+}
+
+func (cmd *fakeCliRpcCmdV2) Target(_ string, retVal *Target) error {
+	*retVal = cmd.target
+	return nil
+}
+
+var _ = Describe("CliConnection", func() {
+	var (
+		listener   net.Listener
+		fakeCmd    *fakeCliRpcCmdV2
+		connection CliConnection
+	)
+
+	BeforeEach(func() {
+		fakeCmd = &fakeCliRpcCmdV2{
+			target:    Target{Space: Space{GUID: "space-guid"}},
+			responses: map[string]CloudControllerResponse{},
+		}
+
+		server := rpc.NewServer()
+		Expect(server.RegisterName("CliRpcCmdV2", fakeCmd)).To(Succeed())
+
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		go server.Accept(listener)
+
+		connection = NewCliConnection(strconv.Itoa(listener.Addr().(*net.TCPAddr).Port))
+	})
+
+	AfterEach(func() {
+		listener.Close()
+	})
+
+	Describe("RunCommand", func() {
+		It("returns the command result", func() {
+			result, err := connection.RunCommand("apps")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(CommandResult{Args: []string{"apps"}, Output: []string{"some-output"}, Succeeded: true}))
+		})
+	})
+
+	Describe("CloudControllerRequest", func() {
+		BeforeEach(func() {
+			fakeCmd.responses["/v3/apps"] = CloudControllerResponse{StatusCode: http.StatusCreated, Body: []byte(`{}`)}
+		})
+
+		It("sends the request to the CLI", func() {
+			response, err := connection.CloudControllerRequest(http.MethodPost, "/v3/apps", []byte(`{"name":"some-app"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(fakeCmd.requests).To(Equal([]CloudControllerRequest{{
+				Method: http.MethodPost,
+				Path:   "/v3/apps",
+				Body:   []byte(`{"name":"some-app"}`),
+			}}))
+		})
+	})
+
+	Describe("GetApps", func() {
+		Context("when there are multiple pages", func() {
+			BeforeEach(func() {
+				fakeCmd.responses["/v3/apps?space_guids=space-guid"] = CloudControllerResponse{
+					StatusCode: http.StatusOK,
+					Body: []byte(`{
+						"pagination": {"next": {"href": "https://api.example.com/v3/apps?page=2&space_guids=space-guid"}},
+						"resources": [{
+							"guid": "app-guid-1",
+							"name": "app-1",
+							"state": "STARTED",
+							"lifecycle": {"type": "buildpack"},
+							"relationships": {"space": {"data": {"guid": "space-guid"}}}
+						}]
+					}`),
+				}
+				fakeCmd.responses["/v3/apps?page=2&space_guids=space-guid"] = CloudControllerResponse{
+					StatusCode: http.StatusOK,
+					Body:       []byte(`{"pagination": {"next": null}, "resources": [{"guid": "app-guid-2", "name": "app-2"}]}`),
+				}
+			})
+
+			It("returns the apps from every page", func() {
+				apps, err := connection.GetApps()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(2))
+				Expect(apps[0]).To(Equal(App{
+					GUID:          "app-guid-1",
+					Name:          "app-1",
+					State:         "STARTED",
+					LifecycleType: "buildpack",
+					SpaceGUID:     "space-guid",
+				}))
+				Expect(apps[1].GUID).To(Equal("app-guid-2"))
+			})
+		})
+
+		Context("when no space is targeted", func() {
+			BeforeEach(func() {
+				fakeCmd.target = Target{}
+			})
+
+			It("returns ErrNoSpaceTargeted", func() {
+				_, err := connection.GetApps()
+				Expect(err).To(MatchError(ErrNoSpaceTargeted))
+			})
+		})
+
+		Context("when the Cloud Controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCmd.responses["/v3/apps?space_guids=space-guid"] = CloudControllerResponse{
+					StatusCode: http.StatusForbidden,
+					Body:       []byte(`{"errors": [{"code": 10003, "title": "CF-NotAuthorized", "detail": "You are not authorized to perform the requested action"}]}`),
+				}
+			})
+
+			It("returns a RequestError", func() {
+				_, err := connection.GetApps()
+				Expect(err).To(MatchError(RequestError{
+					StatusCode: http.StatusForbidden,
+					Body:       fakeCmd.responses["/v3/apps?space_guids=space-guid"].Body,
+					Detail:     "You are not authorized to perform the requested action",
+				}))
+			})
+		})
+	})
+
+	Describe("GetApp", func() {
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCmd.responses["/v3/apps?names=some-app&space_guids=space-guid"] = CloudControllerResponse{
+					StatusCode: http.StatusOK,
+					Body:       []byte(`{"resources": []}`),
+				}
+			})
+
+			It("returns an AppNotFoundError", func() {
+				_, err := connection.GetApp("some-app")
+				Expect(err).To(MatchError(AppNotFoundError{Name: "some-app"}))
+			})
+		})
+	})
+
+	Describe("GetProcesses", func() {
+		BeforeEach(func() {
+			fakeCmd.responses["/v3/apps/app-guid/processes"] = CloudControllerResponse{
+				StatusCode: http.StatusOK,
+				Body: []byte(`{"resources": [{
+					"guid": "process-guid",
+					"type": "web",
+					"instances": 2,
+					"memory_in_mb": 256,
+					"health_check": {"type": "port"}
+				}]}`),
+			}
+		})
+
+		It("returns the app's processes", func() {
+			processes, err := connection.GetProcesses("app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(processes).To(Equal([]Process{{
+				GUID:            "process-guid",
+				Type:            "web",
+				Instances:       2,
+				MemoryInMB:      256,
+				HealthCheckType: "port",
+			}}))
+		})
+	})
+
+	Describe("GetNetworkPolicies", func() {
+		BeforeEach(func() {
+			fakeCmd.responses["/networking/v1/external/policies?id=app-guid"] = CloudControllerResponse{
+				StatusCode: http.StatusOK,
+				Body: []byte(`{"total_policies": 1, "policies": [{
+					"source": {"id": "app-guid"},
+					"destination": {"id": "other-app-guid", "protocol": "tcp", "ports": {"start": 8080, "end": 8081}}
+				}]}`),
+			}
+		})
+
+		It("returns the app's policies", func() {
+			policies, err := connection.GetNetworkPolicies("app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies).To(Equal([]NetworkPolicy{{
+				SourceAppGUID:      "app-guid",
+				DestinationAppGUID: "other-app-guid",
+				Protocol:           "tcp",
+				StartPort:          8080,
+				EndPort:            8081,
+			}}))
+		})
+	})
+})
@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNoSpaceTargeted is returned when a space is required but none is
+// targeted.
+var ErrNoSpaceTargeted = errors.New("no space targeted")
+
+// AppNotFoundError is returned when an app cannot be found in the targeted
+// space.
+type AppNotFoundError struct {
+	Name string
+}
+
+func (e AppNotFoundError) Error() string {
+	return fmt.Sprintf("app %s not found", e.Name)
+}
+
+// RequestError is returned by the typed getters when the Cloud Controller
+// responds with a non-2XX status.
+type RequestError struct {
+	StatusCode int
+	Body       []byte
+
+	// Detail is the detail of the first error in a V3 error response.
+	Detail string
+}
+
+func newRequestError(response CloudControllerResponse) RequestError {
+	var errorResponse struct {
+		Errors []struct {
+			Detail string `json:"detail"`
+		} `json:"errors"`
+	}
+	requestErr := RequestError{StatusCode: response.StatusCode, Body: response.Body}
+	if json.Unmarshal(response.Body, &errorResponse) == nil && len(errorResponse.Errors) > 0 {
+		requestErr.Detail = errorResponse.Errors[0].Detail
+	}
+	return requestErr
+}
+
+func (e RequestError) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Detail)
+	}
+	return fmt.Sprintf("request failed with status %d", e.StatusCode)
+}
@@ -0,0 +1,103 @@
+// Package v2 is the second version of the cf CLI plugin API. Plugins built
+// against it get structured command results, the targeted resources in a
+// single call, typed Cloud Controller V3 resources and authenticated Cloud
+// Controller requests that use the CLI's token refresh.
+//
+// Plugins built against code.cloudfoundry.org/cli/plugin keep working; both
+// APIs are served by the CLI at the same time.
+package v2
+
+import "code.cloudfoundry.org/cli/plugin"
+
+// Plugin needs to be implemented by a plugin built against the v2 API. The
+// metadata is the same as for plugins built against the first API.
+type Plugin interface {
+	Run(cliConnection CliConnection, args []string)
+	GetMetadata() plugin.PluginMetadata
+}
+
+// Completer can be implemented by a plugin to complete the flag values and
+// arguments that are declared with plugin.CompletionPlugin. args holds the
+// command name followed by the words typed so far; the last word is the one
+// being completed.
+type Completer interface {
+	Complete(cliConnection CliConnection, args []string) []string
+}
+
+//go:generate counterfeiter . CliConnection
+
+// CliConnection is passed to Run and is used to call back into the CLI.
+type CliConnection interface {
+	// RunCommand runs a CLI command without displaying its output. A command
+	// that fails is reported in the result rather than as an error.
+	RunCommand(args ...string) (CommandResult, error)
+
+	// CloudControllerRequest makes an authenticated request to the targeted
+	// API. The path is relative to the API endpoint, for example
+	// "/v3/apps?names=my-app". Responses with a non-2XX status are returned
+	// without an error.
+	CloudControllerRequest(method string, path string, body []byte) (CloudControllerResponse, error)
+
+	// AccessToken returns a current access token, refreshing it if needed.
+	AccessToken() (string, error)
+
+	// Target returns the targeted API, organization and space, and the
+	// logged in user.
+	Target() (Target, error)
+
+	GetApps() ([]App, error)
+	GetApp(name string) (App, error)
+	GetProcesses(appGUID string) ([]Process, error)
+	GetDroplets(appGUID string) ([]Droplet, error)
+	GetTasks(appGUID string) ([]Task, error)
+	GetIsolationSegments() ([]IsolationSegment, error)
+	GetNetworkPolicies(appGUID string) ([]NetworkPolicy, error)
+}
+
+// CommandResult is the result of running a CLI command.
+type CommandResult struct {
+	Args      []string
+	Output    []string
+	Succeeded bool
+
+	// Error is the error the command failed with, if any.
+	Error string
+}
+
+// CloudControllerRequest is a request made through CloudControllerRequest.
+type CloudControllerRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// CloudControllerResponse is the response to a CloudControllerRequest.
+type CloudControllerResponse struct {
+	StatusCode int
+	Header     map[string][]string
+	Body       []byte
+}
+
+// Target is the targeted API, organization and space, and the logged in
+// user. Fields are empty when nothing is targeted or no user is logged in.
+type Target struct {
+	APIEndpoint       string
+	APIVersion        string
+	SkipSSLValidation bool
+	Organization      Organization
+	Space             Space
+	Username          string
+	UserGUID          string
+}
+
+// Organization is a Cloud Controller organization.
+type Organization struct {
+	GUID string
+	Name string
+}
+
+// Space is a Cloud Controller space.
+type Space struct {
+	GUID string
+	Name string
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+/**
+	* This function is called by a plugin built against the v2 API to setup
+	* its server. It takes the same arguments as plugin.Start:
+	* os.Args[1] port CF_CLI rpc server is running on
+	* os.Args[2] **OPTIONAL**
+		* SendMetadata - used to fetch the plugin metadata
+		* SendCompletions - used to fetch completions from a Completer
+**/
+func Start(cmd Plugin) {
+	if len(os.Args) < 2 {
+		fmt.Printf("This cf CLI plugin is not intended to be run on its own\n\n")
+		os.Exit(1)
+	}
+
+	cliConnection := NewCliConnection(os.Args[1])
+	cliConnection.pingCLI()
+	if len(os.Args) == 3 && os.Args[2] == "SendMetadata" {
+		cliConnection.sendPluginMetadataToCliServer(cmd.GetMetadata())
+	} else if len(os.Args) >= 4 && os.Args[2] == "SendCompletions" {
+		var completions []string
+		if completer, ok := cmd.(Completer); ok {
+			completions = completer.Complete(cliConnection, os.Args[3:])
+		}
+		cliConnection.sendPluginCompletionsToCliServer(completions)
+	} else {
+		if version := plugin.MinCliVersionStr(cmd.GetMetadata().MinCliVersion); version != "" {
+			ok := cliConnection.isMinCliVersion(version)
+			if !ok {
+				fmt.Printf("Minimum CLI version %s is required to run this plugin command\n\n", version)
+				os.Exit(0)
+			}
+		}
+
+		cmd.Run(cliConnection, os.Args[2:])
+	}
+}
@@ -0,0 +1,181 @@
+package v2
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// App is a Cloud Controller V3 app.
+type App struct {
+	GUID          string
+	Name          string
+	State         string
+	LifecycleType string
+	SpaceGUID     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (app *App) UnmarshalJSON(data []byte) error {
+	var ccApp struct {
+		GUID      string    `json:"guid"`
+		Name      string    `json:"name"`
+		State     string    `json:"state"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+		Lifecycle struct {
+			Type string `json:"type"`
+		} `json:"lifecycle"`
+		Relationships struct {
+			Space struct {
+				Data struct {
+					GUID string `json:"guid"`
+				} `json:"data"`
+			} `json:"space"`
+		} `json:"relationships"`
+	}
+	if err := json.Unmarshal(data, &ccApp); err != nil {
+		return err
+	}
+
+	*app = App{
+		GUID:          ccApp.GUID,
+		Name:          ccApp.Name,
+		State:         ccApp.State,
+		LifecycleType: ccApp.Lifecycle.Type,
+		SpaceGUID:     ccApp.Relationships.Space.Data.GUID,
+		CreatedAt:     ccApp.CreatedAt,
+		UpdatedAt:     ccApp.UpdatedAt,
+	}
+	return nil
+}
+
+// Process is a Cloud Controller V3 process.
+type Process struct {
+	GUID            string
+	Type            string
+	Command         string
+	Instances       int
+	MemoryInMB      int
+	DiskInMB        int
+	HealthCheckType string
+}
+
+func (process *Process) UnmarshalJSON(data []byte) error {
+	var ccProcess struct {
+		GUID        string `json:"guid"`
+		Type        string `json:"type"`
+		Command     string `json:"command"`
+		Instances   int    `json:"instances"`
+		MemoryInMB  int    `json:"memory_in_mb"`
+		DiskInMB    int    `json:"disk_in_mb"`
+		HealthCheck struct {
+			Type string `json:"type"`
+		} `json:"health_check"`
+	}
+	if err := json.Unmarshal(data, &ccProcess); err != nil {
+		return err
+	}
+
+	*process = Process{
+		GUID:            ccProcess.GUID,
+		Type:            ccProcess.Type,
+		Command:         ccProcess.Command,
+		Instances:       ccProcess.Instances,
+		MemoryInMB:      ccProcess.MemoryInMB,
+		DiskInMB:        ccProcess.DiskInMB,
+		HealthCheckType: ccProcess.HealthCheck.Type,
+	}
+	return nil
+}
+
+// Droplet is a Cloud Controller V3 droplet.
+type Droplet struct {
+	GUID       string
+	State      string
+	Stack      string
+	Buildpacks []string
+	CreatedAt  time.Time
+}
+
+func (droplet *Droplet) UnmarshalJSON(data []byte) error {
+	var ccDroplet struct {
+		GUID       string `json:"guid"`
+		State      string `json:"state"`
+		Stack      string `json:"stack"`
+		Buildpacks []struct {
+			Name string `json:"name"`
+		} `json:"buildpacks"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+	if err := json.Unmarshal(data, &ccDroplet); err != nil {
+		return err
+	}
+
+	*droplet = Droplet{
+		GUID:      ccDroplet.GUID,
+		State:     ccDroplet.State,
+		Stack:     ccDroplet.Stack,
+		CreatedAt: ccDroplet.CreatedAt,
+	}
+	for _, buildpack := range ccDroplet.Buildpacks {
+		droplet.Buildpacks = append(droplet.Buildpacks, buildpack.Name)
+	}
+	return nil
+}
+
+// Task is a Cloud Controller V3 task.
+type Task struct {
+	GUID       string    `json:"guid"`
+	SequenceID int       `json:"sequence_id"`
+	Name       string    `json:"name"`
+	Command    string    `json:"command"`
+	State      string    `json:"state"`
+	MemoryInMB int       `json:"memory_in_mb"`
+	DiskInMB   int       `json:"disk_in_mb"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// IsolationSegment is a Cloud Controller V3 isolation segment.
+type IsolationSegment struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+}
+
+// NetworkPolicy is a container networking policy that allows traffic from the
+// source app to the destination app.
+type NetworkPolicy struct {
+	SourceAppGUID      string
+	DestinationAppGUID string
+	Protocol           string
+	StartPort          int
+	EndPort            int
+}
+
+func (policy *NetworkPolicy) UnmarshalJSON(data []byte) error {
+	var ccPolicy struct {
+		Source struct {
+			ID string `json:"id"`
+		} `json:"source"`
+		Destination struct {
+			ID       string `json:"id"`
+			Protocol string `json:"protocol"`
+			Ports    struct {
+				Start int `json:"start"`
+				End   int `json:"end"`
+			} `json:"ports"`
+		} `json:"destination"`
+	}
+	if err := json.Unmarshal(data, &ccPolicy); err != nil {
+		return err
+	}
+
+	*policy = NetworkPolicy{
+		SourceAppGUID:      ccPolicy.Source.ID,
+		DestinationAppGUID: ccPolicy.Destination.ID,
+		Protocol:           ccPolicy.Destination.Protocol,
+		StartPort:          ccPolicy.Destination.Ports.Start,
+		EndPort:            ccPolicy.Destination.Ports.End,
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import "encoding/json"
+
+// NullString is a wrapper around string values that can be null or a string.
+// Use IsSet to check if the value is provided, instead of checking against the
+// empty string.
+type NullString struct {
+	IsSet bool
+	Value string
+}
+
+// NewNullString returns a NullString. Supplying no value creates an unset
+// NullString.
+func NewNullString(optionalValue ...string) NullString {
+	switch len(optionalValue) {
+	case 0:
+		return NullString{IsSet: false}
+	default:
+		return NullString{Value: optionalValue[0], IsSet: true}
+	}
+}
+
+func (n *NullString) UnmarshalJSON(rawJSON []byte) error {
+	var value *string
+	err := json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		n.Value = ""
+		n.IsSet = false
+		return nil
+	}
+
+	n.Value = *value
+	n.IsSet = true
+	return nil
+}
+
+// MarshalJSON marshals the value field if IsSet is true, otherwise returns
+// null.
+func (n NullString) MarshalJSON() ([]byte, error) {
+	if n.IsSet {
+		return json.Marshal(n.Value)
+	}
+
+	return json.Marshal(nil)
+}
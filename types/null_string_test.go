@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullString", func() {
+	Describe("NewNullString", func() {
+		It("returns an unset NullString when no value is provided", func() {
+			Expect(NewNullString()).To(Equal(NullString{}))
+		})
+
+		It("returns a set NullString when a value is provided", func() {
+			Expect(NewNullString("")).To(Equal(NullString{IsSet: true, Value: ""}))
+			Expect(NewNullString("some-value")).To(Equal(NullString{IsSet: true, Value: "some-value"}))
+		})
+	})
+
+	Describe("UnmarshalJSON", func() {
+		var nullString NullString
+
+		BeforeEach(func() {
+			nullString = NullString{IsSet: true, Value: "old-value"}
+		})
+
+		Context("when null is provided", func() {
+			It("returns an unset NullString", func() {
+				Expect(json.Unmarshal([]byte("null"), &nullString)).To(Succeed())
+				Expect(nullString).To(Equal(NullString{}))
+			})
+		})
+
+		Context("when a string is provided", func() {
+			It("returns a set NullString", func() {
+				Expect(json.Unmarshal([]byte(`""`), &nullString)).To(Succeed())
+				Expect(nullString).To(Equal(NullString{IsSet: true, Value: ""}))
+			})
+		})
+	})
+
+	Describe("MarshalJSON", func() {
+		It("marshals an unset NullString as null", func() {
+			Expect(json.Marshal(NullString{})).To(MatchJSON("null"))
+		})
+
+		It("marshals a set NullString as a string", func() {
+			Expect(json.Marshal(NewNullString("some-value"))).To(MatchJSON(`"some-value"`))
+		})
+	})
+})
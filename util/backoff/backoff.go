@@ -0,0 +1,82 @@
+// Package backoff computes how long to wait before retrying a failed request.
+package backoff
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	randMutex sync.Mutex
+	random    = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// Backoff doubles the delay between retries, starting at Initial and never
+// exceeding Max.
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+// Delay returns how long to wait before the given retry attempt, starting at
+// 1. A Retry-After header on a 429 or 503 response is used when present;
+// otherwise the delay is the exponential backoff with random jitter, between
+// half and all of it.
+func (backoff Backoff) Delay(attempt int, response *http.Response) time.Duration {
+	if retryAfter, ok := RetryAfter(response); ok {
+		return backoff.limit(retryAfter)
+	}
+
+	delay := backoff.Initial
+	for i := 1; i < attempt && delay < backoff.Max; i++ {
+		delay *= 2
+	}
+	delay = backoff.limit(delay)
+	if delay <= 0 {
+		return 0
+	}
+
+	randMutex.Lock()
+	jitter := time.Duration(random.Int63n(int64(delay/2) + 1))
+	randMutex.Unlock()
+
+	return delay/2 + jitter
+}
+
+func (backoff Backoff) limit(delay time.Duration) time.Duration {
+	if delay > backoff.Max {
+		return backoff.Max
+	}
+	return delay
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of a 429
+// or 503 response. The header is either a number of seconds or an HTTP date.
+func RetryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil ||
+		response.StatusCode != http.StatusTooManyRequests &&
+			response.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
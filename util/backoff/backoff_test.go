@@ -0,0 +1,69 @@
+package backoff_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/backoff"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Backoff", func() {
+	var backoff Backoff
+
+	BeforeEach(func() {
+		backoff = Backoff{Initial: time.Second, Max: 5 * time.Second}
+	})
+
+	Describe("Delay", func() {
+		DescribeTable("doubles the delay with jitter up to the maximum",
+			func(attempt int, min time.Duration, max time.Duration) {
+				for i := 0; i < 20; i++ {
+					delay := backoff.Delay(attempt, nil)
+					Expect(delay).To(BeNumerically(">=", min))
+					Expect(delay).To(BeNumerically("<=", max))
+				}
+			},
+
+			Entry("first attempt", 1, 500*time.Millisecond, time.Second),
+			Entry("second attempt", 2, time.Second, 2*time.Second),
+			Entry("third attempt", 3, 2*time.Second, 4*time.Second),
+			Entry("tenth attempt", 10, 2500*time.Millisecond, 5*time.Second),
+		)
+
+		Context("when the response has a Retry-After header", func() {
+			It("waits for the requested time, up to the maximum", func() {
+				response := &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"Retry-After": {"3"}},
+				}
+				Expect(backoff.Delay(1, response)).To(Equal(3 * time.Second))
+
+				response.Header.Set("Retry-After", "60")
+				Expect(backoff.Delay(1, response)).To(Equal(5 * time.Second))
+			})
+		})
+	})
+
+	DescribeTable("RetryAfter",
+		func(statusCode int, retryAfter string, expectedDelay time.Duration, expectedOK bool) {
+			delay, ok := RetryAfter(&http.Response{
+				StatusCode: statusCode,
+				Header:     http.Header{"Retry-After": {retryAfter}},
+			})
+			Expect(ok).To(Equal(expectedOK))
+			Expect(delay).To(BeNumerically("~", expectedDelay, time.Second))
+		},
+
+		Entry("seconds on a 429", http.StatusTooManyRequests, "2", 2*time.Second, true),
+		Entry("seconds on a 503", http.StatusServiceUnavailable, "2", 2*time.Second, true),
+		Entry("an HTTP date", http.StatusServiceUnavailable, time.Now().Add(10*time.Second).UTC().Format(http.TimeFormat), 10*time.Second, true),
+		Entry("an HTTP date in the past", http.StatusServiceUnavailable, "Mon, 02 Jan 2006 15:04:05 GMT", time.Duration(0), true),
+		Entry("an invalid value", http.StatusTooManyRequests, "soon", time.Duration(0), false),
+		Entry("no header", http.StatusTooManyRequests, "", time.Duration(0), false),
+		Entry("another status code", http.StatusInternalServerError, "2", time.Duration(0), false),
+	)
+})
@@ -0,0 +1,25 @@
+// Package browser opens URLs in the user's default web browser.
+package browser
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// Launcher opens URLs with the operating system's URL handler.
+type Launcher struct{}
+
+// Open opens the URL in the default web browser. It does not wait for the
+// browser to exit.
+func (Launcher) Open(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -24,6 +24,17 @@ type FakeSecureSession struct {
 	requestPtyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RequestSubsystemStub        func(subsystem string) error
+	requestSubsystemMutex       sync.RWMutex
+	requestSubsystemArgsForCall []struct {
+		subsystem string
+	}
+	requestSubsystemReturns struct {
+		result1 error
+	}
+	requestSubsystemReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SendRequestStub        func(name string, wantReply bool, payload []byte) (bool, error)
 	sendRequestMutex       sync.RWMutex
 	sendRequestArgsForCall []struct {
@@ -165,6 +176,54 @@ func (fake *FakeSecureSession) RequestPtyReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) RequestSubsystem(subsystem string) error {
+	fake.requestSubsystemMutex.Lock()
+	ret, specificReturn := fake.requestSubsystemReturnsOnCall[len(fake.requestSubsystemArgsForCall)]
+	fake.requestSubsystemArgsForCall = append(fake.requestSubsystemArgsForCall, struct {
+		subsystem string
+	}{subsystem})
+	fake.recordInvocation("RequestSubsystem", []interface{}{subsystem})
+	fake.requestSubsystemMutex.Unlock()
+	if fake.RequestSubsystemStub != nil {
+		return fake.RequestSubsystemStub(subsystem)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestSubsystemReturns.result1
+}
+
+func (fake *FakeSecureSession) RequestSubsystemCallCount() int {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	return len(fake.requestSubsystemArgsForCall)
+}
+
+func (fake *FakeSecureSession) RequestSubsystemArgsForCall(i int) string {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	return fake.requestSubsystemArgsForCall[i].subsystem
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturns(result1 error) {
+	fake.RequestSubsystemStub = nil
+	fake.requestSubsystemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturnsOnCall(i int, result1 error) {
+	fake.RequestSubsystemStub = nil
+	if fake.requestSubsystemReturnsOnCall == nil {
+		fake.requestSubsystemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestSubsystemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) SendRequest(name string, wantReply bool, payload []byte) (bool, error) {
 	var payloadCopy []byte
 	if payload != nil {
@@ -525,6 +584,8 @@ func (fake *FakeSecureSession) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.requestPtyMutex.RLock()
 	defer fake.requestPtyMutex.RUnlock()
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
 	fake.sendRequestMutex.RLock()
 	defer fake.sendRequestMutex.RUnlock()
 	fake.stdinPipeMutex.RLock()
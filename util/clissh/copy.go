@@ -0,0 +1,414 @@
+package clissh
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+	"github.com/pkg/sftp"
+)
+
+//go:generate counterfeiter . ProgressBar
+
+// ProgressBar displays the number of bytes copied.
+type ProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+	Ready()
+	Complete()
+}
+
+const (
+	// checksumCommand calculates the checksum of copied files in the app
+	// container.
+	checksumCommand = "sha256sum"
+
+	// commandNotFoundExitStatus is the exit status of a shell command that
+	// could not be found.
+	commandNotFoundExitStatus = 127
+)
+
+// copyItem is a single file or directory to copy.
+type copyItem struct {
+	source      string
+	destination string
+	size        int64
+	perm        os.FileMode
+	isDir       bool
+}
+
+// CopyFromRemote copies the remote file, or directory when recursive is true,
+// to localPath over SFTP. When localPath is an existing directory the remote
+// file is copied into it. Every copied file's SHA-256 checksum is compared to
+// the checksum of the remote file.
+func (c *SecureShell) CopyFromRemote(remotePath string, localPath string, recursive bool, progressBar ProgressBar) error {
+	client, err := c.openSFTP()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	info, err := client.Stat(remotePath)
+	if err != nil {
+		return &os.PathError{Op: "stat", Path: remotePath, Err: err}
+	}
+	if info.IsDir() && !recursive {
+		return ssherror.RecursiveCopyRequiredError{Path: remotePath}
+	}
+
+	if localInfo, statErr := os.Stat(localPath); statErr == nil && localInfo.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	items, err := remoteCopyItems(client, remotePath, localPath, info)
+	if err != nil {
+		return err
+	}
+
+	return c.copyItems(items, progressBar, func(item copyItem, source *progressReader, hasher hash.Hash) error {
+		if item.isDir {
+			return os.MkdirAll(item.destination, item.perm|0700)
+		}
+
+		file, err := os.OpenFile(item.destination, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, item.perm)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		remoteFile, err := client.Open(item.source)
+		if err != nil {
+			return &os.PathError{Op: "open", Path: item.source, Err: err}
+		}
+		defer remoteFile.Close()
+
+		pipeReader, pipeWriter := io.Pipe()
+		go func() {
+			_, copyErr := remoteFile.WriteTo(pipeWriter)
+			pipeWriter.CloseWithError(copyErr)
+		}()
+
+		source.Set(pipeReader)
+		_, err = io.Copy(io.MultiWriter(file, hasher), source)
+		if err != nil {
+			_ = pipeReader.CloseWithError(err)
+			return err
+		}
+		return file.Close()
+	}, func(item copyItem) string { return item.source })
+}
+
+// CopyToRemote copies the local file, or directory when recursive is true, to
+// remotePath over SFTP. When remotePath is an existing directory the local
+// file is copied into it. Every copied file's SHA-256 checksum is compared to
+// the checksum of the local file.
+func (c *SecureShell) CopyToRemote(localPath string, remotePath string, recursive bool, progressBar ProgressBar) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() && !recursive {
+		return ssherror.RecursiveCopyRequiredError{Path: localPath}
+	}
+
+	client, err := c.openSFTP()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if remoteInfo, statErr := client.Stat(remotePath); statErr == nil && remoteInfo.IsDir() {
+		remotePath = path.Join(remotePath, filepath.Base(localPath))
+	}
+
+	items, err := localCopyItems(localPath, remotePath, info)
+	if err != nil {
+		return err
+	}
+
+	return c.copyItems(items, progressBar, func(item copyItem, source *progressReader, hasher hash.Hash) error {
+		if item.isDir {
+			if remoteInfo, statErr := client.Stat(item.destination); statErr == nil && remoteInfo.IsDir() {
+				return nil
+			}
+			err := client.Mkdir(item.destination)
+			if err != nil {
+				return &os.PathError{Op: "mkdir", Path: item.destination, Err: err}
+			}
+			return client.Chmod(item.destination, item.perm)
+		}
+
+		file, err := os.Open(item.source)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		remoteFile, err := client.OpenFile(item.destination, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+		if err != nil {
+			return &os.PathError{Op: "open", Path: item.destination, Err: err}
+		}
+		defer remoteFile.Close()
+
+		source.Set(io.TeeReader(file, hasher))
+		_, err = remoteFile.ReadFrom(source)
+		if err != nil {
+			return err
+		}
+		err = remoteFile.Chmod(item.perm)
+		if err != nil {
+			return err
+		}
+		return remoteFile.Close()
+	}, func(item copyItem) string { return item.destination })
+}
+
+// copyItems copies each item, displaying the progress of all of them together
+// in one progress bar, and verifies the checksum of each file afterwards.
+func (c *SecureShell) copyItems(
+	items []copyItem,
+	progressBar ProgressBar,
+	copyItem func(item copyItem, source *progressReader, hasher hash.Hash) error,
+	remotePath func(item copyItem) string,
+) error {
+	var total int64
+	for _, item := range items {
+		total += item.size
+	}
+
+	source := new(switchReader)
+	go progressBar.Ready()
+	progress := &progressReader{
+		source:  source,
+		wrapped: progressBar.NewProgressBarWrapper(source, total),
+	}
+	defer progressBar.Complete()
+
+	for _, item := range items {
+		hasher := sha256.New()
+		err := copyItem(item, progress, hasher)
+		if err != nil {
+			return err
+		}
+		if item.isDir {
+			continue
+		}
+
+		remoteChecksum, err := c.remoteChecksum(remotePath(item))
+		if err != nil {
+			return err
+		}
+		if remoteChecksum != hex.EncodeToString(hasher.Sum(nil)) {
+			return ssherror.ChecksumMismatchError{Path: item.destination}
+		}
+	}
+
+	return nil
+}
+
+// openSFTP starts the SFTP subsystem in a new session.
+func (c *SecureShell) openSFTP() (*sftp.Client, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = session.RequestSubsystem("sftp")
+	if err != nil {
+		return nil, err
+	}
+
+	return sftp.NewClientPipe(stdout, &sessionCloser{WriteCloser: stdin, session: session})
+}
+
+// remoteChecksum returns the SHA-256 checksum of the remote file, as
+// calculated by sha256sum in the app container. A
+// ChecksumCommandNotFoundError is returned when the container does not have
+// sha256sum.
+func (c *SecureShell) remoteChecksum(remotePath string) (string, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return "", fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return "", err
+	}
+
+	err = session.Start(checksumCommand + " -- " + shellQuote(remotePath))
+	if err != nil {
+		return "", err
+	}
+
+	output, err := ioutil.ReadAll(stdout)
+	if err != nil {
+		return "", err
+	}
+	err = session.Wait()
+	if exitErr, ok := err.(exitStatusError); ok && exitErr.ExitStatus() == commandNotFoundExitStatus {
+		return "", ssherror.ChecksumCommandNotFoundError{Command: checksumCommand}
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to calculate the checksum of %s: %s", remotePath, err.Error())
+	}
+
+	fields := strings.Fields(string(output))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("unable to calculate the checksum of %s", remotePath)
+	}
+	return fields[0], nil
+}
+
+// remoteCopyItems lists the remote file or the remote directory tree.
+// Symlinks to files are copied as files; symlinks to directories are skipped.
+func remoteCopyItems(client *sftp.Client, remotePath string, localPath string, info os.FileInfo) ([]copyItem, error) {
+	if !info.IsDir() {
+		return []copyItem{{source: remotePath, destination: localPath, size: info.Size(), perm: info.Mode().Perm()}}, nil
+	}
+
+	items := []copyItem{{source: remotePath, destination: localPath, perm: info.Mode().Perm(), isDir: true}}
+
+	entries, err := client.ReadDir(remotePath)
+	if err != nil {
+		return nil, &os.PathError{Op: "readdir", Path: remotePath, Err: err}
+	}
+	for _, entry := range entries {
+		entryPath := path.Join(remotePath, entry.Name())
+		if entry.Mode()&os.ModeSymlink != 0 {
+			entry, err = client.Stat(entryPath)
+			if err != nil || entry.IsDir() {
+				continue
+			}
+		}
+		if !entry.IsDir() && !entry.Mode().IsRegular() {
+			continue
+		}
+
+		children, err := remoteCopyItems(client, entryPath, filepath.Join(localPath, path.Base(entryPath)), entry)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, children...)
+	}
+
+	return items, nil
+}
+
+// localCopyItems lists the local file or the local directory tree. Symlinks
+// to files are copied as files; symlinks to directories are skipped.
+func localCopyItems(localPath string, remotePath string, info os.FileInfo) ([]copyItem, error) {
+	if !info.IsDir() {
+		return []copyItem{{source: localPath, destination: remotePath, size: info.Size(), perm: info.Mode().Perm()}}, nil
+	}
+
+	var items []copyItem
+	err := filepath.Walk(localPath, func(walkPath string, walkInfo os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if walkInfo.Mode()&os.ModeSymlink != 0 {
+			walkInfo, err = os.Stat(walkPath)
+			if err != nil || walkInfo.IsDir() {
+				return nil
+			}
+		}
+		if !walkInfo.IsDir() && !walkInfo.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(localPath, walkPath)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, copyItem{
+			source:      walkPath,
+			destination: path.Join(remotePath, filepath.ToSlash(relativePath)),
+			size:        walkInfo.Size(),
+			perm:        walkInfo.Mode().Perm(),
+			isDir:       walkInfo.IsDir(),
+		})
+		if walkInfo.IsDir() {
+			items[len(items)-1].size = 0
+		}
+		return nil
+	})
+	return items, err
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
+
+// switchReader reads from the reader it was most recently set to.
+type switchReader struct {
+	mutex  sync.Mutex
+	reader io.Reader
+}
+
+func (r *switchReader) Set(reader io.Reader) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.reader = reader
+}
+
+func (r *switchReader) Read(p []byte) (int, error) {
+	r.mutex.Lock()
+	reader := r.reader
+	r.mutex.Unlock()
+
+	if reader == nil {
+		return 0, io.EOF
+	}
+	return reader.Read(p)
+}
+
+// progressReader reads the file currently being copied through the progress
+// bar, so that a single progress bar tracks every copied file.
+type progressReader struct {
+	source  *switchReader
+	wrapped io.Reader
+}
+
+// Set sets the file currently being copied.
+func (r *progressReader) Set(reader io.Reader) {
+	r.source.Set(reader)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	return r.wrapped.Read(p)
+}
+
+// sessionCloser closes the session when the SFTP client closes its input.
+type sessionCloser struct {
+	io.WriteCloser
+	session SecureSession
+}
+
+func (closer *sessionCloser) Close() error {
+	err := closer.WriteCloser.Close()
+	if closeErr := closer.session.Close(); err == nil && closeErr != nil && closeErr != io.EOF {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,281 @@
+package clissh_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+	"github.com/pkg/sftp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Copy", func() {
+	var (
+		fakeSecureDialer    *clisshfakes.FakeSecureDialer
+		fakeSecureClient    *clisshfakes.FakeSecureClient
+		fakeTerminalHelper  *clisshfakes.FakeTerminalHelper
+		fakeListenerFactory *clisshfakes.FakeListenerFactory
+		fakeProgressBar     *clisshfakes.FakeProgressBar
+		secureShell         *SecureShell
+
+		remoteDir string
+		localDir  string
+
+		checksumCommands []string
+		checksumOverride string
+		checksumWaitErr  error
+		commandsMutex    sync.Mutex
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeTerminalHelper = new(clisshfakes.FakeTerminalHelper)
+		fakeListenerFactory = new(clisshfakes.FakeListenerFactory)
+		fakeProgressBar = new(clisshfakes.FakeProgressBar)
+		fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+			return reader
+		}
+
+		var err error
+		remoteDir, err = ioutil.TempDir("", "cf-ssh-copy-remote")
+		Expect(err).ToNot(HaveOccurred())
+		localDir, err = ioutil.TempDir("", "cf-ssh-copy-local")
+		Expect(err).ToNot(HaveOccurred())
+
+		checksumCommands = nil
+		checksumOverride = ""
+		checksumWaitErr = nil
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+		fakeSecureClient.NewSessionStub = func() (SecureSession, error) {
+			requestsReader, requestsWriter := io.Pipe()
+			responsesReader, responsesWriter := io.Pipe()
+
+			session := new(clisshfakes.FakeSecureSession)
+			session.StdinPipeReturns(requestsWriter, nil)
+			session.StdoutPipeReturns(responsesReader, nil)
+			session.RequestSubsystemStub = func(subsystem string) error {
+				Expect(subsystem).To(Equal("sftp"))
+				server, err := sftp.NewServer(sftpPipe{Reader: requestsReader, WriteCloser: responsesWriter})
+				Expect(err).ToNot(HaveOccurred())
+				go func() {
+					_ = server.Serve()
+					_ = responsesWriter.Close()
+				}()
+				return nil
+			}
+			session.StartStub = func(command string) error {
+				commandsMutex.Lock()
+				checksumCommands = append(checksumCommands, command)
+				commandsMutex.Unlock()
+
+				go func() {
+					checksum := checksumOverride
+					if checksum == "" {
+						checksum = fileChecksum(strings.Trim(strings.TrimPrefix(command, "sha256sum -- "), "'"))
+					}
+					_, _ = responsesWriter.Write([]byte(checksum + "  some-path\n"))
+					_ = responsesWriter.Close()
+				}()
+				return nil
+			}
+			session.WaitStub = func() error {
+				return checksumWaitErr
+			}
+			return session, nil
+		}
+
+		secureShell = NewSecureShell(fakeSecureDialer, fakeTerminalHelper, fakeListenerFactory, DefaultKeepAliveInterval)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "", true)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(remoteDir)).To(Succeed())
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+	})
+
+	writeFile := func(root string, name string, contents string) {
+		Expect(os.MkdirAll(filepath.Dir(filepath.Join(root, name)), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(root, name), []byte(contents), 0640)).To(Succeed())
+	}
+
+	// remotePath returns the path the SFTP server serves the file in remoteDir
+	// at.
+	remotePath := func(name string) string {
+		return filepath.ToSlash(filepath.Join(remoteDir, name))
+	}
+
+	readFile := func(root string, name string) string {
+		contents, err := ioutil.ReadFile(filepath.Join(root, name))
+		Expect(err).ToNot(HaveOccurred())
+		return string(contents)
+	}
+
+	Describe("CopyFromRemote", func() {
+		var largeContents string
+
+		BeforeEach(func() {
+			largeContents = strings.Repeat("some-large-file ", 20000)
+			writeFile(remoteDir, "app/some-file", "some-contents")
+			writeFile(remoteDir, "app/dir/large-file", largeContents)
+			writeFile(remoteDir, "app/dir/nested/empty-file", "")
+		})
+
+		It("copies the remote file to the local path", func() {
+			err := secureShell.CopyFromRemote(remotePath("app/some-file"), filepath.Join(localDir, "copied-file"), false, fakeProgressBar)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readFile(localDir, "copied-file")).To(Equal("some-contents"))
+			Expect(checksumCommands).To(ConsistOf("sha256sum -- '" + remotePath("app/some-file") + "'"))
+
+			Expect(fakeProgressBar.ReadyCallCount()).To(Equal(1))
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+			Expect(size).To(BeEquivalentTo(len("some-contents")))
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+		})
+
+		It("copies the remote file into an existing local directory", func() {
+			err := secureShell.CopyFromRemote(remotePath("app/some-file"), localDir, false, fakeProgressBar)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readFile(localDir, "some-file")).To(Equal("some-contents"))
+		})
+
+		Context("when the remote path is a directory", func() {
+			It("copies the directory tree when recursive is set", func() {
+				err := secureShell.CopyFromRemote(remotePath("app/dir"), localDir, true, fakeProgressBar)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(readFile(localDir, "dir/large-file")).To(Equal(largeContents))
+				Expect(readFile(localDir, "dir/nested/empty-file")).To(BeEmpty())
+
+				_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+				Expect(size).To(BeEquivalentTo(len(largeContents)))
+			})
+
+			It("returns a RecursiveCopyRequiredError when recursive is not set", func() {
+				err := secureShell.CopyFromRemote(remotePath("app/dir"), localDir, false, fakeProgressBar)
+				Expect(err).To(MatchError(ssherror.RecursiveCopyRequiredError{Path: remotePath("app/dir")}))
+			})
+		})
+
+		Context("when the remote file does not exist", func() {
+			It("returns an error", func() {
+				err := secureShell.CopyFromRemote(remotePath("app/missing-file"), localDir, false, fakeProgressBar)
+				Expect(err).To(MatchError(ContainSubstring(remotePath("app/missing-file"))))
+			})
+		})
+
+		Context("when the checksums do not match", func() {
+			BeforeEach(func() {
+				checksumOverride = "some-other-checksum"
+			})
+
+			It("returns a ChecksumMismatchError", func() {
+				localPath := filepath.Join(localDir, "copied-file")
+				err := secureShell.CopyFromRemote(remotePath("app/some-file"), localPath, false, fakeProgressBar)
+				Expect(err).To(MatchError(ssherror.ChecksumMismatchError{Path: localPath}))
+			})
+		})
+	})
+
+	Describe("CopyToRemote", func() {
+		var largeContents string
+
+		BeforeEach(func() {
+			largeContents = strings.Repeat("some-large-file ", 20000)
+			writeFile(localDir, "some-file", "some-contents")
+			writeFile(localDir, "dir/large-file", largeContents)
+			writeFile(localDir, "dir/nested/empty-file", "")
+			Expect(os.MkdirAll(filepath.Join(remoteDir, "app"), 0755)).To(Succeed())
+		})
+
+		It("copies the local file to the remote path", func() {
+			err := secureShell.CopyToRemote(filepath.Join(localDir, "some-file"), remotePath("app/copied-file"), false, fakeProgressBar)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readFile(remoteDir, "app/copied-file")).To(Equal("some-contents"))
+			Expect(checksumCommands).To(ConsistOf("sha256sum -- '" + remotePath("app/copied-file") + "'"))
+
+			info, err := os.Stat(filepath.Join(remoteDir, "app/copied-file"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0640)))
+		})
+
+		It("copies the local file into an existing remote directory", func() {
+			err := secureShell.CopyToRemote(filepath.Join(localDir, "some-file"), remotePath("app"), false, fakeProgressBar)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readFile(remoteDir, "app/some-file")).To(Equal("some-contents"))
+		})
+
+		Context("when the local path is a directory", func() {
+			It("copies the directory tree when recursive is set", func() {
+				err := secureShell.CopyToRemote(filepath.Join(localDir, "dir"), remotePath("app"), true, fakeProgressBar)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(readFile(remoteDir, "app/dir/large-file")).To(Equal(largeContents))
+				Expect(readFile(remoteDir, "app/dir/nested/empty-file")).To(BeEmpty())
+			})
+
+			It("returns a RecursiveCopyRequiredError when recursive is not set", func() {
+				localPath := filepath.Join(localDir, "dir")
+				err := secureShell.CopyToRemote(localPath, remotePath("app"), false, fakeProgressBar)
+				Expect(err).To(MatchError(ssherror.RecursiveCopyRequiredError{Path: localPath}))
+				Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the checksums do not match", func() {
+			BeforeEach(func() {
+				checksumOverride = "some-other-checksum"
+			})
+
+			It("returns a ChecksumMismatchError", func() {
+				err := secureShell.CopyToRemote(filepath.Join(localDir, "some-file"), remotePath("app/copied-file"), false, fakeProgressBar)
+				Expect(err).To(MatchError(ssherror.ChecksumMismatchError{Path: remotePath("app/copied-file")}))
+			})
+		})
+
+		Context("when sha256sum is not available in the app container", func() {
+			BeforeEach(func() {
+				checksumWaitErr = fakeExitError{status: 127}
+			})
+
+			It("returns a ChecksumCommandNotFoundError", func() {
+				err := secureShell.CopyToRemote(filepath.Join(localDir, "some-file"), remotePath("app/copied-file"), false, fakeProgressBar)
+				Expect(err).To(MatchError(ssherror.ChecksumCommandNotFoundError{Command: "sha256sum"}))
+			})
+		})
+	})
+})
+
+// sftpPipe joins the streams the SFTP server reads requests from and writes
+// responses to.
+type sftpPipe struct {
+	io.Reader
+	io.WriteCloser
+}
+
+func fileChecksum(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
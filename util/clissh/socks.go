@@ -0,0 +1,117 @@
+package clissh
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+)
+
+// SOCKS version 5 protocol constants, as described in
+// https://tools.ietf.org/html/rfc1928.
+const (
+	socks5Version = 0x05
+
+	socks5MethodNoAuth       = 0x00
+	socks5MethodNoAcceptable = 0xff
+
+	socks5CommandConnect = 0x01
+
+	socks5AddressIPv4       = 0x01
+	socks5AddressDomainName = 0x03
+	socks5AddressIPv6       = 0x04
+
+	socks5ReplyCommandNotSupported     = 0x07
+	socks5ReplyAddressTypeNotSupported = 0x08
+)
+
+// SOCKS5 replies to a CONNECT request.
+const (
+	SOCKS5ReplySucceeded       = 0x00
+	SOCKS5ReplyHostUnreachable = 0x04
+)
+
+// SOCKS5Handshake negotiates a SOCKS5 CONNECT request without authentication
+// and returns the requested address. The caller replies once it has dialed
+// the address.
+func SOCKS5Handshake(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if header[0] != socks5Version {
+		return "", errors.New("socks: unsupported version")
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+
+	method := byte(socks5MethodNoAcceptable)
+	for _, offered := range methods {
+		if offered == socks5MethodNoAuth {
+			method = socks5MethodNoAuth
+		}
+	}
+	if _, err := conn.Write([]byte{socks5Version, method}); err != nil {
+		return "", err
+	}
+	if method == socks5MethodNoAcceptable {
+		return "", errors.New("socks: no acceptable authentication method")
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[0] != socks5Version {
+		return "", errors.New("socks: unsupported version")
+	}
+	if request[1] != socks5CommandConnect {
+		_ = SOCKS5Reply(conn, socks5ReplyCommandNotSupported)
+		return "", errors.New("socks: unsupported command")
+	}
+
+	var host string
+	switch request[3] {
+	case socks5AddressIPv4, socks5AddressIPv6:
+		ip := make([]byte, net.IPv4len)
+		if request[3] == socks5AddressIPv6 {
+			ip = make([]byte, net.IPv6len)
+		}
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = net.IP(ip).String()
+	case socks5AddressDomainName:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		name := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, name); err != nil {
+			return "", err
+		}
+		host = string(name)
+	default:
+		_ = SOCKS5Reply(conn, socks5ReplyAddressTypeNotSupported)
+		return "", errors.New("socks: unsupported address type")
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// SOCKS5Reply replies to a CONNECT request. The bound address is not known
+// for connections made through the app instance, so it is reported as
+// 0.0.0.0:0.
+func SOCKS5Reply(conn io.Writer, reply byte) error {
+	_, err := conn.Write([]byte{socks5Version, reply, 0x00, socks5AddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
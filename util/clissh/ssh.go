@@ -45,6 +45,13 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+// RemotePortForward listens on RemoteAddress in the app instance and forwards
+// its connections to LocalAddress, dialed from the local machine.
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
 //go:generate counterfeiter . SecureDialer
 
 type SecureDialer interface {
@@ -57,6 +64,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -81,6 +89,7 @@ type ListenerFactory interface {
 
 type SecureSession interface {
 	RequestPty(term string, height, width int, termModes ssh.TerminalModes) error
+	RequestSubsystem(subsystem string) error
 	SendRequest(name string, wantReply bool, payload []byte) (bool, error)
 	StdinPipe() (io.WriteCloser, error)
 	StdoutPipe() (io.Reader, error)
@@ -97,7 +106,7 @@ type SecureShell struct {
 	terminalHelper  TerminalHelper
 	listenerFactory ListenerFactory
 
-	localListeners    []net.Listener
+	listeners         []net.Listener
 	keepAliveInterval time.Duration
 }
 
@@ -110,7 +119,7 @@ func NewDefaultSecureShell() *SecureShell {
 		terminalHelper:    defaultTerminalHelper,
 		listenerFactory:   defaultListenerFactory,
 		keepAliveInterval: DefaultKeepAliveInterval,
-		localListeners:    []net.Listener{},
+		listeners:         []net.Listener{},
 	}
 }
 
@@ -125,7 +134,7 @@ func NewSecureShell(
 		terminalHelper:    terminalHelper,
 		listenerFactory:   listenerFactory,
 		keepAliveInterval: keepAliveInterval,
-		localListeners:    []net.Listener{},
+		listeners:         []net.Listener{},
 	}
 }
 
@@ -151,7 +160,7 @@ func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint s
 }
 
 func (c *SecureShell) Close() error {
-	for _, listener := range c.localListeners {
+	for _, listener := range c.listeners {
 		listener.Close()
 	}
 	return c.secureClient.Close()
@@ -163,15 +172,56 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 		if err != nil {
 			return err
 		}
-		c.localListeners = append(c.localListeners, listener)
+		c.listeners = append(c.listeners, listener)
 
-		go c.localForwardAcceptLoop(listener, spec.RemoteAddress)
+		remoteAddress := spec.RemoteAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			forwardConnection(conn, c.secureClient.Dial, remoteAddress)
+		})
 	}
 
 	return nil
 }
 
-func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+// RemotePortForward requests that the app instance listens on each spec's
+// remote address, and forwards the connections it accepts to the spec's local
+// address.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return fmt.Errorf("remote port forwarding on %s failed: %s", spec.RemoteAddress, err.Error())
+		}
+		c.listeners = append(c.listeners, listener)
+
+		localAddress := spec.LocalAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			forwardConnection(conn, net.Dial, localAddress)
+		})
+	}
+
+	return nil
+}
+
+// DynamicPortForward starts a SOCKS5 proxy on each local address that
+// connects to the requested addresses from the app instance.
+func (c *SecureShell) DynamicPortForward(localAddresses []string) error {
+	for _, address := range localAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
+
+		go acceptLoop(listener, c.handleSOCKSConnection)
+	}
+
+	return nil
+}
+
+// acceptLoop handles each connection accepted by the listener until it is
+// closed.
+func acceptLoop(listener net.Listener, handle func(conn net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -184,14 +234,37 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
+	}
+}
+
+func (c *SecureShell) handleSOCKSConnection(conn net.Conn) {
+	targetAddr, err := SOCKS5Handshake(conn)
+	if err != nil {
+		conn.Close()
+		return
 	}
+
+	forwardConnection(conn, func(network string, address string) (net.Conn, error) {
+		target, dialErr := c.secureClient.Dial(network, address)
+		if dialErr != nil {
+			_ = SOCKS5Reply(conn, SOCKS5ReplyHostUnreachable)
+			return nil, dialErr
+		}
+		if replyErr := SOCKS5Reply(conn, SOCKS5ReplySucceeded); replyErr != nil {
+			target.Close()
+			return nil, replyErr
+		}
+		return target, nil
+	}, targetAddr)
 }
 
-func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+// forwardConnection dials the target address and copies data in both
+// directions until either side closes its connection.
+func forwardConnection(conn net.Conn, dial func(network string, address string) (net.Conn, error), targetAddr string) {
 	defer conn.Close()
 
-	target, err := c.secureClient.Dial("tcp", targetAddr)
+	target, err := dial("tcp", targetAddr)
 	if err != nil {
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
@@ -318,6 +391,57 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// RunCommand runs the commands without a terminal, copying their output to
+// stdout and stderr, and returns their exit status. An error is only returned
+// when the commands could not be run or did not report an exit status.
+func (c *SecureShell) RunCommand(commands []string, stdout io.Writer, stderr io.Writer) (int, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return 0, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return 0, err
+	}
+
+	err = session.Start(strings.Join(commands, " "))
+	if err != nil {
+		return 0, err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+
+	if exitErr, ok := result.(exitStatusError); ok {
+		return exitErr.ExitStatus(), nil
+	}
+	return 0, result
+}
+
+// exitStatusError is implemented by *ssh.ExitError.
+type exitStatusError interface {
+	error
+	ExitStatus() int
+}
+
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
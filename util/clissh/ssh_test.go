@@ -7,11 +7,13 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -50,6 +52,29 @@ func BlockAcceptOnClose(fake *fake_net.FakeListener) {
 	}
 }
 
+func serveEcho(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			_, _ = io.Copy(conn, conn)
+			_ = conn.Close()
+		}()
+	}
+}
+
+func expectEcho(conn net.Conn, msg string) {
+	_, err := conn.Write([]byte(msg))
+	Expect(err).NotTo(HaveOccurred())
+
+	response := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, response)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(response)).To(Equal(msg))
+}
+
 var _ = Describe("CLI SSH", func() {
 	var (
 		fakeSecureDialer    *clisshfakes.FakeSecureDialer
@@ -178,6 +203,7 @@ var _ = Describe("CLI SSH", func() {
 	Describe("InteractiveSession", func() {
 		var (
 			stdin          *fake_io.FakeReadCloser
+			stdinDone      chan struct{}
 			stdout, stderr *fake_io.FakeWriter
 
 			sessionErr                error
@@ -185,7 +211,14 @@ var _ = Describe("CLI SSH", func() {
 		)
 
 		BeforeEach(func() {
+			// Reading stdin blocks until the test is done, so that the copy to
+			// the session does not keep recording empty reads after it.
+			stdinDone = make(chan struct{})
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadStub = func([]byte) (int, error) {
+				<-stdinDone
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -195,6 +228,10 @@ var _ = Describe("CLI SSH", func() {
 			}
 		})
 
+		AfterEach(func() {
+			close(stdinDone)
+		})
+
 		JustBeforeEach(func() {
 			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
 			Expect(connectErr).NotTo(HaveOccurred())
@@ -1149,6 +1186,269 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+			forwardSpecs   []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:5005",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the remote address in the app instance", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:5005"))
+		})
+
+		It("copies data between the remote connections and the local address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			expectEcho(conn, "Hello from the app instance\n")
+		})
+
+		It("closes the remote listener when the client is closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+
+			_, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when listening in the app instance fails", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("remote port forwarding on localhost:5005 failed: tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener  net.Listener
+			localListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]string{"localhost:1080"})
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		socksConnect := func(request []byte) (net.Conn, []byte) {
+			conn, err := net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+			methodReply := make([]byte, 2)
+			_, err = io.ReadFull(conn, methodReply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(methodReply).To(Equal([]byte{0x05, 0x00}))
+
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			return conn, reply
+		}
+
+		echoPort := func() []byte {
+			port := echoListener.Addr().(*net.TCPAddr).Port
+			return []byte{byte(port >> 8), byte(port)}
+		}
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("connects to IPv4 addresses through the app instance", func() {
+			conn, reply := socksConnect(append([]byte{0x05, 0x01, 0x00, 0x01, 127, 0, 0, 1}, echoPort()...))
+			defer conn.Close()
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x00}))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			expectEcho(conn, "Hello through the proxy\n")
+		})
+
+		It("connects to domain names through the app instance", func() {
+			request := append([]byte{0x05, 0x01, 0x00, 0x03, byte(len("localhost"))}, "localhost"...)
+			conn, reply := socksConnect(append(request, echoPort()...))
+			defer conn.Close()
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x00}))
+
+			_, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(addr).To(Equal(fmt.Sprintf("localhost:%d", echoListener.Addr().(*net.TCPAddr).Port)))
+		})
+
+		It("rejects commands other than CONNECT", func() {
+			conn, reply := socksConnect(append([]byte{0x05, 0x02, 0x00, 0x01, 127, 0, 0, 1}, echoPort()...))
+			defer conn.Close()
+			Expect(reply[:2]).To(Equal([]byte{0x05, 0x07}))
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+		})
+
+		Context("when dialing through the app instance fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("connect failed"))
+			})
+
+			It("replies that the host is unreachable", func() {
+				conn, reply := socksConnect(append([]byte{0x05, 0x01, 0x00, 0x01, 10, 0, 0, 1}, echoPort()...))
+				defer conn.Close()
+				Expect(reply[:2]).To(Equal([]byte{0x05, 0x04}))
+			})
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				localListener.Close()
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
+	Describe("RunCommand", func() {
+		var (
+			stdout, stderr *bytes.Buffer
+			exitStatus     int
+			runErr         error
+		)
+
+		BeforeEach(func() {
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+			commands = []string{"some-command", "some-args"}
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some-stdout"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("some-stderr"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			exitStatus, runErr = secureShell.RunCommand(commands, stdout, stderr)
+		})
+
+		It("starts the command without requesting a pty and copies its output", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(exitStatus).To(Equal(0))
+
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("some-command some-args"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+
+			Expect(stdout.String()).To(Equal("some-stdout"))
+			Expect(stderr.String()).To(Equal("some-stderr"))
+		})
+
+		Context("when the command exits non-zero", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(fakeExitError{status: 3})
+			})
+
+			It("returns the exit status", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Expect(exitStatus).To(Equal(3))
+			})
+		})
+
+		Context("when the session ends without an exit status", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("some-wait-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("some-wait-error"))
+			})
+		})
+
+		Context("when starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("some-start-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("some-start-error"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
@@ -1217,3 +1517,15 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 })
+
+type fakeExitError struct {
+	status int
+}
+
+func (e fakeExitError) Error() string {
+	return "some-exit-error"
+}
+
+func (e fakeExitError) ExitStatus() int {
+	return e.status
+}
@@ -0,0 +1,11 @@
+package ssherror
+
+// ChecksumCommandNotFoundError is returned when the app container does not
+// have the command used to verify the checksum of copied files.
+type ChecksumCommandNotFoundError struct {
+	Command string
+}
+
+func (e ChecksumCommandNotFoundError) Error() string {
+	return e.Command + " not found in the app container"
+}
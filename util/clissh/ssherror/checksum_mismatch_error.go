@@ -0,0 +1,11 @@
+package ssherror
+
+// ChecksumMismatchError is returned when a copied file's SHA-256 checksum
+// does not match the checksum of the file it was copied from.
+type ChecksumMismatchError struct {
+	Path string
+}
+
+func (e ChecksumMismatchError) Error() string {
+	return "checksum mismatch for " + e.Path
+}
@@ -0,0 +1,11 @@
+package ssherror
+
+// RecursiveCopyRequiredError is returned when a directory is copied without
+// requesting a recursive copy.
+type RecursiveCopyRequiredError struct {
+	Path string
+}
+
+func (e RecursiveCopyRequiredError) Error() string {
+	return e.Path + " is a directory"
+}
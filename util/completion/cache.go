@@ -0,0 +1,93 @@
+// Package completion caches the names used for shell completion so that
+// repeated completions do not each wait on the API.
+package completion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cache stores lists of names in a directory for a short time. Each list is
+// kept in its own file, named after the hash of its key.
+type Cache struct {
+	dir string
+	ttl time.Duration
+}
+
+type cacheEntry struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Names     []string  `json:"names"`
+}
+
+// NewCache returns a Cache that keeps names in dir for ttl.
+func NewCache(dir string, ttl time.Duration) *Cache {
+	return &Cache{
+		dir: dir,
+		ttl: ttl,
+	}
+}
+
+// Get returns the names stored under key. It returns false when there are no
+// names stored or they have expired.
+func (cache *Cache) Get(key string) ([]string, bool) {
+	raw, err := ioutil.ReadFile(cache.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, false
+	}
+
+	if !time.Now().Before(entry.ExpiresAt) {
+		return nil, false
+	}
+	return entry.Names, true
+}
+
+// Set stores the names under key. The names may include resources the user
+// should only see while logged in, so the files are only readable by the
+// user.
+func (cache *Cache) Set(key string, names []string) error {
+	err := os.MkdirAll(cache.dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(cacheEntry{
+		ExpiresAt: time.Now().Add(cache.ttl),
+		Names:     names,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent completions never
+	// read a partially written file.
+	tempFile, err := ioutil.TempFile(cache.dir, "names")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(raw)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), cache.path(key))
+}
+
+func (cache *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(cache.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,92 @@
+package completion_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/completion"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		tmpDir string
+		dir    string
+		cache  *Cache
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "completion-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		dir = filepath.Join(tmpDir, ".cf", "completion")
+		cache = NewCache(dir, time.Minute)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("returns the names that were set under the key", func() {
+		Expect(cache.Set("some-key", []string{"name-1", "name-2"})).To(Succeed())
+		Expect(cache.Set("other-key", []string{"name-3"})).To(Succeed())
+
+		names, found := cache.Get("some-key")
+		Expect(found).To(BeTrue())
+		Expect(names).To(Equal([]string{"name-1", "name-2"}))
+
+		names, found = NewCache(dir, time.Minute).Get("other-key")
+		Expect(found).To(BeTrue())
+		Expect(names).To(Equal([]string{"name-3"}))
+	})
+
+	It("only lets the user read the cached names", func() {
+		if runtime.GOOS == "windows" {
+			Skip("file permissions are not checked on Windows")
+		}
+
+		Expect(cache.Set("some-key", []string{"name-1"})).To(Succeed())
+
+		files, err := ioutil.ReadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	Context("when nothing is cached under the key", func() {
+		It("returns false", func() {
+			_, found := cache.Get("some-key")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the names have expired", func() {
+		BeforeEach(func() {
+			cache = NewCache(dir, 0)
+		})
+
+		It("returns false", func() {
+			Expect(cache.Set("some-key", []string{"name-1"})).To(Succeed())
+
+			_, found := cache.Get("some-key")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the cache file is corrupt", func() {
+		It("returns false", func() {
+			Expect(cache.Set("some-key", []string{"name-1"})).To(Succeed())
+			files, err := ioutil.ReadDir(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.WriteFile(filepath.Join(dir, files[0].Name()), []byte("not json"), 0600)).To(Succeed())
+
+			_, found := cache.Get("some-key")
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -4,6 +4,7 @@ package configv3
 import (
 	"path/filepath"
 	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/version"
 )
@@ -24,6 +25,22 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// credentials stores the secrets that are kept out of the config file.
+	credentials CredentialStore
+
+	// storedCredentials are the secrets last read from or written to the
+	// credential store.
+	storedCredentials map[string]Credentials
+
+	// credentialsOnce loads the secrets from the credential store when they
+	// are first needed. It is nil when the config was not loaded from disk.
+	credentialsOnce *sync.Once
+
+	// credentialsLoaded is true once the secrets have been loaded, and
+	// credentialsUnreadable when the credential store could not be read.
+	credentialsLoaded     bool
+	credentialsUnreadable bool
 }
 
 // BinaryVersion is the current version of the CF binary.
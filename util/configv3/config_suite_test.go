@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"code.cloudfoundry.org/cli/integration/helpers"
+	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -27,6 +28,9 @@ func setup() string {
 	Expect(err).NotTo(HaveOccurred())
 	err = os.Setenv("CF_HOME", homeDir)
 	Expect(err).NotTo(HaveOccurred())
+	// Keep the tests out of the OS keychain of the machine running them.
+	err = os.Setenv("CF_CREDENTIAL_HELPER", configv3.FileCredentialHelper)
+	Expect(err).NotTo(HaveOccurred())
 	return homeDir
 }
 
@@ -36,6 +40,8 @@ func teardown(homeDir string) {
 		Expect(err).ToNot(HaveOccurred())
 		err = os.Unsetenv("CF_HOME")
 		Expect(err).ToNot(HaveOccurred())
+		err = os.Unsetenv("CF_CREDENTIAL_HELPER")
+		Expect(err).ToNot(HaveOccurred())
 	}
 }
 
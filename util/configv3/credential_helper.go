@@ -0,0 +1,117 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// credentialHelperNotFound is the output of a credential helper that has no
+// secret for the key.
+const credentialHelperNotFound = "credentials not found"
+
+// CredentialHelperUnavailableError is returned when the credential helper
+// executable cannot be run, e.g. because it is not installed.
+type CredentialHelperUnavailableError struct {
+	Executable string
+	Err        error
+}
+
+func (e CredentialHelperUnavailableError) Error() string {
+	return fmt.Sprintf("credential helper %s cannot be run: %s", e.Executable, e.Err)
+}
+
+// CredentialHelper stores secrets with an external credential helper
+// executable, e.g. one backed by the OS keychain. The helper is named
+// cf-credential-<Name> and must be on the PATH, unless Name is a path to the
+// executable.
+//
+// The helper speaks the docker credential helper protocol, so existing
+// docker-credential-* helpers can be used. It is run with one of the
+// following actions as its only argument:
+//   - get: reads the key from stdin and writes {"ServerURL", "Username",
+//     "Secret"} as JSON to stdout, or fails with "credentials not found"
+//   - store: reads {"ServerURL", "Username", "Secret"} as JSON from stdin
+//   - erase: reads the key from stdin
+type CredentialHelper struct {
+	Name string
+}
+
+type credentialHelperPayload struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// Get returns the secret the helper has stored under key.
+func (helper CredentialHelper) Get(key string) (string, error) {
+	output, err := helper.run("get", strings.NewReader(key))
+	if err != nil {
+		if strings.Contains(string(output), credentialHelperNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	var payload credentialHelperPayload
+	err = json.Unmarshal(output, &payload)
+	if err != nil {
+		return "", err
+	}
+	return payload.Secret, nil
+}
+
+// Store has the helper store the secret under key.
+func (helper CredentialHelper) Store(key string, secret string) error {
+	payload, err := json.Marshal(credentialHelperPayload{
+		ServerURL: key,
+		Username:  "cf",
+		Secret:    secret,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = helper.run("store", bytes.NewReader(payload))
+	return err
+}
+
+// Erase has the helper remove the secret stored under key.
+func (helper CredentialHelper) Erase(key string) error {
+	output, err := helper.run("erase", strings.NewReader(key))
+	if err != nil && strings.Contains(string(output), credentialHelperNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (helper CredentialHelper) executable() string {
+	if strings.ContainsAny(helper.Name, `/\`) {
+		return helper.Name
+	}
+	return "cf-credential-" + helper.Name
+}
+
+// run runs the helper with the action. The helper's output is returned
+// whether it succeeds or not, since helpers report errors on stdout. When the
+// helper cannot be started a CredentialHelperUnavailableError is returned.
+func (helper CredentialHelper) run(action string, input io.Reader) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(helper.executable(), action)
+	cmd.Stdin = input
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if _, ok := err.(*exec.ExitError); err != nil && !ok {
+		return nil, CredentialHelperUnavailableError{Executable: helper.executable(), Err: err}
+	}
+	if err != nil {
+		message := strings.TrimSpace(stdout.String() + stderr.String())
+		return stdout.Bytes(), fmt.Errorf("credential helper %s %s: %s: %s", helper.executable(), action, err, message)
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,212 @@
+// +build !windows
+
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeHelperScript keeps each secret in a file named after the action's key,
+// in the same directory as the script.
+const fakeHelperScript = `#!/bin/sh
+dir=$(dirname "$0")
+case "$1" in
+get)
+	key=$(cat)
+	file="$dir/$(echo "$key" | tr '/:' '__')"
+	if [ ! -f "$file" ]; then
+		echo "credentials not found in native keychain"
+		exit 1
+	fi
+	printf '{"ServerURL":"%s","Username":"cf","Secret":%s}' "$key" "$(cat "$file")"
+	;;
+store)
+	payload=$(cat)
+	key=$(echo "$payload" | sed 's/.*"ServerURL":"\([^"]*\)".*/\1/')
+	echo "$payload" | sed 's/.*"Secret":\("\([^"\\]\|\\.\)*"\).*/\1/' > "$dir/$(echo "$key" | tr '/:' '__')"
+	;;
+erase)
+	rm -f "$dir/$(cat | tr '/:' '__')"
+	;;
+esac
+`
+
+var _ = Describe("CredentialHelper", func() {
+	var (
+		homeDir    string
+		helperDir  string
+		helperPath string
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+		helperPath = filepath.Join(helperDir, "cf-credential-fake")
+		Expect(ioutil.WriteFile(helperPath, []byte(fakeHelperScript), 0700)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	It("stores, gets and erases secrets with the helper", func() {
+		helper := CredentialHelper{Name: helperPath}
+
+		secret, err := helper.Get("cf:/some/path")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret).To(BeEmpty())
+
+		Expect(helper.Store("cf:/some/path", `{"some":"secret"}`)).To(Succeed())
+		Expect(helper.Get("cf:/some/path")).To(Equal(`{"some":"secret"}`))
+
+		Expect(helper.Erase("cf:/some/path")).To(Succeed())
+		Expect(helper.Get("cf:/some/path")).To(BeEmpty())
+	})
+
+	Context("when CF_CREDENTIAL_HELPER is set", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{"ConfigVersion": 3, "AccessToken": "some-access-token"}`)
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_CREDENTIAL_HELPER")).To(Succeed())
+		})
+
+		Context("when the helper is on the PATH", func() {
+			var oldPath string
+
+			BeforeEach(func() {
+				oldPath = os.Getenv("PATH")
+				Expect(os.Setenv("PATH", helperDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+				Expect(os.Setenv("CF_CREDENTIAL_HELPER", "fake")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+			})
+
+			It("keeps the credentials in the helper", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				Expect(filepath.Join(homeDir, ".cf", "credentials.json")).ToNot(BeAnExistingFile())
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("some-access-token"))
+			})
+
+			It("only runs the helper once the credentials are needed", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				invocationsPath := filepath.Join(helperDir, "invocations")
+				Expect(ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-counting"), []byte("#!/bin/sh\necho \"$1\" >> \""+invocationsPath+"\"\nexec \"$(dirname \"$0\")/cf-credential-fake\" \"$@\"\n"), 0700)).To(Succeed())
+				Expect(os.Setenv("CF_CREDENTIAL_HELPER", "counting")).To(Succeed())
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Target()).To(BeEmpty())
+				Expect(WriteConfig(config)).To(Succeed())
+				Expect(invocationsPath).ToNot(BeAnExistingFile())
+
+				Expect(config.AccessToken()).To(Equal("some-access-token"))
+				Expect(ioutil.ReadFile(invocationsPath)).To(Equal([]byte("get\n")))
+			})
+		})
+
+		Context("when the helper fails", func() {
+			var oldPath string
+
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-broken"), []byte("#!/bin/sh\n[ \"$1\" = get ] && echo 'credentials not found' && exit 1\necho 'keychain is locked'\nexit 1\n"), 0700)).To(Succeed())
+				oldPath = os.Getenv("PATH")
+				Expect(os.Setenv("PATH", helperDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+				Expect(os.Setenv("CF_CREDENTIAL_HELPER", "broken")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+			})
+
+			It("reports the error instead of using the encrypted credentials file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(WriteConfig(config)).To(MatchError(ContainSubstring("keychain is locked")))
+				Expect(filepath.Join(homeDir, ".cf", "credentials.json")).ToNot(BeAnExistingFile())
+
+			})
+
+			Context("when the credentials are read", func() {
+				var (
+					oldStderr *os.File
+					stderr    *os.File
+				)
+
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-broken"), []byte("#!/bin/sh\necho 'keychain is locked'\nexit 1\n"), 0700)).To(Succeed())
+
+					var err error
+					stderr, err = ioutil.TempFile(helperDir, "stderr")
+					Expect(err).ToNot(HaveOccurred())
+					oldStderr = os.Stderr
+					os.Stderr = stderr
+				})
+
+				AfterEach(func() {
+					os.Stderr = oldStderr
+					Expect(stderr.Close()).To(Succeed())
+				})
+
+				It("displays a warning and continues without them", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("some-access-token"))
+
+					setConfig(homeDir, `{"ConfigVersion": 3, "Target": "https://api.example.com"}`)
+					config, err = LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.AccessToken()).To(BeEmpty())
+					Expect(WriteConfig(config)).To(Succeed())
+
+					output, err := ioutil.ReadFile(stderr.Name())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(output)).To(ContainSubstring("Unable to read the stored credentials"))
+					Expect(string(output)).To(ContainSubstring("keychain is locked"))
+				})
+			})
+		})
+
+		Context("when the helper cannot be run", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CREDENTIAL_HELPER", "does-not-exist")).To(Succeed())
+			})
+
+			It("falls back to the encrypted credentials file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				Expect(filepath.Join(homeDir, ".cf", "credentials.json")).To(BeAnExistingFile())
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("some-access-token"))
+			})
+		})
+	})
+})
@@ -0,0 +1,305 @@
+package configv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+)
+
+// CredentialStore stores secrets outside of the config file. Get returns an
+// empty secret when nothing is stored under the key.
+type CredentialStore interface {
+	Get(key string) (string, error)
+	Store(key string, secret string) error
+	Erase(key string) error
+}
+
+// Credentials are the secrets of a profile. They are kept in the credential
+// store instead of the config file.
+type Credentials struct {
+	AccessToken          string `json:"AccessToken,omitempty"`
+	RefreshToken         string `json:"RefreshToken,omitempty"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret,omitempty"`
+}
+
+// FileCredentialHelper is the credential helper name that selects the
+// encrypted file store with its key kept next to it, for machines where the
+// OS keychain should not be used.
+const FileCredentialHelper = "file"
+
+// NewCredentialStore returns the credential store used for the config file in
+// configDir. When helper is set, the credentials are kept by the
+// cf-credential-<helper> executable, falling back to the encrypted file store
+// when the helper is not installed. Otherwise the encrypted file store is
+// used. The key of the encrypted file store is kept in the OS keychain when
+// there is one, unless helper is FileCredentialHelper. Should the keychain
+// fail, a warning is displayed and the key is kept next to the credentials.
+func NewCredentialStore(configDir string, helper string) CredentialStore {
+	fileStore := EncryptedFileCredentialStore{Dir: configDir}
+	if helper == FileCredentialHelper {
+		return fileStore
+	}
+
+	if keychainAvailable() {
+		var warnOnce sync.Once
+		fileStore.Keychain = Keychain{Account: filepath.Clean(configDir)}
+		fileStore.OnKeychainError = func(err error) {
+			warnOnce.Do(func() {
+				displayCredentialWarning("Unable to use the OS keychain, falling back to %s: %s",
+					filepath.Join(configDir, credentialKeyFileName), err)
+			})
+		}
+	}
+	if helper == "" {
+		return fileStore
+	}
+
+	return fallbackCredentialStore{
+		primary:  CredentialHelper{Name: helper},
+		fallback: fileStore,
+	}
+}
+
+// LoadCredentials returns the credentials of each profile of the config file
+// at configFilePath, keyed by profile name.
+func LoadCredentials(store CredentialStore, configFilePath string) (map[string]Credentials, error) {
+	credentials := map[string]Credentials{}
+
+	secret, err := store.Get(credentialKey(configFilePath))
+	if err != nil || secret == "" {
+		return credentials, err
+	}
+
+	err = json.Unmarshal([]byte(secret), &credentials)
+	return credentials, err
+}
+
+// SaveCredentials replaces the credentials of the config file at
+// configFilePath. Profiles without credentials are dropped.
+func SaveCredentials(store CredentialStore, configFilePath string, credentials map[string]Credentials) error {
+	nonEmpty := map[string]Credentials{}
+	for name, profileCredentials := range credentials {
+		if profileCredentials != (Credentials{}) {
+			nonEmpty[name] = profileCredentials
+		}
+	}
+
+	if len(nonEmpty) == 0 {
+		return store.Erase(credentialKey(configFilePath))
+	}
+
+	secret, err := json.Marshal(nonEmpty)
+	if err != nil {
+		return err
+	}
+	return store.Store(credentialKey(configFilePath), string(secret))
+}
+
+// credentialKey returns the key the credentials of the config file are stored
+// under. It includes the config file path so that each $CF_HOME has its own
+// credentials in a shared store, such as a keychain.
+func credentialKey(configFilePath string) string {
+	return "cf:" + filepath.Clean(configFilePath)
+}
+
+// credentialStore returns the config's credential store.
+func (config *Config) credentialStore() CredentialStore {
+	if config.credentials == nil {
+		config.credentials = NewCredentialStore(configDirectory(), config.ENV.CFCredentialHelper)
+	}
+	return config.credentials
+}
+
+// ensureCredentials fills in the secrets of every profile from the credential
+// store the first time they are needed, so that commands that do not use them
+// never access the store. A store that cannot be read is reported with a
+// warning and treated as empty. Configs that were not loaded from disk have
+// nothing to fill in.
+func (config *Config) ensureCredentials() {
+	if config.credentialsOnce == nil {
+		return
+	}
+
+	config.credentialsOnce.Do(func() {
+		err := config.loadCredentials()
+		if err != nil {
+			config.credentialsUnreadable = true
+			displayCredentialWarning("Unable to read the stored credentials: %s", err)
+		}
+		config.credentialsLoaded = true
+	})
+}
+
+// loadCredentials fills in the secrets of every profile from the credential
+// store. Secrets that are still in the config file, because it was written
+// before the credential store existed, take precedence and are moved to the
+// store the next time the config is written.
+func (config *Config) loadCredentials() error {
+	credentials, err := LoadCredentials(config.credentialStore(), ConfigFilePath())
+	if err != nil {
+		return err
+	}
+	config.storedCredentials = credentials
+
+	current := config.ConfigFile.profile()
+	current.setMissingCredentials(credentials[config.CurrentProfile()])
+	config.ConfigFile.setProfile(current)
+
+	for name, profile := range config.ConfigFile.Profiles {
+		profile.setMissingCredentials(credentials[name])
+		config.ConfigFile.Profiles[name] = profile
+	}
+
+	return nil
+}
+
+// storeCredentials moves the secrets of every profile out of jsonConfig and
+// into the credential store. The store is only written when the secrets have
+// changed, and is left alone when they were never loaded or could not be read
+// and none have been set since.
+func (config *Config) storeCredentials(jsonConfig JSONConfig) (JSONConfig, error) {
+	if config.credentialsOnce != nil && !config.credentialsLoaded {
+		return jsonConfig, nil
+	}
+
+	credentials := map[string]Credentials{
+		jsonConfig.currentProfileName(): jsonConfig.profile().credentials(),
+	}
+	jsonConfig.AccessToken = ""
+	jsonConfig.RefreshToken = ""
+	jsonConfig.UAAOAuthClientSecret = ""
+
+	profiles := make(map[string]Profile, len(jsonConfig.Profiles))
+	for name, profile := range jsonConfig.Profiles {
+		credentials[name] = profile.credentials()
+		profile.setCredentials(Credentials{})
+		profiles[name] = profile
+	}
+	if jsonConfig.Profiles != nil {
+		jsonConfig.Profiles = profiles
+	}
+
+	if reflect.DeepEqual(credentials, config.storedCredentials) {
+		return jsonConfig, nil
+	}
+	if config.credentialsUnreadable && !hasCredentials(credentials) {
+		return jsonConfig, nil
+	}
+
+	err := SaveCredentials(config.credentialStore(), ConfigFilePath(), credentials)
+	if err != nil {
+		return JSONConfig{}, err
+	}
+	config.storedCredentials = credentials
+	return jsonConfig, nil
+}
+
+// hasCredentials returns true when any profile has a secret.
+func hasCredentials(credentials map[string]Credentials) bool {
+	for _, profileCredentials := range credentials {
+		if profileCredentials != (Credentials{}) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCredentials returns true when any profile still has its secrets in the
+// config file.
+func (config JSONConfig) hasCredentials() bool {
+	if config.profile().credentials() != (Credentials{}) {
+		return true
+	}
+	for _, profile := range config.Profiles {
+		if profile.credentials() != (Credentials{}) {
+			return true
+		}
+	}
+	return false
+}
+
+// displayCredentialWarning writes a warning about the credential store to
+// stderr. The config has no UI to display it with.
+func displayCredentialWarning(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+// credentials returns the secrets of the profile.
+func (profile Profile) credentials() Credentials {
+	return Credentials{
+		AccessToken:          profile.AccessToken,
+		RefreshToken:         profile.RefreshToken,
+		UAAOAuthClientSecret: profile.UAAOAuthClientSecret,
+	}
+}
+
+// setCredentials replaces the secrets of the profile.
+func (profile *Profile) setCredentials(credentials Credentials) {
+	profile.AccessToken = credentials.AccessToken
+	profile.RefreshToken = credentials.RefreshToken
+	profile.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+}
+
+// setMissingCredentials sets the secrets that the profile does not have.
+func (profile *Profile) setMissingCredentials(credentials Credentials) {
+	if profile.AccessToken == "" {
+		profile.AccessToken = credentials.AccessToken
+	}
+	if profile.RefreshToken == "" {
+		profile.RefreshToken = credentials.RefreshToken
+	}
+	if profile.UAAOAuthClientSecret == "" {
+		profile.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	}
+}
+
+// fallbackCredentialStore uses the fallback store when the primary store is
+// not installed. Any other error of the primary store is returned, so that a
+// broken keychain is reported rather than hidden. The fallback store is also
+// read when the primary store does not have the secret, so that secrets stored
+// before the primary store was installed are still found.
+type fallbackCredentialStore struct {
+	primary  CredentialStore
+	fallback CredentialStore
+}
+
+func (store fallbackCredentialStore) Get(key string) (string, error) {
+	secret, err := store.primary.Get(key)
+	if err != nil && !isCredentialStoreUnavailable(err) {
+		return "", err
+	}
+	if err != nil || secret == "" {
+		return store.fallback.Get(key)
+	}
+	return secret, nil
+}
+
+func (store fallbackCredentialStore) Store(key string, secret string) error {
+	err := store.primary.Store(key, secret)
+	if isCredentialStoreUnavailable(err) {
+		return store.fallback.Store(key, secret)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Once the primary store has the secret, a copy left in the fallback store
+	// would only go stale.
+	return store.fallback.Erase(key)
+}
+
+func (store fallbackCredentialStore) Erase(key string) error {
+	err := store.primary.Erase(key)
+	if err != nil && !isCredentialStoreUnavailable(err) {
+		return err
+	}
+	return store.fallback.Erase(key)
+}
+
+func isCredentialStoreUnavailable(err error) bool {
+	_, ok := err.(CredentialHelperUnavailableError)
+	return ok
+}
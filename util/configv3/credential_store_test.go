@@ -0,0 +1,245 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential Store", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readConfigFile := func() JSONConfig {
+		file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var configFile JSONConfig
+		Expect(json.Unmarshal(file, &configFile)).To(Succeed())
+		return configFile
+	}
+
+	Context("when the config file has plain text credentials", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"ConfigVersion": 3,
+				"Target": "https://api.example.com",
+				"AccessToken": "some-access-token",
+				"RefreshToken": "some-refresh-token",
+				"UAAOAuthClient": "some-client",
+				"UAAOAuthClientSecret": "some-client-secret",
+				"Profiles": {
+					"staging": {
+						"Target": "https://api.staging.com",
+						"AccessToken": "staging-token"
+					}
+				}
+			}`
+			setConfig(homeDir, rawConfig)
+		})
+
+		It("moves them to the encrypted credentials file when the config is written", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("some-access-token"))
+			Expect(WriteConfig(config)).To(Succeed())
+
+			configFile := readConfigFile()
+			Expect(configFile.Target).To(Equal("https://api.example.com"))
+			Expect(configFile.UAAOAuthClient).To(Equal("some-client"))
+			Expect(configFile.AccessToken).To(BeEmpty())
+			Expect(configFile.RefreshToken).To(BeEmpty())
+			Expect(configFile.UAAOAuthClientSecret).To(BeEmpty())
+			Expect(configFile.Profiles["staging"].AccessToken).To(BeEmpty())
+
+			credentialsFile, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "credentials.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(credentialsFile)).ToNot(ContainSubstring("token"))
+			Expect(string(credentialsFile)).ToNot(ContainSubstring("secret"))
+
+			keyInfo, err := os.Stat(filepath.Join(homeDir, ".cf", "credentials.key"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keyInfo.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("some-access-token"))
+			Expect(config.RefreshToken()).To(Equal("some-refresh-token"))
+			Expect(config.UAAOAuthClientSecret()).To(Equal("some-client-secret"))
+			Expect(config.Profiles()[1].AccessToken).To(Equal("staging-token"))
+		})
+
+		Context("when the credentials are removed", func() {
+			It("removes the credentials file", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config.UnsetUserInformation()
+				config.DeleteProfile("staging")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				Expect(filepath.Join(homeDir, ".cf", "credentials.json")).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
+	Context("when the config file has no credentials", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{"ConfigVersion": 3, "Target": "https://api.example.com"}`)
+		})
+
+		It("does not create a credentials file", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(WriteConfig(config)).To(Succeed())
+
+			Expect(filepath.Join(homeDir, ".cf", "credentials.json")).ToNot(BeAnExistingFile())
+			Expect(filepath.Join(homeDir, ".cf", "credentials.key")).ToNot(BeAnExistingFile())
+		})
+	})
+
+	Describe("EncryptedFileCredentialStore", func() {
+		var store EncryptedFileCredentialStore
+
+		BeforeEach(func() {
+			store = EncryptedFileCredentialStore{Dir: filepath.Join(homeDir, "store")}
+		})
+
+		It("stores, gets and erases secrets", func() {
+			secret, err := store.Get("some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret).To(BeEmpty())
+
+			Expect(store.Store("some-key", "some-secret")).To(Succeed())
+			Expect(store.Store("other-key", "other-secret")).To(Succeed())
+			Expect(store.Get("some-key")).To(Equal("some-secret"))
+			Expect(store.Get("other-key")).To(Equal("other-secret"))
+
+			Expect(store.Erase("some-key")).To(Succeed())
+			Expect(store.Get("some-key")).To(BeEmpty())
+			Expect(store.Get("other-key")).To(Equal("other-secret"))
+		})
+
+		It("keeps the key next to the credentials", func() {
+			Expect(store.Store("some-key", "some-secret")).To(Succeed())
+			Expect(filepath.Join(homeDir, "store", "credentials.key")).To(BeAnExistingFile())
+		})
+
+		Context("when the key file has been replaced", func() {
+			It("returns an error", func() {
+				Expect(store.Store("some-key", "some-secret")).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(homeDir, "store", "credentials.key"), make([]byte, 32), 0600)).To(Succeed())
+
+				_, err := store.Get("some-key")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("EncryptedFileCredentialStore with a keychain", func() {
+		var (
+			keychain *fakeKeyStore
+			store    EncryptedFileCredentialStore
+		)
+
+		BeforeEach(func() {
+			keychain = new(fakeKeyStore)
+			store = EncryptedFileCredentialStore{Dir: filepath.Join(homeDir, "store"), Keychain: keychain}
+		})
+
+		It("keeps the key in the keychain", func() {
+			Expect(store.Store("some-key", "some-secret")).To(Succeed())
+			Expect(keychain.key).To(HaveLen(32))
+			Expect(filepath.Join(homeDir, "store", "credentials.key")).ToNot(BeAnExistingFile())
+
+			Expect(store.Get("some-key")).To(Equal("some-secret"))
+		})
+
+		Context("when the key is not in the keychain", func() {
+			It("cannot decrypt the credentials", func() {
+				Expect(store.Store("some-key", "some-secret")).To(Succeed())
+				keychain.key = nil
+
+				_, err := store.Get("some-key")
+				Expect(err).To(MatchError("credentials key not found"))
+			})
+		})
+
+		Context("when the key was kept next to the credentials", func() {
+			BeforeEach(func() {
+				fileStore := EncryptedFileCredentialStore{Dir: store.Dir}
+				Expect(fileStore.Store("some-key", "some-secret")).To(Succeed())
+			})
+
+			It("moves the key to the keychain", func() {
+				Expect(store.Get("some-key")).To(Equal("some-secret"))
+				Expect(keychain.key).To(HaveLen(32))
+				Expect(filepath.Join(homeDir, "store", "credentials.key")).ToNot(BeAnExistingFile())
+
+				Expect(store.Get("some-key")).To(Equal("some-secret"))
+			})
+		})
+
+		Context("when the keychain fails", func() {
+			var keychainErrs []error
+
+			BeforeEach(func() {
+				keychainErrs = nil
+				store.OnKeychainError = func(err error) {
+					keychainErrs = append(keychainErrs, err)
+				}
+				keychain.err = errors.New("keychain is locked")
+			})
+
+			It("keeps the key next to the credentials and reports the error", func() {
+				Expect(store.Store("some-key", "some-secret")).To(Succeed())
+				Expect(filepath.Join(homeDir, "store", "credentials.key")).To(BeAnExistingFile())
+				Expect(store.Get("some-key")).To(Equal("some-secret"))
+
+				Expect(keychainErrs).ToNot(BeEmpty())
+				Expect(keychainErrs[0]).To(MatchError("keychain is locked"))
+			})
+
+			It("moves the key to the keychain once it works again", func() {
+				Expect(store.Store("some-key", "some-secret")).To(Succeed())
+				keychain.err = nil
+
+				Expect(store.Get("some-key")).To(Equal("some-secret"))
+				Expect(keychain.key).To(HaveLen(32))
+				Expect(filepath.Join(homeDir, "store", "credentials.key")).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+})
+
+type fakeKeyStore struct {
+	key []byte
+	err error
+}
+
+func (keyStore *fakeKeyStore) Key() ([]byte, error) {
+	return keyStore.key, keyStore.err
+}
+
+func (keyStore *fakeKeyStore) SetKey(key []byte) error {
+	if keyStore.err != nil {
+		return keyStore.err
+	}
+	keyStore.key = key
+	return nil
+}
@@ -16,6 +16,11 @@ const (
 	// Developer Note: Due to bugs in using MaxInt64 during comparison, the above
 	// was chosen as a replacement.
 
+	// DefaultPaginationConcurrency is the default number of pages of a list
+	// request that are requested in parallel. By default pages are requested
+	// sequentially.
+	DefaultPaginationConcurrency = 1
+
 	// DefaultPollingInterval is the time between consecutive polls of a status.
 	DefaultPollingInterval = 3 * time.Second
 
@@ -25,6 +30,10 @@ const (
 	// DefaultStartupTimeout is the default timeout for application starting.
 	DefaultStartupTimeout = 5 * time.Minute
 
+	// DefaultTraceMaxSize is the default size in bytes at which a trace log
+	// file is rotated.
+	DefaultTraceMaxSize = 10 * 1024 * 1024
+
 	// DefaultTarget is the default CFConfig value for Target.
 	DefaultTarget = ""
 
@@ -47,6 +56,14 @@ const (
 
 	// DefaultRetryCount is the default number of request retries.
 	DefaultRetryCount = 2
+
+	// DefaultRetryBackoff is the default delay before the first request
+	// retry. The delay doubles with every following retry.
+	DefaultRetryBackoff = 500 * time.Millisecond
+
+	// DefaultRetryMaxBackoff is the default maximum delay between request
+	// retries.
+	DefaultRetryMaxBackoff = 10 * time.Second
 )
 
 // NOAARequestRetryCount returns the number of request retries.
@@ -0,0 +1,237 @@
+package configv3
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	credentialsFileName   = "credentials.json"
+	credentialKeyFileName = "credentials.key"
+)
+
+// KeyStore keeps the key that encrypts the credentials file.
+type KeyStore interface {
+	// Key returns the stored key, or nil when there is none.
+	Key() ([]byte, error)
+	SetKey(key []byte) error
+}
+
+// EncryptedFileCredentialStore stores secrets in Dir/credentials.json,
+// encrypted with AES-GCM. The key is generated on first use and kept in the
+// Keychain, so that the secrets cannot be read with the contents of Dir alone.
+//
+// Without a Keychain, e.g. on headless CI workers, the key is kept in
+// Dir/credentials.key, readable only by the user. As the key sits next to the
+// secrets, this only obfuscates them: anyone who can read Dir can decrypt
+// them. It still keeps the secrets out of the config file should that be
+// shared or backed up.
+//
+// When the Keychain fails, the key file is used instead and the error is
+// passed to OnKeychainError. The key is moved to the Keychain once it works
+// again.
+type EncryptedFileCredentialStore struct {
+	Dir             string
+	Keychain        KeyStore
+	OnKeychainError func(error)
+}
+
+// Get decrypts the secret stored under key.
+func (store EncryptedFileCredentialStore) Get(key string) (string, error) {
+	secrets, err := store.readSecrets()
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, ok := secrets[key]
+	if !ok {
+		return "", nil
+	}
+
+	gcm, err := store.cipher(false)
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(raw) < gcm.NonceSize() {
+		return "", errors.New("credentials file is corrupt")
+	}
+
+	secret, err := gcm.Open(nil, raw[:gcm.NonceSize()], raw[gcm.NonceSize():], []byte(key))
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// Store encrypts the secret and stores it under key.
+func (store EncryptedFileCredentialStore) Store(key string, secret string) error {
+	secrets, err := store.readSecrets()
+	if err != nil {
+		return err
+	}
+
+	gcm, err := store.cipher(true)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	secrets[key] = base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), []byte(key)))
+	return store.writeSecrets(secrets)
+}
+
+// Erase removes the secret stored under key.
+func (store EncryptedFileCredentialStore) Erase(key string) error {
+	secrets, err := store.readSecrets()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := secrets[key]; !ok {
+		return nil
+	}
+
+	delete(secrets, key)
+	return store.writeSecrets(secrets)
+}
+
+// cipher returns the AES-GCM cipher for the store's key, generating the key
+// when create is true and there is none.
+func (store EncryptedFileCredentialStore) cipher(create bool) (cipher.AEAD, error) {
+	key, err := store.key()
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil {
+		if !create {
+			return nil, errors.New("credentials key not found")
+		}
+
+		key = make([]byte, 32)
+		_, err = rand.Read(key)
+		if err != nil {
+			return nil, err
+		}
+
+		err = store.setKey(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// key returns the store's key, or nil when there is none. A key file in Dir,
+// left from before the store had a Keychain or written while the Keychain was
+// failing, is moved to the Keychain.
+func (store EncryptedFileCredentialStore) key() ([]byte, error) {
+	keyPath := filepath.Join(store.Dir, credentialKeyFileName)
+	fileKey, err := ioutil.ReadFile(keyPath)
+	if os.IsNotExist(err) {
+		fileKey = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if store.Keychain == nil {
+		return fileKey, nil
+	}
+
+	if fileKey != nil {
+		err = store.Keychain.SetKey(fileKey)
+		if err != nil {
+			store.keychainFailed(err)
+			return fileKey, nil
+		}
+		return fileKey, os.Remove(keyPath)
+	}
+
+	key, err := store.Keychain.Key()
+	if err != nil {
+		store.keychainFailed(err)
+		return nil, nil
+	}
+	return key, nil
+}
+
+func (store EncryptedFileCredentialStore) setKey(key []byte) error {
+	if store.Keychain != nil {
+		err := store.Keychain.SetKey(key)
+		if err == nil {
+			return nil
+		}
+		store.keychainFailed(err)
+	}
+
+	err := os.MkdirAll(store.Dir, 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(store.Dir, credentialKeyFileName), key, 0600)
+}
+
+func (store EncryptedFileCredentialStore) keychainFailed(err error) {
+	if store.OnKeychainError != nil {
+		store.OnKeychainError(err)
+	}
+}
+
+func (store EncryptedFileCredentialStore) readSecrets() (map[string]string, error) {
+	secrets := map[string]string{}
+
+	file, err := ioutil.ReadFile(filepath.Join(store.Dir, credentialsFileName))
+	if os.IsNotExist(err) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(file, &secrets)
+	return secrets, err
+}
+
+func (store EncryptedFileCredentialStore) writeSecrets(secrets map[string]string) error {
+	path := filepath.Join(store.Dir, credentialsFileName)
+	if len(secrets) == 0 {
+		err := os.Remove(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	rawSecrets, err := json.MarshalIndent(secrets, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(store.Dir, 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, rawSecrets, 0600)
+}
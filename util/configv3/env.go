@@ -4,25 +4,35 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/util/backoff"
 )
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName              string
+	CFColor                 string
+	CFCredentialHelper      string
+	CFDialTimeout           string
+	CFHome                  string
+	CFLogLevel              string
+	CFPaginationConcurrency string
+	CFPluginHome            string
+	CFProfile               string
+	CFRetryBackoff          string
+	CFRetryMaxBackoff       string
+	CFStagingTimeout        string
+	CFStartupTimeout        string
+	CFTrace                 string
+	CFTraceFormat           string
+	CFTraceMaxSize          string
+	DockerPassword          string
+	Experimental            string
+	ForceTTY                string
+	HTTPSProxy              string
+	Lang                    string
+	LCAll                   string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -104,6 +114,43 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// PaginationConcurrency returns the maximum number of pages of a list request
+// that are requested in parallel. This is based off of:
+//   1. The $CF_PAGINATION_CONCURRENCY environment variable if set to a positive
+//      integer
+//   2. Defaults to DefaultPaginationConcurrency
+func (config *Config) PaginationConcurrency() int {
+	if config.ENV.CFPaginationConcurrency != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFPaginationConcurrency)
+		if err == nil && envVal > 0 {
+			return envVal
+		}
+	}
+
+	return DefaultPaginationConcurrency
+}
+
+// RequestRetryBackoff returns the delays between request retries. They are
+// based off of:
+//   1. The $CF_RETRY_BACKOFF and $CF_RETRY_MAX_BACKOFF environment variables
+//      if set to durations, e.g. "250ms" or "1m"
+//   2. Defaults to DefaultRetryBackoff and DefaultRetryMaxBackoff
+func (config *Config) RequestRetryBackoff() backoff.Backoff {
+	retryBackoff := backoff.Backoff{
+		Initial: DefaultRetryBackoff,
+		Max:     DefaultRetryMaxBackoff,
+	}
+
+	if envVal, err := time.ParseDuration(config.ENV.CFRetryBackoff); err == nil && envVal >= 0 {
+		retryBackoff.Initial = envVal
+	}
+	if envVal, err := time.ParseDuration(config.ENV.CFRetryMaxBackoff); err == nil && envVal >= 0 {
+		retryBackoff.Max = envVal
+	}
+
+	return retryBackoff
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
@@ -133,3 +180,19 @@ func (config *Config) StartupTimeout() time.Duration {
 
 	return DefaultStartupTimeout
 }
+
+// TraceMaxSize returns the size in bytes at which a trace log file is rotated.
+// The size is based off of:
+//   1. The $CF_TRACE_MAX_SIZE environment variable if set to a size with a
+//      unit (e.g. 512K, 10M)
+//   2. Defaults to DefaultTraceMaxSize
+func (config *Config) TraceMaxSize() int64 {
+	if config.ENV.CFTraceMaxSize != "" {
+		envVal, err := bytefmt.ToBytes(config.ENV.CFTraceMaxSize)
+		if err == nil && envVal > 0 {
+			return int64(envVal)
+		}
+	}
+
+	return DefaultTraceMaxSize
+}
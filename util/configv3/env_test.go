@@ -4,6 +4,7 @@ import (
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/cli/util/backoff"
 	. "code.cloudfoundry.org/cli/util/configv3"
 
 	. "github.com/onsi/ginkgo"
@@ -29,6 +30,7 @@ var _ = Describe("Config", func() {
 		BeforeEach(func() {
 			Expect(os.Setenv("CF_DIAL_TIMEOUT", "1234")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_DOCKER_PASSWORD", "banana")).ToNot(HaveOccurred())
+			Expect(os.Setenv("CF_PAGINATION_CONCURRENCY", "4")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_STAGING_TIMEOUT", "8675")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_STARTUP_TIMEOUT", "309")).ToNot(HaveOccurred())
 			Expect(os.Setenv("https_proxy", "proxy.com")).ToNot(HaveOccurred())
@@ -42,6 +44,7 @@ var _ = Describe("Config", func() {
 		AfterEach(func() {
 			Expect(os.Unsetenv("CF_DIAL_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_DOCKER_PASSWORD")).ToNot(HaveOccurred())
+			Expect(os.Unsetenv("CF_PAGINATION_CONCURRENCY")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_STAGING_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_STARTUP_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("https_proxy")).ToNot(HaveOccurred())
@@ -51,6 +54,7 @@ var _ = Describe("Config", func() {
 			Expect(config.DialTimeout()).To(Equal(1234 * time.Second))
 			Expect(config.DockerPassword()).To(Equal("banana"))
 			Expect(config.HTTPSProxy()).To(Equal("proxy.com"))
+			Expect(config.PaginationConcurrency()).To(Equal(4))
 			Expect(config.StagingTimeout()).To(Equal(time.Duration(8675) * time.Minute))
 			Expect(config.StartupTimeout()).To(Equal(time.Duration(309) * time.Minute))
 		})
@@ -112,4 +116,31 @@ var _ = Describe("Config", func() {
 		Entry("debug returns 5", "debug", 5),
 		Entry("dEbUg returns 5", "dEbUg", 5),
 	)
+
+	DescribeTable("RequestRetryBackoff",
+		func(initialEnvVal string, maxEnvVal string, expected backoff.Backoff) {
+			config := Config{ENV: EnvOverride{CFRetryBackoff: initialEnvVal, CFRetryMaxBackoff: maxEnvVal}}
+			Expect(config.RequestRetryBackoff()).To(Equal(expected))
+		},
+
+		Entry("defaults to DefaultRetryBackoff and DefaultRetryMaxBackoff", "", "",
+			backoff.Backoff{Initial: DefaultRetryBackoff, Max: DefaultRetryMaxBackoff}),
+		Entry("uses the environment values", "250ms", "1m",
+			backoff.Backoff{Initial: 250 * time.Millisecond, Max: time.Minute}),
+		Entry("defaults when the environment values are invalid", "banana", "-1s",
+			backoff.Backoff{Initial: DefaultRetryBackoff, Max: DefaultRetryMaxBackoff}),
+	)
+
+	DescribeTable("TraceMaxSize",
+		func(envVal string, expectedSize int64) {
+			config := Config{ENV: EnvOverride{CFTraceMaxSize: envVal}}
+			Expect(config.TraceMaxSize()).To(Equal(expectedSize))
+		},
+
+		Entry("defaults to DefaultTraceMaxSize", "", int64(DefaultTraceMaxSize)),
+		Entry("uses the environment value with a unit", "512K", int64(512*1024)),
+		Entry("uses the environment value in megabytes", "2M", int64(2*1024*1024)),
+		Entry("defaults when the environment value has no unit", "1024", int64(DefaultTraceMaxSize)),
+		Entry("defaults when the environment value is invalid", "banana", int64(DefaultTraceMaxSize)),
+	)
 })
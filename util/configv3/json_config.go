@@ -31,6 +31,8 @@ type JSONConfig struct {
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	CurrentProfile           string             `json:"CurrentProfile,omitempty"`
+	Profiles                 map[string]Profile `json:"Profiles,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -67,6 +69,7 @@ type User struct {
 
 // AccessToken returns the access token for making authenticated API calls.
 func (config *Config) AccessToken() string {
+	config.ensureCredentials()
 	return config.ConfigFile.AccessToken
 }
 
@@ -78,9 +81,20 @@ func (config *Config) APIVersion() string {
 // CurrentUser returns user information decoded from the JWT access token in
 // .cf/config.json.
 func (config *Config) CurrentUser() (User, error) {
+	config.ensureCredentials()
 	return decodeUserFromJWT(config.ConfigFile.AccessToken)
 }
 
+// CurrentUserName returns the name of the user decoded from the JWT access
+// token in .cf/config.json.
+func (config *Config) CurrentUserName() (string, error) {
+	user, err := config.CurrentUser()
+	if err != nil {
+		return "", err
+	}
+	return user.Name, nil
+}
+
 // HasTargetedOrganization returns true if the organization is set.
 func (config *Config) HasTargetedOrganization() bool {
 	return config.ConfigFile.TargetedOrganization.GUID != ""
@@ -109,11 +123,13 @@ func (config *Config) OverallPollingTimeout() time.Duration {
 
 // RefreshToken returns the refresh token for getting a new access token.
 func (config *Config) RefreshToken() string {
+	config.ensureCredentials()
 	return config.ConfigFile.RefreshToken
 }
 
 // SetAccessToken sets the current access token.
 func (config *Config) SetAccessToken(accessToken string) {
+	config.ensureCredentials()
 	config.ConfigFile.AccessToken = accessToken
 }
 
@@ -126,6 +142,7 @@ func (config *Config) SetOrganizationInformation(guid string, name string) {
 
 // SetRefreshToken sets the current refresh token.
 func (config *Config) SetRefreshToken(refreshToken string) {
+	config.ensureCredentials()
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
@@ -152,6 +169,7 @@ func (config *Config) SetTargetInformation(api string, apiVersion string, auth s
 
 // SetTokenInformation sets the current token/user information.
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
+	config.ensureCredentials()
 	config.ConfigFile.AccessToken = accessToken
 	config.ConfigFile.RefreshToken = refreshToken
 	config.ConfigFile.SSHOAuthClient = sshOAuthClient
@@ -159,6 +177,7 @@ func (config *Config) SetTokenInformation(accessToken string, refreshToken strin
 
 // SetUAAClientCredentials sets the client credentials.
 func (config *Config) SetUAAClientCredentials(client string, clientSecret string) {
+	config.ensureCredentials()
 	config.ConfigFile.UAAOAuthClient = client
 	config.ConfigFile.UAAOAuthClientSecret = clientSecret
 }
@@ -214,6 +233,7 @@ func (config *Config) UAAOAuthClient() string {
 
 // UAAOAuthClientSecret returns the CLI's UAA client secret.
 func (config *Config) UAAOAuthClientSecret() string {
+	config.ensureCredentials()
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
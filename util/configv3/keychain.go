@@ -0,0 +1,13 @@
+package configv3
+
+// keychainService is the service the keys of the credentials files are stored
+// under in the OS keychain.
+const keychainService = "Cloud Foundry CLI"
+
+// Keychain keeps the key of an encrypted credentials file in the OS keychain:
+// the login keychain on macOS, the Credential Manager on Windows and the
+// Secret Service (e.g. GNOME Keyring or KWallet) elsewhere. Account identifies
+// the key, so that each config directory has its own.
+type Keychain struct {
+	Account string
+}
@@ -0,0 +1,48 @@
+// +build !windows
+
+package configv3
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// keychainCommandError is returned when a keychain command line tool exits
+// with a non-zero status.
+type keychainCommandError struct {
+	Command    string
+	ExitStatus int
+	Message    string
+}
+
+func (e keychainCommandError) Error() string {
+	return fmt.Sprintf("%s failed with exit status %d: %s", e.Command, e.ExitStatus, e.Message)
+}
+
+// runKeychainCommand runs a keychain command line tool with input on its
+// stdin, so that secrets do not show up in the process list, and returns its
+// trimmed stdout.
+func runKeychainCommand(input string, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		status := -1
+		if waitStatus, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			status = waitStatus.ExitStatus()
+		}
+		return "", keychainCommandError{
+			Command:    name,
+			ExitStatus: status,
+			Message:    strings.TrimSpace(stderr.String()),
+		}
+	}
+	return strings.TrimSpace(stdout.String()), err
+}
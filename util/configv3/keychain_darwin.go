@@ -0,0 +1,60 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// securityItemNotFound is the exit status of security when the keychain has
+// no such item.
+const securityItemNotFound = 44
+
+func keychainAvailable() bool {
+	_, err := exec.LookPath("security")
+	return err == nil
+}
+
+// Key returns the key stored in the login keychain, or nil when there is
+// none.
+func (keychain Keychain) Key() ([]byte, error) {
+	output, err := runKeychainCommand("", "security", "find-generic-password", "-s", keychainService, "-a", keychain.Account, "-w")
+	if commandErr, ok := err.(keychainCommandError); ok && commandErr.ExitStatus == securityItemNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(output)
+}
+
+// SetKey stores the key in the login keychain. The key is passed to security
+// in interactive mode, on its stdin, which does not report failed commands in
+// its exit status, so the key is read back to confirm it was stored.
+func (keychain Keychain) SetKey(key []byte) error {
+	command := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+		quoteSecurityArgument(keychainService),
+		quoteSecurityArgument(keychain.Account),
+		hex.EncodeToString(key),
+	)
+	_, err := runKeychainCommand(command, "security", "-i")
+	if err != nil {
+		return err
+	}
+
+	storedKey, err := keychain.Key()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(storedKey, key) {
+		return errors.New("unable to store the credentials key in the login keychain")
+	}
+	return nil
+}
+
+func quoteSecurityArgument(argument string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(argument) + `"`
+}
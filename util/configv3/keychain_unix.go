@@ -0,0 +1,39 @@
+// +build !darwin,!windows
+
+package configv3
+
+import (
+	"encoding/hex"
+	"os"
+	"os/exec"
+)
+
+// keychainAvailable returns true when the Secret Service can be reached with
+// secret-tool, which needs a D-Bus session.
+func keychainAvailable() bool {
+	_, err := exec.LookPath("secret-tool")
+	return err == nil && os.Getenv("DBUS_SESSION_BUS_ADDRESS") != ""
+}
+
+// Key returns the key stored in the Secret Service, or nil when there is
+// none.
+func (keychain Keychain) Key() ([]byte, error) {
+	output, err := runKeychainCommand("", "secret-tool", "lookup", "service", keychainService, "account", keychain.Account)
+	if commandErr, ok := err.(keychainCommandError); ok && commandErr.ExitStatus == 1 && commandErr.Message == "" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(output)
+}
+
+// SetKey stores the key in the Secret Service.
+func (keychain Keychain) SetKey(key []byte) error {
+	_, err := runKeychainCommand(hex.EncodeToString(key), "secret-tool", "store",
+		"--label", keychainService+" credentials key",
+		"service", keychainService,
+		"account", keychain.Account,
+	)
+	return err
+}
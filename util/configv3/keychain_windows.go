@@ -0,0 +1,94 @@
+package configv3
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+
+	errorNotFound syscall.Errno = 1168
+)
+
+var (
+	advapi32       = syscall.NewLazyDLL("advapi32.dll")
+	procCredReadW  = advapi32.NewProc("CredReadW")
+	procCredWriteW = advapi32.NewProc("CredWriteW")
+	procCredFree   = advapi32.NewProc("CredFree")
+)
+
+// credential is the CREDENTIALW structure of the Credential Manager.
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+func keychainAvailable() bool {
+	return procCredReadW.Find() == nil
+}
+
+// Key returns the key stored in the Credential Manager, or nil when there is
+// none.
+func (keychain Keychain) Key() ([]byte, error) {
+	target, err := syscall.UTF16PtrFromString(keychain.targetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var cred *credential
+	ret, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if ret == 0 {
+		if err == errorNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	key := make([]byte, cred.CredentialBlobSize)
+	blob := (*[1 << 20]byte)(unsafe.Pointer(cred.CredentialBlob))
+	copy(key, blob[:cred.CredentialBlobSize:cred.CredentialBlobSize])
+	return key, nil
+}
+
+// SetKey stores the key in the Credential Manager.
+func (keychain Keychain) SetKey(key []byte) error {
+	target, err := syscall.UTF16PtrFromString(keychain.targetName())
+	if err != nil {
+		return err
+	}
+	userName, err := syscall.UTF16PtrFromString("cf")
+	if err != nil {
+		return err
+	}
+
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(key)),
+		CredentialBlob:     &key[0],
+		Persist:            credPersistLocalMachine,
+		UserName:           userName,
+	}
+	ret, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+func (keychain Keychain) targetName() string {
+	return keychainService + ":" + keychain.Account
+}
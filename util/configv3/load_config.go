@@ -6,6 +6,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"golang.org/x/crypto/ssh/terminal"
@@ -26,6 +27,13 @@ import (
 //   1. CF_HOME\.cf if CF_HOME is set
 //   2. HOMEDRIVE\HOMEPATH\.cf if HOMEDRIVE or HOMEPATH is set
 //   3. USERPROFILE\.cf as the default
+//
+// Access tokens, refresh tokens and client secrets are read from the
+// credential store; see NewCredentialStore.
+//
+// When $CF_PROFILE names a profile other than the config file's current
+// profile, the named profile is targeted for the life of the process without
+// changing the current profile on disk.
 func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	err := removeOldTempConfigFiles()
 	if err != nil {
@@ -78,20 +86,33 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:              filepath.Base(os.Args[0]),
+		CFColor:                 os.Getenv("CF_COLOR"),
+		CFCredentialHelper:      os.Getenv("CF_CREDENTIAL_HELPER"),
+		CFDialTimeout:           os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:              os.Getenv("CF_LOG_LEVEL"),
+		CFPaginationConcurrency: os.Getenv("CF_PAGINATION_CONCURRENCY"),
+		CFPluginHome:            os.Getenv("CF_PLUGIN_HOME"),
+		CFProfile:               os.Getenv("CF_PROFILE"),
+		CFRetryBackoff:          os.Getenv("CF_RETRY_BACKOFF"),
+		CFRetryMaxBackoff:       os.Getenv("CF_RETRY_MAX_BACKOFF"),
+		CFStagingTimeout:        os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:        os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:                 os.Getenv("CF_TRACE"),
+		CFTraceFormat:           os.Getenv("CF_TRACE_FORMAT"),
+		CFTraceMaxSize:          os.Getenv("CF_TRACE_MAX_SIZE"),
+		DockerPassword:          os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:            os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:                os.Getenv("FORCE_TTY"),
+		HTTPSProxy:              os.Getenv("https_proxy"),
+		Lang:                    os.Getenv("LANG"),
+		LCAll:                   os.Getenv("LC_ALL"),
+	}
+
+	config.credentialsOnce = new(sync.Once)
+
+	if config.profileOverridden() {
+		config.ConfigFile.switchProfile(config.ConfigFile.currentProfileName(), config.ENV.CFProfile)
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -0,0 +1,34 @@
+package configv3
+
+import "strings"
+
+const (
+	// OutputFormatDefault means that human readable text and tables will be
+	// displayed.
+	OutputFormatDefault OutputFormat = ""
+
+	// OutputFormatJSON means that commands supporting structured output will
+	// display a JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML means that commands supporting structured output will
+	// display a YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat represents the format in which command output is displayed.
+type OutputFormat string
+
+// OutputFormat returns the output format based off of:
+//   1. The '--output' global flag
+//   2. Defaults to OutputFormatDefault
+func (config *Config) OutputFormat() OutputFormat {
+	switch OutputFormat(strings.ToLower(config.Flags.OutputFormat)) {
+	case OutputFormatJSON:
+		return OutputFormatJSON
+	case OutputFormatYAML:
+		return OutputFormatYAML
+	default:
+		return OutputFormatDefault
+	}
+}
@@ -17,13 +17,17 @@ const (
 type PluginRepository struct {
 	Name string `json:"Name"`
 	URL  string `json:"URL"`
+
+	// PublicKey is the minisign or Ed25519 public key that the repository's
+	// plugin binaries are signed with.
+	PublicKey string `json:"PublicKey,omitempty"`
 }
 
 // AddPluginRepository adds an new repository to the plugin config. It does not
 // add duplicates to the config.
-func (config *Config) AddPluginRepository(name string, url string) {
+func (config *Config) AddPluginRepository(name string, url string, publicKey string) {
 	config.ConfigFile.PluginRepositories = append(config.ConfigFile.PluginRepositories,
-		PluginRepository{Name: name, URL: url})
+		PluginRepository{Name: name, URL: url, PublicKey: publicKey})
 }
 
 // PluginRepositories returns the currently configured plugin repositories from the
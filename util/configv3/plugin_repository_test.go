@@ -40,8 +40,8 @@ var _ = Describe("PluginRepository", func() {
 				},
 			}
 
-			config.AddPluginRepository("some-repo", "some-URL")
-			Expect(config.PluginRepositories()).To(ContainElement(PluginRepository{Name: "some-repo", URL: "some-URL"}))
+			config.AddPluginRepository("some-repo", "some-URL", "some-public-key")
+			Expect(config.PluginRepositories()).To(ContainElement(PluginRepository{Name: "some-repo", URL: "some-URL", PublicKey: "some-public-key"}))
 		})
 	})
 })
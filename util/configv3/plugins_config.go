@@ -66,6 +66,8 @@ type PluginCommand struct {
 	Alias        string             `json:"Alias"`
 	HelpText     string             `json:"HelpText"`
 	UsageDetails PluginUsageDetails `json:"UsageDetails"`
+	Flags        []PluginFlag       `json:"Flags,omitempty"`
+	Arguments    []PluginArgument   `json:"Arguments,omitempty"`
 }
 
 // CommandName returns the name of the plugin. The name is concatenated with
@@ -83,6 +85,32 @@ type PluginUsageDetails struct {
 	Options map[string]string `json:"Options"`
 }
 
+// PluginFlag is a flag declared by the plugin for shell completion. Type is
+// one of "bool", "string" or "int"; Completion names the source of the
+// completions for the flag's value.
+type PluginFlag struct {
+	Name        string   `json:"Name"`
+	ShortName   string   `json:"ShortName"`
+	Description string   `json:"Description"`
+	Type        string   `json:"Type"`
+	Completion  string   `json:"Completion"`
+	Choices     []string `json:"Choices"`
+}
+
+// TakesValue returns true if the flag is followed by a value.
+func (f PluginFlag) TakesValue() bool {
+	return f.Type == "string" || f.Type == "int"
+}
+
+// PluginArgument is a positional argument declared by the plugin for shell
+// completion.
+type PluginArgument struct {
+	Name        string   `json:"Name"`
+	Description string   `json:"Description"`
+	Completion  string   `json:"Completion"`
+	Choices     []string `json:"Choices"`
+}
+
 // AddPlugin adds the specified plugin to PluginsConfig
 func (config *Config) AddPlugin(plugin Plugin) {
 	config.pluginsConfig.Plugins[plugin.Name] = plugin
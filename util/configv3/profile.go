@@ -0,0 +1,201 @@
+package configv3
+
+import "sort"
+
+// DefaultProfileName is the name of the profile that is targeted until
+// another profile is targeted.
+const DefaultProfileName = "default"
+
+// Profile is a named target: an API endpoint together with the tokens, the
+// targeted organization and space, and the SSL settings used against it.
+type Profile struct {
+	Name                     string       `json:"-"`
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	AccessToken              string       `json:"AccessToken"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	RefreshToken             string       `json:"RefreshToken"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+}
+
+// CurrentUser returns user information decoded from the profile's JWT access
+// token.
+func (profile Profile) CurrentUser() (User, error) {
+	return decodeUserFromJWT(profile.AccessToken)
+}
+
+func newProfile() Profile {
+	return Profile{
+		SSHOAuthClient:       DefaultSSHOAuthClient,
+		UAAOAuthClient:       DefaultUAAOAuthClient,
+		UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+	}
+}
+
+// CurrentProfile returns the name of the targeted profile. The name is based
+// off of:
+//   1. The $CF_PROFILE environment variable if set
+//   2. The config file's CurrentProfile value if set
+//   3. Defaults to DefaultProfileName
+func (config *Config) CurrentProfile() string {
+	if config.ENV.CFProfile != "" {
+		return config.ENV.CFProfile
+	}
+
+	return config.ConfigFile.currentProfileName()
+}
+
+// DeleteProfile removes the profile. The targeted profile cannot be removed.
+func (config *Config) DeleteProfile(name string) {
+	config.ensureCredentials()
+	delete(config.ConfigFile.Profiles, name)
+}
+
+// Profiles returns all the profiles, including the targeted one, sorted by
+// name.
+func (config *Config) Profiles() []Profile {
+	config.ensureCredentials()
+	current := config.ConfigFile.profile()
+	current.Name = config.CurrentProfile()
+
+	profiles := []Profile{current}
+	for name, profile := range config.ConfigFile.Profiles {
+		profile.Name = name
+		profiles = append(profiles, profile)
+	}
+
+	sort.Slice(profiles, func(i int, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	return profiles
+}
+
+// RenameProfile renames the profile, keeping it targeted if it was targeted.
+func (config *Config) RenameProfile(oldName string, newName string) {
+	config.ensureCredentials()
+	if profile, ok := config.ConfigFile.Profiles[oldName]; ok {
+		delete(config.ConfigFile.Profiles, oldName)
+		config.ConfigFile.Profiles[newName] = profile
+	}
+
+	if config.ENV.CFProfile == oldName {
+		config.ENV.CFProfile = newName
+	}
+	if config.ConfigFile.currentProfileName() == oldName {
+		config.ConfigFile.CurrentProfile = newName
+	}
+}
+
+// SetCurrentProfile targets the profile, creating an empty profile if one
+// with the given name does not exist. The newly targeted profile replaces any
+// profile targeted by $CF_PROFILE.
+func (config *Config) SetCurrentProfile(name string) {
+	config.ensureCredentials()
+	config.ConfigFile.switchProfile(config.CurrentProfile(), name)
+	config.ConfigFile.CurrentProfile = name
+	config.ENV.CFProfile = ""
+}
+
+// jsonConfig returns the config file as it is written to disk. When
+// $CF_PROFILE targets a profile other than the config file's current profile,
+// the current profile is moved back into the top level fields.
+func (config *Config) jsonConfig() JSONConfig {
+	jsonConfig := config.ConfigFile
+	if !config.profileOverridden() {
+		return jsonConfig
+	}
+
+	jsonConfig.Profiles = make(map[string]Profile, len(config.ConfigFile.Profiles))
+	for name, profile := range config.ConfigFile.Profiles {
+		jsonConfig.Profiles[name] = profile
+	}
+	jsonConfig.switchProfile(config.ENV.CFProfile, jsonConfig.currentProfileName())
+	return jsonConfig
+}
+
+// profileOverridden returns true when $CF_PROFILE targets a profile other than
+// the config file's current profile.
+func (config *Config) profileOverridden() bool {
+	return config.ENV.CFProfile != "" && config.ENV.CFProfile != config.ConfigFile.currentProfileName()
+}
+
+// currentProfileName returns the name of the profile that is stored in the
+// top level fields of the config file.
+func (config JSONConfig) currentProfileName() string {
+	if config.CurrentProfile == "" {
+		return DefaultProfileName
+	}
+	return config.CurrentProfile
+}
+
+// profile returns the profile stored in the top level fields.
+func (config JSONConfig) profile() Profile {
+	return Profile{
+		Target:                   config.Target,
+		APIVersion:               config.APIVersion,
+		AuthorizationEndpoint:    config.AuthorizationEndpoint,
+		DopplerEndpoint:          config.DopplerEndpoint,
+		UAAEndpoint:              config.UAAEndpoint,
+		RoutingEndpoint:          config.RoutingEndpoint,
+		AccessToken:              config.AccessToken,
+		SSHOAuthClient:           config.SSHOAuthClient,
+		UAAOAuthClient:           config.UAAOAuthClient,
+		UAAOAuthClientSecret:     config.UAAOAuthClientSecret,
+		UAAGrantType:             config.UAAGrantType,
+		RefreshToken:             config.RefreshToken,
+		TargetedOrganization:     config.TargetedOrganization,
+		TargetedSpace:            config.TargetedSpace,
+		SkipSSLValidation:        config.SkipSSLValidation,
+		MinCLIVersion:            config.MinCLIVersion,
+		MinRecommendedCLIVersion: config.MinRecommendedCLIVersion,
+	}
+}
+
+// setProfile stores the profile in the top level fields.
+func (config *JSONConfig) setProfile(profile Profile) {
+	config.Target = profile.Target
+	config.APIVersion = profile.APIVersion
+	config.AuthorizationEndpoint = profile.AuthorizationEndpoint
+	config.DopplerEndpoint = profile.DopplerEndpoint
+	config.UAAEndpoint = profile.UAAEndpoint
+	config.RoutingEndpoint = profile.RoutingEndpoint
+	config.AccessToken = profile.AccessToken
+	config.SSHOAuthClient = profile.SSHOAuthClient
+	config.UAAOAuthClient = profile.UAAOAuthClient
+	config.UAAOAuthClientSecret = profile.UAAOAuthClientSecret
+	config.UAAGrantType = profile.UAAGrantType
+	config.RefreshToken = profile.RefreshToken
+	config.TargetedOrganization = profile.TargetedOrganization
+	config.TargetedSpace = profile.TargetedSpace
+	config.SkipSSLValidation = profile.SkipSSLValidation
+	config.MinCLIVersion = profile.MinCLIVersion
+	config.MinRecommendedCLIVersion = profile.MinRecommendedCLIVersion
+}
+
+// switchProfile saves the top level fields as the activeName profile and
+// replaces them with the name profile. Profiles only holds the profiles that
+// are not in the top level fields.
+func (config *JSONConfig) switchProfile(activeName string, name string) {
+	if config.Profiles == nil {
+		config.Profiles = map[string]Profile{}
+	}
+	config.Profiles[activeName] = config.profile()
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		profile = newProfile()
+	}
+	delete(config.Profiles, name)
+	config.setProfile(profile)
+}
@@ -0,0 +1,207 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profile", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		rawConfig := `{
+			"ConfigVersion": 3,
+			"Target": "https://api.prod.com",
+			"AccessToken": "prod-token",
+			"OrganizationFields": {"GUID": "prod-org-guid", "Name": "prod-org"},
+			"CurrentProfile": "prod",
+			"Profiles": {
+				"staging": {
+					"Target": "https://api.staging.com",
+					"AccessToken": "staging-token",
+					"SSLDisabled": true,
+					"UAAOAuthClient": "cf"
+				}
+			}
+		}`
+		setConfig(homeDir, rawConfig)
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readConfigFile := func() JSONConfig {
+		file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var configFile JSONConfig
+		Expect(json.Unmarshal(file, &configFile)).To(Succeed())
+		return configFile
+	}
+
+	Context("when CF_PROFILE is not set", func() {
+		BeforeEach(func() {
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("targets the config file's current profile", func() {
+			Expect(config.CurrentProfile()).To(Equal("prod"))
+			Expect(config.Target()).To(Equal("https://api.prod.com"))
+			Expect(config.AccessToken()).To(Equal("prod-token"))
+		})
+
+		Describe("Profiles", func() {
+			It("returns every profile sorted by name", func() {
+				profiles := config.Profiles()
+				Expect(profiles).To(HaveLen(2))
+				Expect(profiles[0].Name).To(Equal("prod"))
+				Expect(profiles[0].Target).To(Equal("https://api.prod.com"))
+				Expect(profiles[0].TargetedOrganization.Name).To(Equal("prod-org"))
+				Expect(profiles[1].Name).To(Equal("staging"))
+				Expect(profiles[1].Target).To(Equal("https://api.staging.com"))
+			})
+		})
+
+		Describe("SetCurrentProfile", func() {
+			Context("when the profile exists", func() {
+				It("swaps the targeted profile and keeps the previous one", func() {
+					config.SetCurrentProfile("staging")
+					Expect(config.CurrentProfile()).To(Equal("staging"))
+					Expect(config.Target()).To(Equal("https://api.staging.com"))
+					Expect(config.AccessToken()).To(Equal("staging-token"))
+					Expect(config.SkipSSLValidation()).To(BeTrue())
+					Expect(config.HasTargetedOrganization()).To(BeFalse())
+
+					Expect(WriteConfig(config)).To(Succeed())
+					configFile := readConfigFile()
+					Expect(configFile.CurrentProfile).To(Equal("staging"))
+					Expect(configFile.Target).To(Equal("https://api.staging.com"))
+					Expect(configFile.Profiles).To(HaveLen(1))
+					Expect(configFile.Profiles["prod"].AccessToken).To(BeEmpty())
+					Expect(configFile.Profiles["prod"].TargetedOrganization.GUID).To(Equal("prod-org-guid"))
+
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.Profiles()[0].AccessToken).To(Equal("prod-token"))
+				})
+			})
+
+			Context("when the profile does not exist", func() {
+				It("targets an empty profile", func() {
+					config.SetCurrentProfile("sandbox")
+					Expect(config.CurrentProfile()).To(Equal("sandbox"))
+					Expect(config.Target()).To(BeEmpty())
+					Expect(config.AccessToken()).To(BeEmpty())
+					Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+					Expect(config.Profiles()).To(HaveLen(3))
+				})
+			})
+		})
+
+		Describe("RenameProfile", func() {
+			It("renames profiles that are not targeted", func() {
+				config.RenameProfile("staging", "stage")
+				Expect(config.Profiles()[1].Name).To(Equal("stage"))
+				Expect(config.Profiles()[1].AccessToken).To(Equal("staging-token"))
+			})
+
+			It("renames the targeted profile", func() {
+				config.RenameProfile("prod", "production")
+				Expect(config.CurrentProfile()).To(Equal("production"))
+				Expect(config.Target()).To(Equal("https://api.prod.com"))
+			})
+		})
+
+		Describe("DeleteProfile", func() {
+			It("deletes the profile", func() {
+				config.DeleteProfile("staging")
+				Expect(config.Profiles()).To(HaveLen(1))
+			})
+		})
+	})
+
+	Context("when CF_PROFILE is set", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+		})
+
+		It("targets the profile for this process only", func() {
+			Expect(config.CurrentProfile()).To(Equal("staging"))
+			Expect(config.Target()).To(Equal("https://api.staging.com"))
+
+			config.SetAccessToken("new-staging-token")
+			Expect(WriteConfig(config)).To(Succeed())
+
+			configFile := readConfigFile()
+			Expect(configFile.CurrentProfile).To(Equal("prod"))
+			Expect(configFile.Target).To(Equal("https://api.prod.com"))
+			Expect(configFile.Profiles).To(HaveLen(1))
+			Expect(configFile.Profiles["staging"].Target).To(Equal("https://api.staging.com"))
+
+			Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("prod-token"))
+			Expect(config.Profiles()[1].AccessToken).To(Equal("new-staging-token"))
+		})
+
+		Context("when the profile is switched", func() {
+			It("persists the new profile", func() {
+				config.SetCurrentProfile("prod")
+				Expect(config.CurrentProfile()).To(Equal("prod"))
+				Expect(config.AccessToken()).To(Equal("prod-token"))
+
+				Expect(WriteConfig(config)).To(Succeed())
+				configFile := readConfigFile()
+				Expect(configFile.CurrentProfile).To(Equal("prod"))
+				Expect(configFile.Profiles["staging"].Target).To(Equal("https://api.staging.com"))
+			})
+		})
+	})
+
+	Context("when no profile has been named", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{"ConfigVersion": 3, "Target": "https://api.example.com"}`)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("targets the default profile", func() {
+			Expect(config.CurrentProfile()).To(Equal(DefaultProfileName))
+			Expect(config.Profiles()).To(HaveLen(1))
+			Expect(config.Profiles()[0].Name).To(Equal(DefaultProfileName))
+		})
+
+		It("does not write profiles to the config file", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+			file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(file)).ToNot(ContainSubstring("Profile"))
+		})
+	})
+})
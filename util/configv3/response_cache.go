@@ -0,0 +1,15 @@
+package configv3
+
+import "path/filepath"
+
+// NoCache returns true when cached Cloud Controller responses should not be
+// used. This is based off of the '--no-cache' global flag.
+func (config *Config) NoCache() bool {
+	return config.Flags.NoCache
+}
+
+// ResponseCacheDir returns the directory where Cloud Controller responses are
+// cached.
+func (config *Config) ResponseCacheDir() string {
+	return filepath.Join(configDirectory(), "cache", "responses")
+}
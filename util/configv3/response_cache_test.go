@@ -0,0 +1,42 @@
+package configv3_test
+
+import (
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("NoCache", func() {
+		It("returns the value of the '--no-cache' flag", func() {
+			config, err := LoadConfig(FlagOverride{NoCache: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.NoCache()).To(BeTrue())
+
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.NoCache()).To(BeFalse())
+		})
+	})
+
+	Describe("ResponseCacheDir", func() {
+		It("returns a directory in the config directory", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ResponseCacheDir()).To(Equal(filepath.Join(homeDir, ".cf", "cache", "responses")))
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package configv3
+
+import "strings"
+
+const (
+	// TraceFormatText means that requests and responses are written to trace
+	// log files as human readable text.
+	TraceFormatText TraceFormat = ""
+
+	// TraceFormatJSONL means that each request and its response are written
+	// to trace log files as a single line of JSON.
+	TraceFormatJSONL TraceFormat = "jsonl"
+)
+
+// TraceFormat represents the format of trace log files.
+type TraceFormat string
+
+// TraceFormat returns the format of trace log files based off of:
+//   1. The $CF_TRACE_FORMAT environment variable if set to 'jsonl'
+//   2. Defaults to TraceFormatText
+func (config *Config) TraceFormat() TraceFormat {
+	if TraceFormat(strings.ToLower(config.ENV.CFTraceFormat)) == TraceFormatJSONL {
+		return TraceFormatJSONL
+	}
+
+	return TraceFormatText
+}
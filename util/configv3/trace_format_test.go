@@ -0,0 +1,22 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	DescribeTable("TraceFormat",
+		func(envVal string, expected TraceFormat) {
+			config := Config{ENV: EnvOverride{CFTraceFormat: envVal}}
+			Expect(config.TraceFormat()).To(Equal(expected))
+		},
+		Entry("env=unset text", "", TraceFormatText),
+		Entry("env=jsonl jsonl", "jsonl", TraceFormatJSONL),
+		Entry("env=JSONL jsonl", "JSONL", TraceFormatJSONL),
+		Entry("env=xml   text", "xml", TraceFormatText),
+	)
+})
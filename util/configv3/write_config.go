@@ -10,9 +10,21 @@ import (
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory. Access tokens, refresh tokens and client secrets are written to
+// the credential store instead of the config.json.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	// Secrets still in the config file have to be merged with the stored ones
+	// before they can be moved to the credential store.
+	if c.ConfigFile.hasCredentials() {
+		c.ensureCredentials()
+	}
+
+	jsonConfig, err := c.storeCredentials(c.jsonConfig())
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(jsonConfig, "", "  ")
 	if err != nil {
 		return err
 	}
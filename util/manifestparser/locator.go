@@ -0,0 +1,137 @@
+package manifestparser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// position is the 1-based line and column of a key in the manifest.
+type position struct {
+	line   int
+	column int
+}
+
+// locatorFrame is a block mapping key or sequence entry that the following,
+// more indented, lines belong to.
+type locatorFrame struct {
+	path   string
+	indent int
+	// dashColumn is the column of the '-' of a sequence entry, or -1 for a
+	// mapping key.
+	dashColumn int
+	entries    int
+}
+
+// locateKeys returns the position of every mapping key and sequence entry in
+// a block style YAML document, indexed by its path (for example
+// 'applications[0].processes[1].memory'). It does not understand flow style
+// collections; keys inside them are not located.
+func locateKeys(rawManifest []byte) map[string]position {
+	positions := map[string]position{}
+	stack := []*locatorFrame{{indent: -1, dashColumn: -1}}
+	blockScalarIndent := -1
+
+	scanner := bufio.NewScanner(bytes.NewReader(rawManifest))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		content := strings.TrimLeft(line, " ")
+		column := len(line) - len(content)
+
+		if blockScalarIndent >= 0 {
+			if content == "" || column > blockScalarIndent {
+				continue
+			}
+			blockScalarIndent = -1
+		}
+
+		if content == "" || strings.HasPrefix(content, "#") || strings.HasPrefix(content, "---") {
+			continue
+		}
+
+		for content == "-" || strings.HasPrefix(content, "- ") {
+			for len(stack) > 1 {
+				top := stack[len(stack)-1]
+				if (top.dashColumn >= 0 && top.dashColumn >= column) || (top.dashColumn < 0 && top.indent > column) {
+					stack = stack[:len(stack)-1]
+					continue
+				}
+				break
+			}
+
+			parent := stack[len(stack)-1]
+			entryPath := fmt.Sprintf("%s[%d]", parent.path, parent.entries)
+			parent.entries++
+			positions[entryPath] = position{line: lineNumber, column: column + 1}
+
+			rest := strings.TrimLeft(strings.TrimPrefix(content, "-"), " ")
+			entryColumn := column + len(content) - len(rest)
+			stack = append(stack, &locatorFrame{path: entryPath, indent: entryColumn, dashColumn: column})
+
+			content = rest
+			column = entryColumn
+		}
+
+		key, value, ok := splitKey(content)
+		if !ok {
+			continue
+		}
+
+		for len(stack) > 1 {
+			top := stack[len(stack)-1]
+			if (top.dashColumn >= 0 && column < top.indent) || (top.dashColumn < 0 && top.indent >= column) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			break
+		}
+
+		keyPath := key
+		if parentPath := stack[len(stack)-1].path; parentPath != "" {
+			keyPath = parentPath + "." + key
+		}
+		positions[keyPath] = position{line: lineNumber, column: column + 1}
+
+		switch {
+		case value == "":
+			stack = append(stack, &locatorFrame{path: keyPath, indent: column, dashColumn: -1})
+		case strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+			blockScalarIndent = column
+		}
+	}
+
+	return positions
+}
+
+// splitKey splits a 'key: value' line into its unquoted key and its value
+// without a trailing comment.
+func splitKey(content string) (string, string, bool) {
+	var separator int
+	switch {
+	case strings.HasPrefix(content, `"`) || strings.HasPrefix(content, "'"):
+		closing := strings.Index(content[1:], content[:1])
+		if closing < 0 {
+			return "", "", false
+		}
+		separator = closing + 2
+		if !strings.HasPrefix(content[separator:], ":") {
+			return "", "", false
+		}
+	default:
+		separator = strings.Index(content, ": ")
+		if separator < 0 {
+			if !strings.HasSuffix(content, ":") {
+				return "", "", false
+			}
+			separator = len(content) - 1
+		}
+	}
+
+	key := strings.Trim(strings.TrimSpace(content[:separator]), `"'`)
+	value := strings.TrimSpace(content[separator+1:])
+	if strings.HasPrefix(value, "#") {
+		value = ""
+	}
+	return key, value, true
+}
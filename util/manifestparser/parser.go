@@ -2,13 +2,15 @@ package manifestparser
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 
 	yaml "gopkg.in/yaml.v2"
 )
 
 type Application struct {
-	Name string `yaml:"name"`
+	Name      string    `yaml:"name"`
+	Processes []Process `yaml:"processes,omitempty"`
 }
 
 type Parser struct {
@@ -30,6 +32,17 @@ func (parser *Parser) Parse(manifestPath string) error {
 	}
 	parser.rawManifest = bytes
 
+	var document yaml.MapSlice
+	err = yaml.Unmarshal(bytes, &document)
+	if err != nil {
+		return err
+	}
+
+	err = validateSchema(document, bytes)
+	if err != nil {
+		return err
+	}
+
 	var raw struct {
 		Applications []Application `yaml:"applications"`
 	}
@@ -49,6 +62,11 @@ func (parser *Parser) Parse(manifestPath string) error {
 		if application.Name == "" {
 			return errors.New("Found an application with no name specified")
 		}
+
+		err = validateProcesses(application)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -65,3 +83,20 @@ func (parser Parser) AppNames() []string {
 func (parser Parser) RawManifest(_ string) ([]byte, error) {
 	return parser.rawManifest, nil
 }
+
+func validateProcesses(application Application) error {
+	processTypes := map[string]bool{}
+	for _, process := range application.Processes {
+		err := process.validate(application.Name)
+		if err != nil {
+			return err
+		}
+
+		if processTypes[process.Type] {
+			return fmt.Errorf("Process '%s' is specified more than once in application '%s'", process.Type, application.Name)
+		}
+		processTypes[process.Type] = true
+	}
+
+	return nil
+}
@@ -74,6 +74,243 @@ var _ = Describe("Parser", func() {
 				Expect(executeErr).To(MatchError("must have at least one application"))
 			})
 		})
+
+		Context("when an application has processes", func() {
+			var processes []map[string]interface{}
+
+			BeforeEach(func() {
+				processes = []map[string]interface{}{
+					{
+						"type":                       "web",
+						"instances":                  2,
+						"memory":                     "256M",
+						"disk_quota":                 "1G",
+						"health-check-type":          "http",
+						"health-check-http-endpoint": "/health",
+						"timeout":                    120,
+					},
+					{
+						"type":      "worker",
+						"command":   "bundle exec rake work",
+						"instances": 0,
+					},
+				}
+
+				manifest = map[string]interface{}{
+					"applications": []map[string]interface{}{
+						{
+							"name":      "app-1",
+							"processes": processes,
+						},
+					},
+				}
+			})
+
+			Context("when the processes are valid", func() {
+				It("sets the processes on the application", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					webInstances, workerInstances := 2, 0
+					Expect(parser.Applications).To(ConsistOf(Application{
+						Name: "app-1",
+						Processes: []Process{
+							{
+								Type:                    "web",
+								Instances:               &webInstances,
+								Memory:                  "256M",
+								DiskQuota:               "1G",
+								HealthCheckType:         "http",
+								HealthCheckHTTPEndpoint: "/health",
+								Timeout:                 120,
+							},
+							{
+								Type:      "worker",
+								Command:   "bundle exec rake work",
+								Instances: &workerInstances,
+							},
+						},
+					}))
+				})
+			})
+
+			Context("when a process has no type", func() {
+				BeforeEach(func() {
+					delete(processes[1], "type")
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Found a process with no type specified in application 'app-1'"))
+				})
+			})
+
+			Context("when a process type is specified more than once", func() {
+				BeforeEach(func() {
+					processes[1]["type"] = "web"
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Process 'web' is specified more than once in application 'app-1'"))
+				})
+			})
+
+			Context("when a process has an endpoint without the http health check type", func() {
+				BeforeEach(func() {
+					processes[0]["health-check-type"] = "port"
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Process 'web' in application 'app-1' has a health-check-http-endpoint but its health-check-type is not 'http'"))
+				})
+			})
+		})
+	})
+
+	Describe("schema validation", func() {
+		var (
+			manifestPath string
+			rawManifest  string
+
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			tmpfile, err := ioutil.TempFile("", "")
+			Expect(err).ToNot(HaveOccurred())
+			manifestPath = tmpfile.Name()
+			Expect(tmpfile.Close()).ToNot(HaveOccurred())
+
+			Expect(ioutil.WriteFile(manifestPath, []byte(rawManifest), 0666)).To(Succeed())
+
+			executeErr = parser.Parse(manifestPath)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(manifestPath)).ToNot(HaveOccurred())
+		})
+
+		Context("when the manifest uses every supported key", func() {
+			BeforeEach(func() {
+				rawManifest = `---
+version: 1
+applications:
+- name: app-1
+  buildpacks:
+  - ruby_buildpack
+  - https://github.com/cloudfoundry/go-buildpack.git
+  command: bundle exec rackup
+  disk_quota: 1G
+  docker:
+    image: some-image
+    username: some-user
+  env:
+    SOME_KEY: some-value
+  health-check-type: http
+  health-check-http-endpoint: /health
+  instances: 2
+  memory: 256M
+  metadata:
+    labels:
+      env: prod
+  no-route: false
+  path: some/path
+  processes:
+  - type: worker
+    command: |
+      bundle exec rake
+      work: now
+    instances: 0
+  random-route: true
+  routes:
+  - route: example.com/path
+  services:
+  - some-service
+  - name: other-service
+    parameters:
+      some: parameter
+  stack: cflinuxfs2
+  timeout: 120
+`
+			})
+
+			It("does not return an error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the manifest has unknown keys", func() {
+			BeforeEach(func() {
+				rawManifest = `---
+applications:
+- name: app-1
+  memroy: 1G
+  processes:
+  - type: web
+    instance: 2
+  - type: worker
+    some-key: some-value
+- name: app-2
+  buildpaks: [ruby_buildpack]
+aplications: []
+`
+			})
+
+			It("returns every unknown key with its position and suggestions", func() {
+				Expect(executeErr).To(Equal(ValidationError{Errors: []SchemaError{
+					{Path: "applications[0].memroy", Line: 4, Column: 3, Message: "is not a valid key; did you mean 'memory'?"},
+					{Path: "applications[0].processes[0].instance", Line: 7, Column: 5, Message: "is not a valid key; did you mean 'instances'?"},
+					{Path: "applications[0].processes[1].some-key", Line: 9, Column: 5, Message: "is not a valid key"},
+					{Path: "applications[1].buildpaks", Line: 11, Column: 3, Message: "is not a valid key; did you mean 'buildpack' or 'buildpacks'?"},
+					{Path: "aplications", Line: 12, Column: 1, Message: "is not a valid key; did you mean 'applications'?"},
+				}}))
+				Expect(executeErr.Error()).To(HavePrefix("line 4, column 3: applications[0].memroy is not a valid key; did you mean 'memory'?\n"))
+			})
+		})
+
+		Context("when the manifest has values of the wrong type", func() {
+			BeforeEach(func() {
+				rawManifest = `---
+applications:
+- name: app-1
+  instances: two
+  memory: lots
+  disk_quota: 1024
+  no-route: "yes"
+  health-check-type: tcp
+  buildpacks: some-buildpack
+  env: some-env
+  processes:
+  - type: worker
+    timeout: -1
+  routes:
+  - some-route
+`
+			})
+
+			It("returns every invalid value with its position", func() {
+				Expect(executeErr).To(Equal(ValidationError{Errors: []SchemaError{
+					{Path: "applications[0].instances", Line: 4, Column: 3, Message: "must be an integer"},
+					{Path: "applications[0].memory", Line: 5, Column: 3, Message: "'lots' must be an integer followed by a unit of measurement like M, MB, G, or GB"},
+					{Path: "applications[0].disk_quota", Line: 6, Column: 3, Message: "'1024' must be an integer followed by a unit of measurement like M, MB, G, or GB"},
+					{Path: "applications[0].no-route", Line: 7, Column: 3, Message: "must be true or false"},
+					{Path: "applications[0].health-check-type", Line: 8, Column: 3, Message: "'tcp' must be one of http, none, port, process"},
+					{Path: "applications[0].buildpacks", Line: 9, Column: 3, Message: "must be a list of strings"},
+					{Path: "applications[0].env", Line: 10, Column: 3, Message: "must be a map"},
+					{Path: "applications[0].processes[0].timeout", Line: 13, Column: 5, Message: "must be 0 or greater"},
+					{Path: "applications[0].routes[0]", Line: 15, Column: 3, Message: "must be a map"},
+				}}))
+			})
+		})
+
+		Context("when the manifest is not valid YAML", func() {
+			BeforeEach(func() {
+				rawManifest = "applications: [\n"
+			})
+
+			It("returns the YAML error", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(executeErr).ToNot(BeAssignableToTypeOf(ValidationError{}))
+			})
+		})
 	})
 
 	Describe("AppNames", func() {
@@ -0,0 +1,30 @@
+package manifestparser
+
+import "fmt"
+
+// Process is an entry in the 'processes' section of an application in the
+// manifest. It configures the process of the given type.
+type Process struct {
+	Type                    string `yaml:"type"`
+	Command                 string `yaml:"command,omitempty"`
+	Instances               *int   `yaml:"instances,omitempty"`
+	Memory                  string `yaml:"memory,omitempty"`
+	DiskQuota               string `yaml:"disk_quota,omitempty"`
+	HealthCheckType         string `yaml:"health-check-type,omitempty"`
+	HealthCheckHTTPEndpoint string `yaml:"health-check-http-endpoint,omitempty"`
+	Timeout                 int    `yaml:"timeout,omitempty"`
+}
+
+// validate checks the rules that involve more than one key of the process.
+// The value of each key is checked against the manifest schema beforehand.
+func (process Process) validate(appName string) error {
+	if process.Type == "" {
+		return fmt.Errorf("Found a process with no type specified in application '%s'", appName)
+	}
+
+	if process.HealthCheckHTTPEndpoint != "" && process.HealthCheckType != "http" {
+		return fmt.Errorf("Process '%s' in application '%s' has a health-check-http-endpoint but its health-check-type is not 'http'", process.Type, appName)
+	}
+
+	return nil
+}
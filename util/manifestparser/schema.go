@@ -0,0 +1,238 @@
+package manifestparser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/cf/util/spellcheck"
+	yaml "gopkg.in/yaml.v2"
+)
+
+type valueKind int
+
+const (
+	stringValue valueKind = iota
+	intValue
+	boolValue
+	byteSizeValue
+	stringListValue
+	// mapValue is a map with arbitrary keys, such as 'env'.
+	mapValue
+	// objectValue is a map whose keys are described by the field's fields.
+	objectValue
+	// objectListValue is a list of objects whose keys are described by the
+	// field's fields.
+	objectListValue
+)
+
+// field describes the expected value of a key in the manifest.
+type field struct {
+	kind        valueKind
+	enum        []string
+	nonNegative bool
+	fields      map[string]field
+	// allowString allows the entries of an objectListValue to be plain
+	// strings, such as service instance names.
+	allowString bool
+}
+
+var healthCheckTypes = []string{"http", "none", "port", "process"}
+
+func processFields() map[string]field {
+	return map[string]field{
+		"command":                    {kind: stringValue},
+		"disk_quota":                 {kind: byteSizeValue},
+		"health-check-http-endpoint": {kind: stringValue},
+		"health-check-type":          {kind: stringValue, enum: healthCheckTypes},
+		"instances":                  {kind: intValue, nonNegative: true},
+		"memory":                     {kind: byteSizeValue},
+		"timeout":                    {kind: intValue, nonNegative: true},
+	}
+}
+
+func applicationFields() map[string]field {
+	fields := processFields()
+	fields["name"] = field{kind: stringValue}
+	fields["buildpack"] = field{kind: stringValue}
+	fields["buildpacks"] = field{kind: stringListValue}
+	fields["default-route"] = field{kind: boolValue}
+	fields["docker"] = field{kind: objectValue, fields: map[string]field{
+		"image":    {kind: stringValue},
+		"username": {kind: stringValue},
+	}}
+	fields["env"] = field{kind: mapValue}
+	fields["metadata"] = field{kind: objectValue, fields: map[string]field{
+		"annotations": {kind: mapValue},
+		"labels":      {kind: mapValue},
+	}}
+	fields["no-route"] = field{kind: boolValue}
+	fields["path"] = field{kind: stringValue}
+	fields["random-route"] = field{kind: boolValue}
+	fields["stack"] = field{kind: stringValue}
+
+	process := processFields()
+	process["type"] = field{kind: stringValue}
+	fields["processes"] = field{kind: objectListValue, fields: process}
+	fields["routes"] = field{kind: objectListValue, fields: map[string]field{
+		"route": {kind: stringValue},
+	}}
+	fields["services"] = field{kind: objectListValue, allowString: true, fields: map[string]field{
+		"name":       {kind: stringValue},
+		"parameters": {kind: mapValue},
+	}}
+	return fields
+}
+
+var manifestSchema = field{kind: objectValue, fields: map[string]field{
+	"applications": {kind: objectListValue, fields: applicationFields()},
+	"version":      {kind: intValue},
+}}
+
+// schemaValidator walks a decoded manifest and collects a SchemaError for
+// every value that does not match the manifest schema.
+type schemaValidator struct {
+	positions map[string]position
+	errors    []SchemaError
+}
+
+func validateSchema(document yaml.MapSlice, rawManifest []byte) error {
+	validator := schemaValidator{positions: locateKeys(rawManifest)}
+	validator.validateObject("", document, manifestSchema.fields)
+
+	if len(validator.errors) > 0 {
+		return ValidationError{Errors: validator.errors}
+	}
+	return nil
+}
+
+func (validator *schemaValidator) addError(path string, format string, args ...interface{}) {
+	pos := validator.positions[path]
+	validator.errors = append(validator.errors, SchemaError{
+		Path:    path,
+		Line:    pos.line,
+		Column:  pos.column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (validator *schemaValidator) validateObject(path string, object yaml.MapSlice, fields map[string]field) {
+	for _, item := range object {
+		key := fmt.Sprint(item.Key)
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		keyField, ok := fields[key]
+		if !ok {
+			validator.addUnknownKeyError(keyPath, key, fields)
+			continue
+		}
+		validator.validateValue(keyPath, item.Value, keyField)
+	}
+}
+
+func (validator *schemaValidator) addUnknownKeyError(path string, key string, fields map[string]field) {
+	var knownKeys []string
+	for knownKey := range fields {
+		knownKeys = append(knownKeys, knownKey)
+	}
+
+	suggestions := spellcheck.NewCommandSuggester(knownKeys).Recommend(key)
+	if len(suggestions) == 0 {
+		validator.addError(path, "is not a valid key")
+		return
+	}
+
+	sort.Strings(suggestions)
+	validator.addError(path, "is not a valid key; did you mean '%s'?", strings.Join(suggestions, "' or '"))
+}
+
+func (validator *schemaValidator) validateValue(path string, value interface{}, valueField field) {
+	// A null value unsets the key, which is valid for every key.
+	if value == nil {
+		return
+	}
+
+	switch valueField.kind {
+	case stringValue:
+		str, ok := value.(string)
+		if !ok {
+			validator.addError(path, "must be a string")
+			return
+		}
+		if len(valueField.enum) > 0 && !contains(valueField.enum, str) {
+			validator.addError(path, "'%s' must be one of %s", str, strings.Join(valueField.enum, ", "))
+		}
+	case intValue:
+		integer, ok := value.(int)
+		if !ok {
+			validator.addError(path, "must be an integer")
+			return
+		}
+		if valueField.nonNegative && integer < 0 {
+			validator.addError(path, "must be 0 or greater")
+		}
+	case boolValue:
+		if _, ok := value.(bool); !ok {
+			validator.addError(path, "must be true or false")
+		}
+	case byteSizeValue:
+		size := fmt.Sprint(value)
+		if _, err := bytefmt.ToMegabytes(size); err != nil {
+			validator.addError(path, "'%s' must be an integer followed by a unit of measurement like M, MB, G, or GB", size)
+		}
+	case stringListValue:
+		list, ok := value.([]interface{})
+		if !ok {
+			validator.addError(path, "must be a list of strings")
+			return
+		}
+		for i, entry := range list {
+			if _, ok := entry.(string); !ok {
+				validator.addError(fmt.Sprintf("%s[%d]", path, i), "must be a string")
+			}
+		}
+	case mapValue:
+		if _, ok := value.(yaml.MapSlice); !ok {
+			validator.addError(path, "must be a map")
+		}
+	case objectValue:
+		object, ok := value.(yaml.MapSlice)
+		if !ok {
+			validator.addError(path, "must be a map")
+			return
+		}
+		validator.validateObject(path, object, valueField.fields)
+	case objectListValue:
+		list, ok := value.([]interface{})
+		if !ok {
+			validator.addError(path, "must be a list")
+			return
+		}
+		for i, entry := range list {
+			entryPath := fmt.Sprintf("%s[%d]", path, i)
+			if _, isString := entry.(string); isString && valueField.allowString {
+				continue
+			}
+
+			object, isObject := entry.(yaml.MapSlice)
+			if !isObject {
+				validator.addError(entryPath, "must be a map")
+				continue
+			}
+			validator.validateObject(entryPath, object, valueField.fields)
+		}
+	}
+}
+
+func contains(list []string, str string) bool {
+	for _, entry := range list {
+		if entry == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SchemaError is a single value in the manifest that does not match the
+// manifest schema. Line and Column are 0 when the value could not be located
+// in the manifest file.
+type SchemaError struct {
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e SchemaError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s %s", e.Line, e.Column, e.Path, e.Message)
+}
+
+// ValidationError is returned when the manifest does not match the manifest
+// schema. It contains every problem that was found.
+type ValidationError struct {
+	Errors []SchemaError
+}
+
+func (e ValidationError) Error() string {
+	var messages []string
+	for _, schemaErr := range e.Errors {
+		messages = append(messages, schemaErr.Error())
+	}
+	return strings.Join(messages, "\n")
+}
@@ -0,0 +1,151 @@
+// Package minisign verifies Ed25519 signatures in the minisign format
+// (https://jedisct1.github.io/minisign/), as well as raw Ed25519 signatures.
+//
+// Both the pre-hashed signatures that minisign creates by default, which sign
+// the BLAKE2b-512 hash of the message, and the legacy signatures of the whole
+// message, which minisign creates with its -l flag, are supported.
+package minisign
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ed25519"
+)
+
+const (
+	trustedCommentPrefix   = "trusted comment: "
+	untrustedCommentPrefix = "untrusted comment:"
+)
+
+var (
+	// ErrInvalidPublicKey is returned when a public key cannot be parsed.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+
+	// ErrInvalidSignature is returned when a signature cannot be parsed or
+	// does not match the message.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrKeyMismatch is returned when a signature was made with another key.
+	ErrKeyMismatch = errors.New("signature was made with a different key")
+
+	// ErrUnsupportedAlgorithm is returned for signatures made with an
+	// algorithm other than Ed25519.
+	ErrUnsupportedAlgorithm = errors.New("unsupported signature algorithm")
+)
+
+var (
+	ed25519Algorithm          = []byte("Ed")
+	prehashedEd25519Algorithm = []byte("ED")
+)
+
+// PublicKey is an Ed25519 public key. KeyID is empty for raw keys.
+type PublicKey struct {
+	KeyID []byte
+	Key   ed25519.PublicKey
+}
+
+// ParsePublicKey parses a minisign public key, either the contents of a
+// minisign .pub file or its base64 line, or a base64 encoded raw Ed25519
+// public key.
+func ParsePublicKey(text string) (PublicKey, error) {
+	lines := significantLines(text)
+	if len(lines) != 1 {
+		return PublicKey{}, ErrInvalidPublicKey
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil {
+		return PublicKey{}, ErrInvalidPublicKey
+	}
+
+	switch {
+	case len(raw) == ed25519.PublicKeySize:
+		return PublicKey{Key: raw}, nil
+	case len(raw) == 2+8+ed25519.PublicKeySize && bytes.Equal(raw[:2], ed25519Algorithm):
+		return PublicKey{KeyID: raw[2:10], Key: raw[10:]}, nil
+	default:
+		return PublicKey{}, ErrInvalidPublicKey
+	}
+}
+
+// Verify checks that the signature was made for the message with the key.
+// The signature is either the contents of a minisign .minisig file or a
+// base64 encoded raw Ed25519 signature. The trusted comment of a minisign
+// signature is verified as well.
+func (key PublicKey) Verify(message []byte, signature string) error {
+	lines := significantLines(signature)
+	if len(lines) == 0 {
+		return ErrInvalidSignature
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(lines[0])
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if len(raw) == ed25519.SignatureSize && len(lines) == 1 {
+		if !ed25519.Verify(key.Key, message, raw) {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+
+	if len(raw) != 2+8+ed25519.SignatureSize {
+		return ErrInvalidSignature
+	}
+
+	signedMessage := message
+	switch {
+	case bytes.Equal(raw[:2], ed25519Algorithm):
+	case bytes.Equal(raw[:2], prehashedEd25519Algorithm):
+		hash := blake2b.Sum512(message)
+		signedMessage = hash[:]
+	default:
+		return ErrUnsupportedAlgorithm
+	}
+	if key.KeyID != nil && !bytes.Equal(raw[2:10], key.KeyID) {
+		return ErrKeyMismatch
+	}
+
+	messageSignature := raw[10:]
+	if !ed25519.Verify(key.Key, signedMessage, messageSignature) {
+		return ErrInvalidSignature
+	}
+
+	if len(lines) == 1 {
+		return nil
+	}
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], trustedCommentPrefix) {
+		return ErrInvalidSignature
+	}
+
+	globalSignature, err := base64.StdEncoding.DecodeString(lines[2])
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	trustedComment := strings.TrimPrefix(lines[1], trustedCommentPrefix)
+	signed := append(append([]byte{}, messageSignature...), trustedComment...)
+	if !ed25519.Verify(key.Key, signed, globalSignature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// significantLines returns the non-empty lines of text, without the
+// untrusted comment.
+func significantLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, untrustedCommentPrefix) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,13 @@
+package minisign_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestMinisign(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Minisign Suite")
+}
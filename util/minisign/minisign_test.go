@@ -0,0 +1,132 @@
+package minisign_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/minisign"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ed25519"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Minisign", func() {
+	var (
+		publicKey  ed25519.PublicKey
+		privateKey ed25519.PrivateKey
+		keyID      []byte
+		message    []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+		publicKey, privateKey, err = ed25519.GenerateKey(nil)
+		Expect(err).ToNot(HaveOccurred())
+		keyID = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+		message = []byte("some-plugin-binary")
+	})
+
+	encode := func(parts ...[]byte) string {
+		var raw []byte
+		for _, part := range parts {
+			raw = append(raw, part...)
+		}
+		return base64.StdEncoding.EncodeToString(raw)
+	}
+
+	minisignPublicKey := func() string {
+		return fmt.Sprintf("untrusted comment: minisign public key\n%s\n", encode([]byte("Ed"), keyID, publicKey))
+	}
+
+	minisignSignature := func(algorithm string, trustedComment string) string {
+		signedMessage := message
+		if algorithm == "ED" {
+			hash := blake2b.Sum512(message)
+			signedMessage = hash[:]
+		}
+		signature := ed25519.Sign(privateKey, signedMessage)
+		globalSignature := ed25519.Sign(privateKey, append(append([]byte{}, signature...), trustedComment...))
+		return fmt.Sprintf("untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+			encode([]byte(algorithm), keyID, signature), trustedComment, encode(globalSignature))
+	}
+
+	Describe("ParsePublicKey", func() {
+		It("parses minisign public keys", func() {
+			key, err := ParsePublicKey(minisignPublicKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.KeyID).To(Equal(keyID))
+			Expect(key.Key).To(Equal(publicKey))
+		})
+
+		It("parses raw ed25519 public keys", func() {
+			key, err := ParsePublicKey(encode(publicKey))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.KeyID).To(BeNil())
+			Expect(key.Key).To(Equal(publicKey))
+		})
+
+		DescribeTable("invalid keys",
+			func(text string) {
+				_, err := ParsePublicKey(text)
+				Expect(err).To(MatchError(ErrInvalidPublicKey))
+			},
+			Entry("empty", ""),
+			Entry("not base64", "not-base-64!"),
+			Entry("wrong length", encode([]byte("short"))),
+			Entry("multiple keys", encode(publicKey)+"\n"+encode(publicKey)),
+		)
+	})
+
+	Describe("Verify", func() {
+		var key PublicKey
+
+		BeforeEach(func() {
+			var err error
+			key, err = ParsePublicKey(minisignPublicKey())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("accepts valid minisign signatures", func() {
+			Expect(key.Verify(message, minisignSignature("ED", "timestamp:1234"))).To(Succeed())
+		})
+
+		It("accepts valid legacy minisign signatures", func() {
+			Expect(key.Verify(message, minisignSignature("Ed", "timestamp:1234"))).To(Succeed())
+		})
+
+		It("accepts valid raw ed25519 signatures", func() {
+			Expect(key.Verify(message, encode(ed25519.Sign(privateKey, message)))).To(Succeed())
+		})
+
+		It("rejects signatures of other messages", func() {
+			Expect(key.Verify([]byte("some-other-binary"), minisignSignature("Ed", "timestamp:1234"))).To(MatchError(ErrInvalidSignature))
+		})
+
+		It("rejects tampered trusted comments", func() {
+			signature := strings.Replace(minisignSignature("Ed", "timestamp:1234"), "timestamp:1234", "timestamp:9999", 1)
+			Expect(key.Verify(message, signature)).To(MatchError(ErrInvalidSignature))
+		})
+
+		It("rejects signatures made with another key", func() {
+			key.KeyID = []byte{8, 7, 6, 5, 4, 3, 2, 1}
+			Expect(key.Verify(message, minisignSignature("Ed", "timestamp:1234"))).To(MatchError(ErrKeyMismatch))
+		})
+
+		It("rejects pre-hashed signatures of other messages", func() {
+			Expect(key.Verify([]byte("some-other-binary"), minisignSignature("ED", "timestamp:1234"))).To(MatchError(ErrInvalidSignature))
+		})
+
+		It("rejects signatures made with unknown algorithms", func() {
+			Expect(key.Verify(message, minisignSignature("XX", "timestamp:1234"))).To(MatchError(ErrUnsupportedAlgorithm))
+		})
+
+		It("rejects malformed signatures", func() {
+			Expect(key.Verify(message, "")).To(MatchError(ErrInvalidSignature))
+			Expect(key.Verify(message, "not-base-64!")).To(MatchError(ErrInvalidSignature))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+// Package requestlog contains the entries written to trace log files when
+// $CF_TRACE_FORMAT is set to 'jsonl'. Each entry describes a request and its
+// response, or the error that prevented a response from being received.
+package requestlog
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+const (
+	// SourceCloudController marks entries for Cloud Controller requests.
+	SourceCloudController = "cloud_controller"
+
+	// SourcePluginRepository marks entries for plugin repository requests.
+	SourcePluginRepository = "plugin_repository"
+
+	// SourceUAA marks entries for UAA requests.
+	SourceUAA = "uaa"
+)
+
+// Entry is a single request and its response.
+type Entry struct {
+	ID           string    `json:"id"`
+	Source       string    `json:"source"`
+	StartTime    time.Time `json:"start_time"`
+	DurationMS   float64   `json:"duration_ms"`
+	RetryAttempt int       `json:"retry_attempt,omitempty"`
+	Request      Request   `json:"request"`
+	Response     *Response `json:"response,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// Request is the request half of an Entry.
+type Request struct {
+	Method  string              `json:"method"`
+	URL     string              `json:"url"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	Size    int                 `json:"size"`
+	Body    *Body               `json:"body,omitempty"`
+}
+
+// Response is the response half of an Entry.
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Size       int                 `json:"size"`
+	Body       *Body               `json:"body,omitempty"`
+}
+
+// Body is a request or response body. Raw is never written to the log; the
+// writer sanitizes it into Sanitized before the entry is written.
+type Body struct {
+	Raw         []byte
+	ContentType string
+	Sanitized   interface{}
+}
+
+// MarshalJSON writes the sanitized body.
+func (body Body) MarshalJSON() ([]byte, error) {
+	return json.Marshal(body.Sanitized)
+}
+
+// NewID returns a random ID that correlates an entry with the request that
+// was sent.
+func NewID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// NewHeaders copies the provided headers, passing each value through redact.
+func NewHeaders(headers http.Header, redact func(key string, value string) string) map[string][]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	copied := map[string][]string{}
+	for key, values := range headers {
+		for _, value := range values {
+			copied[key] = append(copied[key], redact(key, value))
+		}
+	}
+	return copied
+}
+
+// Duration returns the duration between start and end in milliseconds.
+func Duration(start time.Time, end time.Time) float64 {
+	return float64(end.Sub(start)) / float64(time.Millisecond)
+}
@@ -0,0 +1,79 @@
+package requestlog_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/requestlog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Entry", func() {
+	Describe("MarshalJSON", func() {
+		It("writes the sanitized body and never the raw body", func() {
+			entry := Entry{
+				ID:     "some-id",
+				Source: SourceCloudController,
+				Request: Request{
+					Method: "GET",
+					URL:    "https://api.example.com/v2/apps",
+					Body: &Body{
+						Raw:       []byte(`{"password":"secret"}`),
+						Sanitized: map[string]interface{}{"password": "[PRIVATE DATA HIDDEN]"},
+					},
+				},
+			}
+
+			raw, err := json.Marshal(entry)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(ContainSubstring(`"body":{"password":"[PRIVATE DATA HIDDEN]"}`))
+			Expect(string(raw)).ToNot(ContainSubstring("secret"))
+			Expect(string(raw)).ToNot(ContainSubstring(`"response"`))
+		})
+	})
+
+	Describe("NewID", func() {
+		It("returns unique hex IDs", func() {
+			id := NewID()
+			Expect(id).To(MatchRegexp("^[0-9a-f]{32}$"))
+			Expect(NewID()).ToNot(Equal(id))
+		})
+	})
+
+	Describe("NewHeaders", func() {
+		It("copies the headers through the redact function", func() {
+			headers := http.Header{
+				"Authorization": {"bearer some-token"},
+				"Accept":        {"application/json", "text/plain"},
+			}
+
+			copied := NewHeaders(headers, func(key string, value string) string {
+				if key == "Authorization" {
+					return "[PRIVATE DATA HIDDEN]"
+				}
+				return strings.ToUpper(value)
+			})
+
+			Expect(copied).To(Equal(map[string][]string{
+				"Authorization": {"[PRIVATE DATA HIDDEN]"},
+				"Accept":        {"APPLICATION/JSON", "TEXT/PLAIN"},
+			}))
+			Expect(headers.Get("Authorization")).To(Equal("bearer some-token"))
+		})
+
+		It("returns nil when there are no headers", func() {
+			Expect(NewHeaders(nil, nil)).To(BeNil())
+		})
+	})
+
+	Describe("Duration", func() {
+		It("returns the duration in milliseconds", func() {
+			start := time.Now()
+			Expect(Duration(start, start.Add(1500*time.Microsecond))).To(Equal(1.5))
+		})
+	})
+})
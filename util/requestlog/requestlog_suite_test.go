@@ -0,0 +1,13 @@
+package requestlog_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRequestlog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Request Log Suite")
+}
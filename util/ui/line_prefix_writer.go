@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// LinePrefixWriter writes every line written to it to an underlying writer,
+// starting with a prefix. Writers sharing the same lock never interleave their
+// lines, so output from concurrent sources stays readable.
+type LinePrefixWriter struct {
+	out    io.Writer
+	prefix string
+	lock   *sync.Mutex
+	buffer []byte
+}
+
+// NewLinePrefixWriter returns a LinePrefixWriter that writes to out. lock
+// should be shared by all writers with the same out.
+func NewLinePrefixWriter(out io.Writer, prefix string, lock *sync.Mutex) *LinePrefixWriter {
+	return &LinePrefixWriter{
+		out:    out,
+		prefix: prefix,
+		lock:   lock,
+	}
+}
+
+// Write writes any complete lines in p and holds on to the rest until the
+// next newline or Flush.
+func (w *LinePrefixWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+
+	for {
+		i := bytes.IndexByte(w.buffer, '\n')
+		if i < 0 {
+			break
+		}
+
+		err := w.writeLine(w.buffer[:i+1])
+		w.buffer = w.buffer[i+1:]
+		if err != nil {
+			return len(p), err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes a partial line left over from the last Write, ending it with a
+// newline.
+func (w *LinePrefixWriter) Flush() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+
+	line := append(w.buffer, '\n')
+	w.buffer = nil
+	return w.writeLine(line)
+}
+
+func (w *LinePrefixWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := w.out.Write(append([]byte(w.prefix), line...))
+	return err
+}
@@ -0,0 +1,56 @@
+package ui_test
+
+import (
+	"bytes"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LinePrefixWriter", func() {
+	var (
+		out    *bytes.Buffer
+		lock   *sync.Mutex
+		writer *LinePrefixWriter
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+		lock = new(sync.Mutex)
+		writer = NewLinePrefixWriter(out, "[1] ", lock)
+	})
+
+	It("prefixes every complete line", func() {
+		n, err := writer.Write([]byte("first\nsecond\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(13))
+		Expect(out.String()).To(Equal("[1] first\n[1] second\n"))
+	})
+
+	It("holds on to partial lines until they are complete", func() {
+		_, err := writer.Write([]byte("fir"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(BeEmpty())
+
+		_, err = writer.Write([]byte("st\nsec"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(Equal("[1] first\n"))
+	})
+
+	Describe("Flush", func() {
+		It("writes the remaining partial line with a newline", func() {
+			_, err := writer.Write([]byte("first\nsecond"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(writer.Flush()).To(Succeed())
+			Expect(out.String()).To(Equal("[1] first\n[1] second\n"))
+		})
+
+		It("writes nothing when there is no partial line", func() {
+			Expect(writer.Flush()).To(Succeed())
+			Expect(out.String()).To(BeEmpty())
+		})
+	})
+})
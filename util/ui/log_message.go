@@ -1,7 +1,9 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 
@@ -23,8 +25,40 @@ type LogMessage interface {
 	SourceInstance() string
 }
 
+// AppLogMessage is a log message from one of several apps whose logs are
+// displayed together.
+type AppLogMessage interface {
+	LogMessage
+	AppName() string
+}
+
+// appNameColors are the colors app names are displayed in. Red is left out so
+// that it stays reserved for ERR lines.
+var appNameColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgGreen,
+	color.FgBlue,
+}
+
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
+	ui.displayLogMessage("", message, displayHeader)
+}
+
+// DisplayAppLogMessage formats and outputs a given log message with the name
+// of its app in front. Each app name is always displayed in the same color.
+func (ui *UI) DisplayAppLogMessage(message AppLogMessage) {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(message.AppName()))
+	appNameColor := appNameColors[hash.Sum32()%uint32(len(appNameColors))]
+
+	prefix := ui.modifyColor(message.AppName(), color.New(appNameColor, color.Bold)) + " | "
+	ui.displayLogMessage(prefix, message, true)
+}
+
+func (ui *UI) displayLogMessage(prefix string, message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -45,6 +79,43 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.Out, "   %s%s\n", prefix, logLine)
+	}
+}
+
+type logMessageJSON struct {
+	AppName        string `json:"app_name,omitempty"`
+	Timestamp      string `json:"timestamp"`
+	SourceType     string `json:"source_type"`
+	SourceInstance string `json:"source_instance"`
+	Type           string `json:"type"`
+	Message        string `json:"message"`
+}
+
+// DisplayLogMessageJSON outputs a given log message as a single line JSON
+// object, so that a stream of messages can be read one object per line. The
+// app name is included for an AppLogMessage.
+func (ui *UI) DisplayLogMessageJSON(message LogMessage) error {
+	var appName string
+	if appMessage, ok := message.(AppLogMessage); ok {
+		appName = appMessage.AppName()
+	}
+
+	raw, err := json.Marshal(logMessageJSON{
+		AppName:        appName,
+		Timestamp:      message.Timestamp().In(ui.TimezoneLocation).Format(time.RFC3339Nano),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Type:           message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	})
+	if err != nil {
+		return err
 	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = ui.Out.Write(append(raw, '\n'))
+	return err
 }
@@ -1,6 +1,7 @@
 package ui_test
 
 import (
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -115,4 +116,74 @@ var _ = Describe("Log Message", func() {
 			})
 		})
 	})
+
+	Describe("DisplayLogMessageJSON", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prints out the message as a single line JSON object without color", func() {
+			err := ui.DisplayLogMessageJSON(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(Equal(
+				`{"timestamp":"2016-07-19T16:08:12-07:00","source_type":"APP/PROC/WEB","source_instance":"12","type":"ERR","message":"This is a log message\nThis is also a log message"}` + "\n",
+			))
+		})
+	})
+
+	Describe("DisplayAppLogMessage", func() {
+		var message appLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = appLogMessage{FakeLogMessage: new(uifakes.FakeLogMessage), appName: "some-app"}
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("OUT")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prints out every line with the colored app name in front", func() {
+			ui.DisplayAppLogMessage(message)
+			Expect(out).To(Say("\x1b\\[\\d+;1msome-app\x1b\\[0m \\| 2016-07-19T16:08:12.00-0700 \\[APP/PROC/WEB/12\\] OUT This is a log message\n"))
+			Expect(out).To(Say("\x1b\\[\\d+;1msome-app\x1b\\[0m \\| 2016-07-19T16:08:12.00-0700 \\[APP/PROC/WEB/12\\] OUT This is also a log message\n"))
+		})
+
+		It("displays the same app name in the same color", func() {
+			ui.DisplayAppLogMessage(message)
+			ui.DisplayAppLogMessage(message)
+			lines := strings.Split(string(out.Contents()), "\n")
+			Expect(lines[0]).To(Equal(lines[2]))
+		})
+
+		It("includes the app name in JSON output", func() {
+			err := ui.DisplayLogMessageJSON(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(HavePrefix(`{"app_name":"some-app","timestamp":`))
+		})
+	})
 })
+
+type appLogMessage struct {
+	*uifakes.FakeLogMessage
+	appName string
+}
+
+func (m appLogMessage) AppName() string {
+	return m.appName
+}
@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/requestlog"
+)
+
+// traceLogBackups is the number of rotated trace log files that are kept next
+// to each trace log file.
+const traceLogBackups = 3
+
+// RequestLoggerJSONLWriter writes each request and its response to the trace
+// log files as a single line of JSON. A file is rotated before it grows past
+// maxSize bytes.
+type RequestLoggerJSONLWriter struct {
+	ui        *UI
+	lock      *sync.Mutex
+	filePaths []string
+	maxSize   int64
+}
+
+func newRequestLoggerJSONLWriter(ui *UI, lock *sync.Mutex, filePaths []string, maxSize int64) *RequestLoggerJSONLWriter {
+	return &RequestLoggerJSONLWriter{
+		ui:        ui,
+		lock:      lock,
+		filePaths: filePaths,
+		maxSize:   maxSize,
+	}
+}
+
+// HandleInternalError displays the error as a warning.
+func (writer *RequestLoggerJSONLWriter) HandleInternalError(err error) {
+	writer.ui.DisplayWarning(err.Error())
+}
+
+// WriteEntry sanitizes the URL and bodies of the entry and appends it to every
+// trace log file.
+func (writer *RequestLoggerJSONLWriter) WriteEntry(entry requestlog.Entry) error {
+	entry.Request.URL = sanitizeValues.ReplaceAllString(entry.Request.URL, fmt.Sprintf("$1=%s", RedactedValue))
+	sanitizeBody(entry.Request.Body)
+	if entry.Response != nil {
+		sanitizeBody(entry.Response.Body)
+	}
+
+	buff := new(bytes.Buffer)
+	encoder := json.NewEncoder(buff)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(entry)
+	if err != nil {
+		return err
+	}
+
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	for _, filePath := range writer.filePaths {
+		err = writer.appendLine(filePath, buff.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (writer *RequestLoggerJSONLWriter) appendLine(filePath string, line []byte) error {
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModeDir|os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	err = writer.rotate(filePath, int64(len(line)))
+	if err != nil {
+		return err
+	}
+
+	logFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = logFile.Write(line)
+	closeErr := logFile.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// rotate renames filePath to filePath.1, shifting the previous backups up by
+// one, when appending incomingSize bytes would grow it past maxSize.
+func (writer *RequestLoggerJSONLWriter) rotate(filePath string, incomingSize int64) error {
+	info, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if info.Size() == 0 || info.Size()+incomingSize <= writer.maxSize {
+		return nil
+	}
+
+	for i := traceLogBackups - 1; i > 0; i-- {
+		backup := fmt.Sprintf("%s.%d", filePath, i)
+		if _, err := os.Stat(backup); err != nil {
+			continue
+		}
+		err = os.Rename(backup, fmt.Sprintf("%s.%d", filePath, i+1))
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(filePath, filePath+".1")
+}
+
+// sanitizeBody redacts the secrets in JSON bodies and hides every other body.
+func sanitizeBody(body *requestlog.Body) {
+	if body == nil {
+		return
+	}
+
+	if strings.Contains(body.ContentType, "json") {
+		sanitized, err := SanitizeJSON(body.Raw)
+		if err == nil {
+			body.Sanitized = sanitized
+			return
+		}
+	}
+	body.Sanitized = RedactedValue
+}
+
+// RequestLoggerJSONLWriter returns a RequestLoggerJSONLWriter that cannot
+// overwrite another RequestLoggerJSONLWriter or RequestLoggerFileWriter.
+func (ui *UI) RequestLoggerJSONLWriter(filePaths []string, maxSize int64) *RequestLoggerJSONLWriter {
+	return newRequestLoggerJSONLWriter(ui, ui.fileLock, filePaths, maxSize)
+}
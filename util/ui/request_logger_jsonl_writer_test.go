@@ -0,0 +1,163 @@
+package ui_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/requestlog"
+	. "code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Request Logger JSONL Writer", func() {
+	var (
+		ui      *UI
+		writer  *RequestLoggerJSONLWriter
+		tmpdir  string
+		logFile string
+		maxSize int64
+	)
+
+	BeforeEach(func() {
+		ui = NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+
+		var err error
+		tmpdir, err = ioutil.TempDir("", "request_logger_jsonl")
+		Expect(err).ToNot(HaveOccurred())
+
+		logFile = filepath.Join(tmpdir, "sub_dir", "trace.jsonl")
+		maxSize = 1024 * 1024
+	})
+
+	JustBeforeEach(func() {
+		writer = ui.RequestLoggerJSONLWriter([]string{logFile}, maxSize)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	readLines := func(path string) []map[string]interface{} {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+
+		var entries []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSuffix(string(contents), "\n"), "\n") {
+			var entry map[string]interface{}
+			Expect(json.Unmarshal([]byte(line), &entry)).To(Succeed())
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	Describe("WriteEntry", func() {
+		It("writes each entry as a single line of JSON", func() {
+			Expect(writer.WriteEntry(requestlog.Entry{ID: "id-1", Source: requestlog.SourceUAA})).To(Succeed())
+			Expect(writer.WriteEntry(requestlog.Entry{ID: "id-2", Source: requestlog.SourceUAA})).To(Succeed())
+
+			entries := readLines(logFile)
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0]["id"]).To(Equal("id-1"))
+			Expect(entries[1]["id"]).To(Equal("id-2"))
+		})
+
+		It("redacts secrets in JSON bodies", func() {
+			err := writer.WriteEntry(requestlog.Entry{
+				ID: "some-id",
+				Request: requestlog.Request{
+					URL: "https://uaa.example.com/oauth/token?grant_type=password&password=some-password",
+					Body: &requestlog.Body{
+						Raw:         []byte(`{"name":"some-name","password":"some-password"}`),
+						ContentType: "application/json",
+					},
+				},
+				Response: &requestlog.Response{
+					StatusCode: 200,
+					Body: &requestlog.Body{
+						Raw:         []byte(`{"access_token":"some-token"}`),
+						ContentType: "application/json;charset=utf-8",
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(logFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).ToNot(ContainSubstring("some-password"))
+			Expect(string(contents)).ToNot(ContainSubstring("some-token"))
+
+			entry := readLines(logFile)[0]
+			request := entry["request"].(map[string]interface{})
+			Expect(request["url"]).To(Equal("https://uaa.example.com/oauth/token?grant_type=password&password=[PRIVATE DATA HIDDEN]"))
+			Expect(request["body"]).To(Equal(map[string]interface{}{
+				"name":     "some-name",
+				"password": RedactedValue,
+			}))
+			response := entry["response"].(map[string]interface{})
+			Expect(response["body"]).To(Equal(map[string]interface{}{
+				"access_token": RedactedValue,
+			}))
+		})
+
+		It("hides bodies that are not JSON", func() {
+			err := writer.WriteEntry(requestlog.Entry{
+				Request: requestlog.Request{
+					Body: &requestlog.Body{
+						Raw:         []byte("username=admin&password=some-password"),
+						ContentType: "application/x-www-form-urlencoded",
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			request := readLines(logFile)[0]["request"].(map[string]interface{})
+			Expect(request["body"]).To(Equal(RedactedValue))
+		})
+
+		Context("when the file would grow past the max size", func() {
+			BeforeEach(func() {
+				maxSize = 200
+			})
+
+			It("rotates the file and keeps a limited number of backups", func() {
+				for i := 0; i < 6; i++ {
+					Expect(writer.WriteEntry(requestlog.Entry{
+						ID:     strings.Repeat("a", 100),
+						Source: string('0' + rune(i)),
+					})).To(Succeed())
+				}
+
+				Expect(readLines(logFile)).To(HaveLen(1))
+				Expect(readLines(logFile)[0]["source"]).To(Equal("5"))
+				Expect(readLines(logFile + ".1")[0]["source"]).To(Equal("4"))
+				Expect(readLines(logFile + ".2")[0]["source"]).To(Equal("3"))
+				Expect(readLines(logFile + ".3")[0]["source"]).To(Equal("2"))
+				Expect(logFile + ".4").ToNot(BeAnExistingFile())
+			})
+		})
+
+		Context("when the file cannot be written", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(logFile, 0700)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				Expect(writer.WriteEntry(requestlog.Entry{})).ToNot(Succeed())
+			})
+		})
+	})
+
+	Describe("HandleInternalError", func() {
+		It("displays the error as a warning", func() {
+			writer.HandleInternalError(errors.New("some-error"))
+			Expect(ui.Err).To(Say("some-error"))
+		})
+	})
+})
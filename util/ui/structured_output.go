@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// IsStructuredOutput returns true when the UI has been configured to display
+// JSON or YAML documents instead of human readable text and tables.
+func (ui *UI) IsStructuredOutput() bool {
+	return ui.OutputFormat == configv3.OutputFormatJSON || ui.OutputFormat == configv3.OutputFormatYAML
+}
+
+// DisplayStructuredOutput marshals the provided document in the configured
+// output format and outputs the result to ui.Out. The document is expected to
+// carry both json and yaml struct tags so that both formats share the same
+// schema.
+func (ui *UI) DisplayStructuredOutput(document interface{}) error {
+	var (
+		raw []byte
+		err error
+	)
+
+	switch ui.OutputFormat {
+	case configv3.OutputFormatYAML:
+		raw, err = yaml.Marshal(document)
+	default:
+		raw, err = json.MarshalIndent(document, "", "  ")
+		raw = append(raw, '\n')
+	}
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = ui.Out.Write(raw)
+	return err
+}
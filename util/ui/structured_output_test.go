@@ -0,0 +1,118 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured Output", func() {
+	type document struct {
+		Name      string   `json:"name" yaml:"name"`
+		Instances int      `json:"instances" yaml:"instances"`
+		Routes    []string `json:"routes" yaml:"routes"`
+	}
+
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		ui.Err = NewBuffer()
+	})
+
+	Describe("IsStructuredOutput", func() {
+		Context("when the output format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("returns true", func() {
+				Expect(ui.IsStructuredOutput()).To(BeTrue())
+			})
+		})
+
+		Context("when the output format is yaml", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			})
+
+			It("returns true", func() {
+				Expect(ui.IsStructuredOutput()).To(BeTrue())
+			})
+		})
+
+		Context("when the output format is not set", func() {
+			It("returns false", func() {
+				Expect(ui.IsStructuredOutput()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("DisplayStructuredOutput", func() {
+		var (
+			doc        document
+			displayErr error
+		)
+
+		BeforeEach(func() {
+			doc = document{
+				Name:      "some-app",
+				Instances: 2,
+				Routes:    []string{"some-route.com"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			displayErr = ui.DisplayStructuredOutput(doc)
+		})
+
+		Context("when the output format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("displays the document as indented JSON to ui.Out", func() {
+				Expect(displayErr).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(Equal(`{
+  "name": "some-app",
+  "instances": 2,
+  "routes": [
+    "some-route.com"
+  ]
+}
+`))
+			})
+		})
+
+		Context("when the output format is yaml", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			})
+
+			It("displays the document as YAML to ui.Out", func() {
+				Expect(displayErr).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(Equal(`name: some-app
+instances: 2
+routes:
+- some-route.com
+`))
+			})
+		})
+	})
+})
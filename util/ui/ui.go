@@ -41,6 +41,9 @@ type UI struct {
 	IsTTY         bool
 	TerminalWidth int
 
+	// OutputFormat is the format used by DisplayStructuredOutput.
+	OutputFormat configv3.OutputFormat
+
 	TimezoneLocation *time.Location
 }
 
@@ -65,6 +68,7 @@ func NewUI(config Config) (*UI, error) {
 		fileLock:         &sync.Mutex{},
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
+		OutputFormat:     config.OutputFormat(),
 		TimezoneLocation: location,
 	}, nil
 }
@@ -92,7 +96,8 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err. It also outputs "FAILED" in bold red to ui.Out, or to ui.Err when
+// displaying structured output.
 func (ui *UI) DisplayError(err error) {
 	var errMsg string
 	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
@@ -105,7 +110,11 @@ func (ui *UI) DisplayError(err error) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
+	out := ui.Out
+	if ui.IsStructuredOutput() {
+		out = ui.Err
+	}
+	fmt.Fprintf(out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
 }
 
 // DisplayHeader translates the header, bolds and adds the default color to the
@@ -67,6 +67,19 @@ var _ = Describe("UI", func() {
 				Expect(out).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
 			})
 		})
+
+		Context("when displaying structured output", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the error text and FAILED to ui.Err", func() {
+				ui.DisplayError(errors.New("I am a BANANA!"))
+				Expect(ui.Err).To(Say("I am a BANANA!\n"))
+				Expect(ui.Err).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("DisplayHeader", func() {
@@ -27,6 +27,15 @@ type FakeConfig struct {
 	localeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 configv3.OutputFormat
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 configv3.OutputFormat
+	}
 	IsTTYStub        func() bool
 	isTTYMutex       sync.RWMutex
 	isTTYArgsForCall []struct{}
@@ -129,6 +138,46 @@ func (fake *FakeConfig) LocaleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.outputFormatReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 configv3.OutputFormat) {
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.OutputFormat
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) IsTTY() bool {
 	fake.isTTYMutex.Lock()
 	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
@@ -216,6 +265,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.isTTYMutex.RLock()
 	defer fake.isTTYMutex.RUnlock()
 	fake.terminalWidthMutex.RLock()